@@ -154,6 +154,28 @@ func (sc *segmentClient) ReportSegmentLeader(
 	return out, nil
 }
 
+func (sc *segmentClient) RebalanceLeaders(
+	ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption,
+) (*ctrlpb.RebalanceLeadersResponse, error) {
+	out := new(ctrlpb.RebalanceLeadersResponse)
+	err := sc.cc.invoke(ctx, "/vanus.core.controller.SegmentController/RebalanceLeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (sc *segmentClient) DecommissionVolume(
+	ctx context.Context, in *ctrlpb.DecommissionVolumeRequest, opts ...grpc.CallOption,
+) (*ctrlpb.DecommissionVolumeResponse, error) {
+	out := new(ctrlpb.DecommissionVolumeResponse)
+	err := sc.cc.invoke(ctx, "/vanus.core.controller.SegmentController/DecommissionVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (sc *segmentClient) QuerySegmentRouteInfo(
 	ctx context.Context, in *ctrlpb.QuerySegmentRouteInfoRequest, opts ...grpc.CallOption,
 ) (*ctrlpb.QuerySegmentRouteInfoResponse, error) {
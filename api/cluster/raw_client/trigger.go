@@ -67,6 +67,17 @@ func (tc *triggerClient) GetDeadLetterEventOffset(
 	return out, nil
 }
 
+func (tc *triggerClient) GetSubscriptionLag(
+	ctx context.Context, in *ctrlpb.GetSubscriptionLagRequest, opts ...grpc.CallOption,
+) (*ctrlpb.GetSubscriptionLagResponse, error) {
+	out := new(ctrlpb.GetSubscriptionLagResponse)
+	err := tc.cc.invoke(ctx, "/vanus.core.controller.TriggerController/GetSubscriptionLag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (tc *triggerClient) Beat(ctx context.Context, v interface{}) error {
 	log.Debug().Str("leader", tc.cc.leader).Msg("heartbeat")
 	req, ok := v.(*ctrlpb.TriggerWorkerHeartbeatRequest)
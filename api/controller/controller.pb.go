@@ -315,6 +315,9 @@ type CreateEventbusRequest struct {
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	NamespaceId uint64 `protobuf:"varint,4,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	Id          uint64 `protobuf:"varint,5,opt,name=id,proto3" json:"id,omitempty"`
+	// the cluster default policy will be used if it's absent
+	Retention         *meta.RetentionPolicy  `protobuf:"bytes,6,opt,name=retention,proto3" json:"retention,omitempty"`
+	CompressAlgorithm meta.CompressAlgorithm `protobuf:"varint,7,opt,name=compress_algorithm,json=compressAlgorithm,proto3,enum=vanus.core.meta.CompressAlgorithm" json:"compress_algorithm,omitempty"`
 }
 
 func (x *CreateEventbusRequest) Reset() {
@@ -384,6 +387,20 @@ func (x *CreateEventbusRequest) GetId() uint64 {
 	return 0
 }
 
+func (x *CreateEventbusRequest) GetRetention() *meta.RetentionPolicy {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *CreateEventbusRequest) GetCompressAlgorithm() meta.CompressAlgorithm {
+	if x != nil {
+		return x.CompressAlgorithm
+	}
+	return meta.CompressAlgorithm(0)
+}
+
 type ListEventbusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -537,6 +554,14 @@ type UpdateEventbusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id          uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Retention   *meta.RetentionPolicy `protobuf:"bytes,2,opt,name=retention,proto3" json:"retention,omitempty"`
+	Description *string               `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// the number of eventlogs can only be increased, 0 means keep it unchanged.
+	LogNumber int32 `protobuf:"varint,4,opt,name=log_number,json=logNumber,proto3" json:"log_number,omitempty"`
+	// only affects segments created after the update.
+	CompressAlgorithm *meta.CompressAlgorithm `protobuf:"varint,5,opt,name=compress_algorithm,json=compressAlgorithm,proto3,enum=vanus.core.meta.CompressAlgorithm,oneof" json:"compress_algorithm,omitempty"`
 }
 
 func (x *UpdateEventbusRequest) Reset() {
@@ -571,6 +596,41 @@ func (*UpdateEventbusRequest) Descriptor() ([]byte, []int) {
 	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{9}
 }
 
+func (x *UpdateEventbusRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateEventbusRequest) GetRetention() *meta.RetentionPolicy {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *UpdateEventbusRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateEventbusRequest) GetLogNumber() int32 {
+	if x != nil {
+		return x.LogNumber
+	}
+	return 0
+}
+
+func (x *UpdateEventbusRequest) GetCompressAlgorithm() meta.CompressAlgorithm {
+	if x != nil && x.CompressAlgorithm != nil {
+		return *x.CompressAlgorithm
+	}
+	return meta.CompressAlgorithm(0)
+}
+
 type QuerySegmentRouteInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -756,18 +816,20 @@ func (*SegmentHeartbeatResponse) Descriptor() ([]byte, []int) {
 	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{13}
 }
 
-type RegisterSegmentServerRequest struct {
+type LeaderTransfer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	VolumeId uint64 `protobuf:"varint,2,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
-	Capacity uint64 `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	SegmentId    uint64 `protobuf:"varint,1,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	FromBlockId  uint64 `protobuf:"varint,2,opt,name=from_block_id,json=fromBlockId,proto3" json:"from_block_id,omitempty"`
+	ToBlockId    uint64 `protobuf:"varint,3,opt,name=to_block_id,json=toBlockId,proto3" json:"to_block_id,omitempty"`
+	FromVolumeId uint64 `protobuf:"varint,4,opt,name=from_volume_id,json=fromVolumeId,proto3" json:"from_volume_id,omitempty"`
+	ToVolumeId   uint64 `protobuf:"varint,5,opt,name=to_volume_id,json=toVolumeId,proto3" json:"to_volume_id,omitempty"`
 }
 
-func (x *RegisterSegmentServerRequest) Reset() {
-	*x = RegisterSegmentServerRequest{}
+func (x *LeaderTransfer) Reset() {
+	*x = LeaderTransfer{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_vanus_core_controller_controller_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -775,13 +837,13 @@ func (x *RegisterSegmentServerRequest) Reset() {
 	}
 }
 
-func (x *RegisterSegmentServerRequest) String() string {
+func (x *LeaderTransfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterSegmentServerRequest) ProtoMessage() {}
+func (*LeaderTransfer) ProtoMessage() {}
 
-func (x *RegisterSegmentServerRequest) ProtoReflect() protoreflect.Message {
+func (x *LeaderTransfer) ProtoReflect() protoreflect.Message {
 	mi := &file_vanus_core_controller_controller_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -793,43 +855,56 @@ func (x *RegisterSegmentServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterSegmentServerRequest.ProtoReflect.Descriptor instead.
-func (*RegisterSegmentServerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LeaderTransfer.ProtoReflect.Descriptor instead.
+func (*LeaderTransfer) Descriptor() ([]byte, []int) {
 	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *RegisterSegmentServerRequest) GetAddress() string {
+func (x *LeaderTransfer) GetSegmentId() uint64 {
 	if x != nil {
-		return x.Address
+		return x.SegmentId
 	}
-	return ""
+	return 0
 }
 
-func (x *RegisterSegmentServerRequest) GetVolumeId() uint64 {
+func (x *LeaderTransfer) GetFromBlockId() uint64 {
 	if x != nil {
-		return x.VolumeId
+		return x.FromBlockId
 	}
 	return 0
 }
 
-func (x *RegisterSegmentServerRequest) GetCapacity() uint64 {
+func (x *LeaderTransfer) GetToBlockId() uint64 {
 	if x != nil {
-		return x.Capacity
+		return x.ToBlockId
 	}
 	return 0
 }
 
-type RegisterSegmentServerResponse struct {
+func (x *LeaderTransfer) GetFromVolumeId() uint64 {
+	if x != nil {
+		return x.FromVolumeId
+	}
+	return 0
+}
+
+func (x *LeaderTransfer) GetToVolumeId() uint64 {
+	if x != nil {
+		return x.ToVolumeId
+	}
+	return 0
+}
+
+type RebalanceLeadersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Segments map[uint64]*meta.Segment `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	VolumeId uint64                   `protobuf:"varint,3,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	Transfers []*LeaderTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
 }
 
-func (x *RegisterSegmentServerResponse) Reset() {
-	*x = RegisterSegmentServerResponse{}
+func (x *RebalanceLeadersResponse) Reset() {
+	*x = RebalanceLeadersResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_vanus_core_controller_controller_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -837,13 +912,13 @@ func (x *RegisterSegmentServerResponse) Reset() {
 	}
 }
 
-func (x *RegisterSegmentServerResponse) String() string {
+func (x *RebalanceLeadersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterSegmentServerResponse) ProtoMessage() {}
+func (*RebalanceLeadersResponse) ProtoMessage() {}
 
-func (x *RegisterSegmentServerResponse) ProtoReflect() protoreflect.Message {
+func (x *RebalanceLeadersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_vanus_core_controller_controller_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -855,36 +930,29 @@ func (x *RegisterSegmentServerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterSegmentServerResponse.ProtoReflect.Descriptor instead.
-func (*RegisterSegmentServerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RebalanceLeadersResponse.ProtoReflect.Descriptor instead.
+func (*RebalanceLeadersResponse) Descriptor() ([]byte, []int) {
 	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *RegisterSegmentServerResponse) GetSegments() map[uint64]*meta.Segment {
+func (x *RebalanceLeadersResponse) GetTransfers() []*LeaderTransfer {
 	if x != nil {
-		return x.Segments
+		return x.Transfers
 	}
 	return nil
 }
 
-func (x *RegisterSegmentServerResponse) GetVolumeId() uint64 {
-	if x != nil {
-		return x.VolumeId
-	}
-	return 0
-}
-
-type UnregisterSegmentServerRequest struct {
+type DecommissionVolumeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address  string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	VolumeId uint64 `protobuf:"varint,3,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	VolumeId uint64 `protobuf:"varint,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	Cancel   bool   `protobuf:"varint,2,opt,name=cancel,proto3" json:"cancel,omitempty"`
 }
 
-func (x *UnregisterSegmentServerRequest) Reset() {
-	*x = UnregisterSegmentServerRequest{}
+func (x *DecommissionVolumeRequest) Reset() {
+	*x = DecommissionVolumeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_vanus_core_controller_controller_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -892,13 +960,13 @@ func (x *UnregisterSegmentServerRequest) Reset() {
 	}
 }
 
-func (x *UnregisterSegmentServerRequest) String() string {
+func (x *DecommissionVolumeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnregisterSegmentServerRequest) ProtoMessage() {}
+func (*DecommissionVolumeRequest) ProtoMessage() {}
 
-func (x *UnregisterSegmentServerRequest) ProtoReflect() protoreflect.Message {
+func (x *DecommissionVolumeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_vanus_core_controller_controller_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -910,33 +978,40 @@ func (x *UnregisterSegmentServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnregisterSegmentServerRequest.ProtoReflect.Descriptor instead.
-func (*UnregisterSegmentServerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DecommissionVolumeRequest.ProtoReflect.Descriptor instead.
+func (*DecommissionVolumeRequest) Descriptor() ([]byte, []int) {
 	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *UnregisterSegmentServerRequest) GetAddress() string {
+func (x *DecommissionVolumeRequest) GetVolumeId() uint64 {
 	if x != nil {
-		return x.Address
+		return x.VolumeId
 	}
-	return ""
+	return 0
 }
 
-func (x *UnregisterSegmentServerRequest) GetVolumeId() uint64 {
+func (x *DecommissionVolumeRequest) GetCancel() bool {
 	if x != nil {
-		return x.VolumeId
+		return x.Cancel
 	}
-	return 0
+	return false
 }
 
-type UnregisterSegmentServerResponse struct {
+type DecommissionVolumeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	VolumeId uint64 `protobuf:"varint,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	Draining bool   `protobuf:"varint,2,opt,name=draining,proto3" json:"draining,omitempty"`
+	// the number of replicas still hosted by the volume.
+	RemainingBlocks uint32 `protobuf:"varint,3,opt,name=remaining_blocks,json=remainingBlocks,proto3" json:"remaining_blocks,omitempty"`
+	// the segment server of volume can be removed safely.
+	Completed bool `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
 }
 
-func (x *UnregisterSegmentServerResponse) Reset() {
-	*x = UnregisterSegmentServerResponse{}
+func (x *DecommissionVolumeResponse) Reset() {
+	*x = DecommissionVolumeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_vanus_core_controller_controller_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -944,13 +1019,13 @@ func (x *UnregisterSegmentServerResponse) Reset() {
 	}
 }
 
-func (x *UnregisterSegmentServerResponse) String() string {
+func (x *DecommissionVolumeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnregisterSegmentServerResponse) ProtoMessage() {}
+func (*DecommissionVolumeResponse) ProtoMessage() {}
 
-func (x *UnregisterSegmentServerResponse) ProtoReflect() protoreflect.Message {
+func (x *DecommissionVolumeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_vanus_core_controller_controller_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -962,23 +1037,52 @@ func (x *UnregisterSegmentServerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnregisterSegmentServerResponse.ProtoReflect.Descriptor instead.
-func (*UnregisterSegmentServerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DecommissionVolumeResponse.ProtoReflect.Descriptor instead.
+func (*DecommissionVolumeResponse) Descriptor() ([]byte, []int) {
 	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{17}
 }
 
-type ReportSegmentLeaderRequest struct {
+func (x *DecommissionVolumeResponse) GetVolumeId() uint64 {
+	if x != nil {
+		return x.VolumeId
+	}
+	return 0
+}
+
+func (x *DecommissionVolumeResponse) GetDraining() bool {
+	if x != nil {
+		return x.Draining
+	}
+	return false
+}
+
+func (x *DecommissionVolumeResponse) GetRemainingBlocks() uint32 {
+	if x != nil {
+		return x.RemainingBlocks
+	}
+	return 0
+}
+
+func (x *DecommissionVolumeResponse) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+type RegisterSegmentServerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SegmentId uint64 `protobuf:"varint,3,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
-	LeaderId  uint64 `protobuf:"varint,1,opt,name=leader_id,json=leaderId,proto3" json:"leader_id,omitempty"`
-	Term      uint64 `protobuf:"varint,2,opt,name=term,proto3" json:"term,omitempty"`
+	Address  string          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	VolumeId uint64          `protobuf:"varint,2,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	Capacity uint64          `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Topology *VolumeTopology `protobuf:"bytes,4,opt,name=topology,proto3" json:"topology,omitempty"`
 }
 
-func (x *ReportSegmentLeaderRequest) Reset() {
-	*x = ReportSegmentLeaderRequest{}
+func (x *RegisterSegmentServerRequest) Reset() {
+	*x = RegisterSegmentServerRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_vanus_core_controller_controller_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -986,13 +1090,13 @@ func (x *ReportSegmentLeaderRequest) Reset() {
 	}
 }
 
-func (x *ReportSegmentLeaderRequest) String() string {
+func (x *RegisterSegmentServerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportSegmentLeaderRequest) ProtoMessage() {}
+func (*RegisterSegmentServerRequest) ProtoMessage() {}
 
-func (x *ReportSegmentLeaderRequest) ProtoReflect() protoreflect.Message {
+func (x *RegisterSegmentServerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_vanus_core_controller_controller_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1004,55 +1108,53 @@ func (x *ReportSegmentLeaderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportSegmentLeaderRequest.ProtoReflect.Descriptor instead.
-func (*ReportSegmentLeaderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegisterSegmentServerRequest.ProtoReflect.Descriptor instead.
+func (*RegisterSegmentServerRequest) Descriptor() ([]byte, []int) {
 	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ReportSegmentLeaderRequest) GetSegmentId() uint64 {
+func (x *RegisterSegmentServerRequest) GetAddress() string {
 	if x != nil {
-		return x.SegmentId
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-func (x *ReportSegmentLeaderRequest) GetLeaderId() uint64 {
+func (x *RegisterSegmentServerRequest) GetVolumeId() uint64 {
 	if x != nil {
-		return x.LeaderId
+		return x.VolumeId
 	}
 	return 0
 }
 
-func (x *ReportSegmentLeaderRequest) GetTerm() uint64 {
+func (x *RegisterSegmentServerRequest) GetCapacity() uint64 {
 	if x != nil {
-		return x.Term
+		return x.Capacity
 	}
 	return 0
 }
 
-type SubscriptionRequest struct {
+func (x *RegisterSegmentServerRequest) GetTopology() *VolumeTopology {
+	if x != nil {
+		return x.Topology
+	}
+	return nil
+}
+
+// VolumeTopology describes failure domains of a volume, replicas of a segment are spread across
+// them as far as possible. Empty label means unknown.
+type VolumeTopology struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Source           string                   `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	Types            []string                 `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
-	Config           *meta.SubscriptionConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
-	Filters          []*meta.Filter           `protobuf:"bytes,4,rep,name=filters,proto3" json:"filters,omitempty"`
-	Sink             string                   `protobuf:"bytes,5,opt,name=sink,proto3" json:"sink,omitempty"`
-	SinkCredential   *meta.SinkCredential     `protobuf:"bytes,6,opt,name=sink_credential,json=sinkCredential,proto3" json:"sink_credential,omitempty"`
-	Protocol         meta.Protocol            `protobuf:"varint,7,opt,name=protocol,proto3,enum=vanus.core.meta.Protocol" json:"protocol,omitempty"`
-	ProtocolSettings *meta.ProtocolSetting    `protobuf:"bytes,8,opt,name=protocol_settings,json=protocolSettings,proto3" json:"protocol_settings,omitempty"`
-	Transformer      *meta.Transformer        `protobuf:"bytes,10,opt,name=transformer,proto3" json:"transformer,omitempty"`
-	Name             string                   `protobuf:"bytes,11,opt,name=name,proto3" json:"name,omitempty"`
-	Description      string                   `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`
-	Disable          bool                     `protobuf:"varint,13,opt,name=disable,proto3" json:"disable,omitempty"`
-	EventbusId       uint64                   `protobuf:"varint,14,opt,name=eventbus_id,json=eventbusId,proto3" json:"eventbus_id,omitempty"`
-	NamespaceId      uint64                   `protobuf:"varint,15,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Zone string `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	Rack string `protobuf:"bytes,2,opt,name=rack,proto3" json:"rack,omitempty"`
+	Host string `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
 }
 
-func (x *SubscriptionRequest) Reset() {
-	*x = SubscriptionRequest{}
+func (x *VolumeTopology) Reset() {
+	*x = VolumeTopology{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_vanus_core_controller_controller_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1060,13 +1162,13 @@ func (x *SubscriptionRequest) Reset() {
 	}
 }
 
-func (x *SubscriptionRequest) String() string {
+func (x *VolumeTopology) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscriptionRequest) ProtoMessage() {}
+func (*VolumeTopology) ProtoMessage() {}
 
-func (x *SubscriptionRequest) ProtoReflect() protoreflect.Message {
+func (x *VolumeTopology) ProtoReflect() protoreflect.Message {
 	mi := &file_vanus_core_controller_controller_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1078,75 +1180,360 @@ func (x *SubscriptionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscriptionRequest.ProtoReflect.Descriptor instead.
-func (*SubscriptionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VolumeTopology.ProtoReflect.Descriptor instead.
+func (*VolumeTopology) Descriptor() ([]byte, []int) {
 	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *SubscriptionRequest) GetSource() string {
+func (x *VolumeTopology) GetZone() string {
 	if x != nil {
-		return x.Source
+		return x.Zone
 	}
 	return ""
 }
 
-func (x *SubscriptionRequest) GetTypes() []string {
+func (x *VolumeTopology) GetRack() string {
 	if x != nil {
-		return x.Types
+		return x.Rack
 	}
-	return nil
+	return ""
 }
 
-func (x *SubscriptionRequest) GetConfig() *meta.SubscriptionConfig {
+func (x *VolumeTopology) GetHost() string {
 	if x != nil {
-		return x.Config
+		return x.Host
 	}
-	return nil
+	return ""
 }
 
-func (x *SubscriptionRequest) GetFilters() []*meta.Filter {
-	if x != nil {
-		return x.Filters
-	}
-	return nil
+type RegisterSegmentServerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Segments map[uint64]*meta.Segment `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	VolumeId uint64                   `protobuf:"varint,3,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
 }
 
-func (x *SubscriptionRequest) GetSink() string {
-	if x != nil {
-		return x.Sink
+func (x *RegisterSegmentServerResponse) Reset() {
+	*x = RegisterSegmentServerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *SubscriptionRequest) GetSinkCredential() *meta.SinkCredential {
-	if x != nil {
-		return x.SinkCredential
-	}
-	return nil
+func (x *RegisterSegmentServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SubscriptionRequest) GetProtocol() meta.Protocol {
-	if x != nil {
-		return x.Protocol
+func (*RegisterSegmentServerResponse) ProtoMessage() {}
+
+func (x *RegisterSegmentServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return meta.Protocol(0)
+	return mi.MessageOf(x)
 }
 
-func (x *SubscriptionRequest) GetProtocolSettings() *meta.ProtocolSetting {
-	if x != nil {
-		return x.ProtocolSettings
-	}
-	return nil
+// Deprecated: Use RegisterSegmentServerResponse.ProtoReflect.Descriptor instead.
+func (*RegisterSegmentServerResponse) Descriptor() ([]byte, []int) {
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *SubscriptionRequest) GetTransformer() *meta.Transformer {
+func (x *RegisterSegmentServerResponse) GetSegments() map[uint64]*meta.Segment {
 	if x != nil {
-		return x.Transformer
+		return x.Segments
 	}
 	return nil
 }
 
-func (x *SubscriptionRequest) GetName() string {
+func (x *RegisterSegmentServerResponse) GetVolumeId() uint64 {
+	if x != nil {
+		return x.VolumeId
+	}
+	return 0
+}
+
+type UnregisterSegmentServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address  string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	VolumeId uint64 `protobuf:"varint,3,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+}
+
+func (x *UnregisterSegmentServerRequest) Reset() {
+	*x = UnregisterSegmentServerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnregisterSegmentServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterSegmentServerRequest) ProtoMessage() {}
+
+func (x *UnregisterSegmentServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterSegmentServerRequest.ProtoReflect.Descriptor instead.
+func (*UnregisterSegmentServerRequest) Descriptor() ([]byte, []int) {
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UnregisterSegmentServerRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UnregisterSegmentServerRequest) GetVolumeId() uint64 {
+	if x != nil {
+		return x.VolumeId
+	}
+	return 0
+}
+
+type UnregisterSegmentServerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnregisterSegmentServerResponse) Reset() {
+	*x = UnregisterSegmentServerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnregisterSegmentServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterSegmentServerResponse) ProtoMessage() {}
+
+func (x *UnregisterSegmentServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterSegmentServerResponse.ProtoReflect.Descriptor instead.
+func (*UnregisterSegmentServerResponse) Descriptor() ([]byte, []int) {
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{22}
+}
+
+type ReportSegmentLeaderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SegmentId uint64 `protobuf:"varint,3,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	LeaderId  uint64 `protobuf:"varint,1,opt,name=leader_id,json=leaderId,proto3" json:"leader_id,omitempty"`
+	Term      uint64 `protobuf:"varint,2,opt,name=term,proto3" json:"term,omitempty"`
+}
+
+func (x *ReportSegmentLeaderRequest) Reset() {
+	*x = ReportSegmentLeaderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportSegmentLeaderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSegmentLeaderRequest) ProtoMessage() {}
+
+func (x *ReportSegmentLeaderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSegmentLeaderRequest.ProtoReflect.Descriptor instead.
+func (*ReportSegmentLeaderRequest) Descriptor() ([]byte, []int) {
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReportSegmentLeaderRequest) GetSegmentId() uint64 {
+	if x != nil {
+		return x.SegmentId
+	}
+	return 0
+}
+
+func (x *ReportSegmentLeaderRequest) GetLeaderId() uint64 {
+	if x != nil {
+		return x.LeaderId
+	}
+	return 0
+}
+
+func (x *ReportSegmentLeaderRequest) GetTerm() uint64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+type SubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source           string                   `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Types            []string                 `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	Config           *meta.SubscriptionConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	Filters          []*meta.Filter           `protobuf:"bytes,4,rep,name=filters,proto3" json:"filters,omitempty"`
+	Sink             string                   `protobuf:"bytes,5,opt,name=sink,proto3" json:"sink,omitempty"`
+	SinkCredential   *meta.SinkCredential     `protobuf:"bytes,6,opt,name=sink_credential,json=sinkCredential,proto3" json:"sink_credential,omitempty"`
+	Protocol         meta.Protocol            `protobuf:"varint,7,opt,name=protocol,proto3,enum=vanus.core.meta.Protocol" json:"protocol,omitempty"`
+	ProtocolSettings *meta.ProtocolSetting    `protobuf:"bytes,8,opt,name=protocol_settings,json=protocolSettings,proto3" json:"protocol_settings,omitempty"`
+	Transformer      *meta.Transformer        `protobuf:"bytes,10,opt,name=transformer,proto3" json:"transformer,omitempty"`
+	Name             string                   `protobuf:"bytes,11,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                   `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`
+	Disable          bool                     `protobuf:"varint,13,opt,name=disable,proto3" json:"disable,omitempty"`
+	EventbusId       uint64                   `protobuf:"varint,14,opt,name=eventbus_id,json=eventbusId,proto3" json:"eventbus_id,omitempty"`
+	NamespaceId      uint64                   `protobuf:"varint,15,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+}
+
+func (x *SubscriptionRequest) Reset() {
+	*x = SubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionRequest) ProtoMessage() {}
+
+func (x *SubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*SubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SubscriptionRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *SubscriptionRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SubscriptionRequest) GetConfig() *meta.SubscriptionConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *SubscriptionRequest) GetFilters() []*meta.Filter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *SubscriptionRequest) GetSink() string {
+	if x != nil {
+		return x.Sink
+	}
+	return ""
+}
+
+func (x *SubscriptionRequest) GetSinkCredential() *meta.SinkCredential {
+	if x != nil {
+		return x.SinkCredential
+	}
+	return nil
+}
+
+func (x *SubscriptionRequest) GetProtocol() meta.Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return meta.Protocol(0)
+}
+
+func (x *SubscriptionRequest) GetProtocolSettings() *meta.ProtocolSetting {
+	if x != nil {
+		return x.ProtocolSettings
+	}
+	return nil
+}
+
+func (x *SubscriptionRequest) GetTransformer() *meta.Transformer {
+	if x != nil {
+		return x.Transformer
+	}
+	return nil
+}
+
+func (x *SubscriptionRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
@@ -1193,7 +1580,7 @@ type CreateSubscriptionRequest struct {
 func (x *CreateSubscriptionRequest) Reset() {
 	*x = CreateSubscriptionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[20]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1206,7 +1593,7 @@ func (x *CreateSubscriptionRequest) String() string {
 func (*CreateSubscriptionRequest) ProtoMessage() {}
 
 func (x *CreateSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[20]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1219,7 +1606,7 @@ func (x *CreateSubscriptionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSubscriptionRequest.ProtoReflect.Descriptor instead.
 func (*CreateSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{20}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *CreateSubscriptionRequest) GetSubscription() *SubscriptionRequest {
@@ -1248,7 +1635,7 @@ type UpdateSubscriptionRequest struct {
 func (x *UpdateSubscriptionRequest) Reset() {
 	*x = UpdateSubscriptionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[21]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1261,7 +1648,7 @@ func (x *UpdateSubscriptionRequest) String() string {
 func (*UpdateSubscriptionRequest) ProtoMessage() {}
 
 func (x *UpdateSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[21]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1274,7 +1661,7 @@ func (x *UpdateSubscriptionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSubscriptionRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{21}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *UpdateSubscriptionRequest) GetId() uint64 {
@@ -1302,7 +1689,7 @@ type GetSubscriptionRequest struct {
 func (x *GetSubscriptionRequest) Reset() {
 	*x = GetSubscriptionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[22]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1315,7 +1702,7 @@ func (x *GetSubscriptionRequest) String() string {
 func (*GetSubscriptionRequest) ProtoMessage() {}
 
 func (x *GetSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[22]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1328,7 +1715,7 @@ func (x *GetSubscriptionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubscriptionRequest.ProtoReflect.Descriptor instead.
 func (*GetSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{22}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetSubscriptionRequest) GetId() uint64 {
@@ -1349,7 +1736,7 @@ type DeleteSubscriptionRequest struct {
 func (x *DeleteSubscriptionRequest) Reset() {
 	*x = DeleteSubscriptionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[23]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1362,7 +1749,7 @@ func (x *DeleteSubscriptionRequest) String() string {
 func (*DeleteSubscriptionRequest) ProtoMessage() {}
 
 func (x *DeleteSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[23]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1375,7 +1762,7 @@ func (x *DeleteSubscriptionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSubscriptionRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{23}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *DeleteSubscriptionRequest) GetId() uint64 {
@@ -1397,7 +1784,7 @@ type DisableSubscriptionRequest struct {
 func (x *DisableSubscriptionRequest) Reset() {
 	*x = DisableSubscriptionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[24]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1410,7 +1797,7 @@ func (x *DisableSubscriptionRequest) String() string {
 func (*DisableSubscriptionRequest) ProtoMessage() {}
 
 func (x *DisableSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[24]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1423,7 +1810,7 @@ func (x *DisableSubscriptionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisableSubscriptionRequest.ProtoReflect.Descriptor instead.
 func (*DisableSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{24}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *DisableSubscriptionRequest) GetId() uint64 {
@@ -1451,7 +1838,7 @@ type ResumeSubscriptionRequest struct {
 func (x *ResumeSubscriptionRequest) Reset() {
 	*x = ResumeSubscriptionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[25]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1464,7 +1851,7 @@ func (x *ResumeSubscriptionRequest) String() string {
 func (*ResumeSubscriptionRequest) ProtoMessage() {}
 
 func (x *ResumeSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[25]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1477,7 +1864,7 @@ func (x *ResumeSubscriptionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResumeSubscriptionRequest.ProtoReflect.Descriptor instead.
 func (*ResumeSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{25}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ResumeSubscriptionRequest) GetId() uint64 {
@@ -1500,7 +1887,7 @@ type ListSubscriptionRequest struct {
 func (x *ListSubscriptionRequest) Reset() {
 	*x = ListSubscriptionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[26]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1513,7 +1900,7 @@ func (x *ListSubscriptionRequest) String() string {
 func (*ListSubscriptionRequest) ProtoMessage() {}
 
 func (x *ListSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[26]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1526,7 +1913,7 @@ func (x *ListSubscriptionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSubscriptionRequest.ProtoReflect.Descriptor instead.
 func (*ListSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{26}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ListSubscriptionRequest) GetName() string {
@@ -1561,7 +1948,7 @@ type ListSubscriptionResponse struct {
 func (x *ListSubscriptionResponse) Reset() {
 	*x = ListSubscriptionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[27]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1574,7 +1961,7 @@ func (x *ListSubscriptionResponse) String() string {
 func (*ListSubscriptionResponse) ProtoMessage() {}
 
 func (x *ListSubscriptionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[27]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1587,7 +1974,7 @@ func (x *ListSubscriptionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSubscriptionResponse.ProtoReflect.Descriptor instead.
 func (*ListSubscriptionResponse) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{27}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ListSubscriptionResponse) GetSubscription() []*meta.Subscription {
@@ -1609,7 +1996,7 @@ type SetDeadLetterEventOffsetRequest struct {
 func (x *SetDeadLetterEventOffsetRequest) Reset() {
 	*x = SetDeadLetterEventOffsetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[28]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1622,7 +2009,7 @@ func (x *SetDeadLetterEventOffsetRequest) String() string {
 func (*SetDeadLetterEventOffsetRequest) ProtoMessage() {}
 
 func (x *SetDeadLetterEventOffsetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[28]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1635,7 +2022,7 @@ func (x *SetDeadLetterEventOffsetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetDeadLetterEventOffsetRequest.ProtoReflect.Descriptor instead.
 func (*SetDeadLetterEventOffsetRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{28}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *SetDeadLetterEventOffsetRequest) GetSubscriptionId() uint64 {
@@ -1663,7 +2050,7 @@ type GetDeadLetterEventOffsetRequest struct {
 func (x *GetDeadLetterEventOffsetRequest) Reset() {
 	*x = GetDeadLetterEventOffsetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[29]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1676,7 +2063,7 @@ func (x *GetDeadLetterEventOffsetRequest) String() string {
 func (*GetDeadLetterEventOffsetRequest) ProtoMessage() {}
 
 func (x *GetDeadLetterEventOffsetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[29]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1689,7 +2076,7 @@ func (x *GetDeadLetterEventOffsetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDeadLetterEventOffsetRequest.ProtoReflect.Descriptor instead.
 func (*GetDeadLetterEventOffsetRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{29}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GetDeadLetterEventOffsetRequest) GetSubscriptionId() uint64 {
@@ -1710,7 +2097,7 @@ type GetDeadLetterEventOffsetResponse struct {
 func (x *GetDeadLetterEventOffsetResponse) Reset() {
 	*x = GetDeadLetterEventOffsetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[30]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1723,7 +2110,7 @@ func (x *GetDeadLetterEventOffsetResponse) String() string {
 func (*GetDeadLetterEventOffsetResponse) ProtoMessage() {}
 
 func (x *GetDeadLetterEventOffsetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[30]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1736,7 +2123,7 @@ func (x *GetDeadLetterEventOffsetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDeadLetterEventOffsetResponse.ProtoReflect.Descriptor instead.
 func (*GetDeadLetterEventOffsetResponse) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{30}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GetDeadLetterEventOffsetResponse) GetOffset() uint64 {
@@ -1757,7 +2144,7 @@ type RegisterTriggerWorkerRequest struct {
 func (x *RegisterTriggerWorkerRequest) Reset() {
 	*x = RegisterTriggerWorkerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[31]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1770,7 +2157,7 @@ func (x *RegisterTriggerWorkerRequest) String() string {
 func (*RegisterTriggerWorkerRequest) ProtoMessage() {}
 
 func (x *RegisterTriggerWorkerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[31]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1783,7 +2170,7 @@ func (x *RegisterTriggerWorkerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterTriggerWorkerRequest.ProtoReflect.Descriptor instead.
 func (*RegisterTriggerWorkerRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{31}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *RegisterTriggerWorkerRequest) GetAddress() string {
@@ -1802,7 +2189,7 @@ type RegisterTriggerWorkerResponse struct {
 func (x *RegisterTriggerWorkerResponse) Reset() {
 	*x = RegisterTriggerWorkerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[32]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1815,7 +2202,7 @@ func (x *RegisterTriggerWorkerResponse) String() string {
 func (*RegisterTriggerWorkerResponse) ProtoMessage() {}
 
 func (x *RegisterTriggerWorkerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[32]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1828,7 +2215,7 @@ func (x *RegisterTriggerWorkerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterTriggerWorkerResponse.ProtoReflect.Descriptor instead.
 func (*RegisterTriggerWorkerResponse) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{32}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{37}
 }
 
 type UnregisterTriggerWorkerRequest struct {
@@ -1842,7 +2229,7 @@ type UnregisterTriggerWorkerRequest struct {
 func (x *UnregisterTriggerWorkerRequest) Reset() {
 	*x = UnregisterTriggerWorkerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[33]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1855,7 +2242,7 @@ func (x *UnregisterTriggerWorkerRequest) String() string {
 func (*UnregisterTriggerWorkerRequest) ProtoMessage() {}
 
 func (x *UnregisterTriggerWorkerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[33]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1868,7 +2255,7 @@ func (x *UnregisterTriggerWorkerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnregisterTriggerWorkerRequest.ProtoReflect.Descriptor instead.
 func (*UnregisterTriggerWorkerRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{33}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *UnregisterTriggerWorkerRequest) GetAddress() string {
@@ -1887,7 +2274,7 @@ type UnregisterTriggerWorkerResponse struct {
 func (x *UnregisterTriggerWorkerResponse) Reset() {
 	*x = UnregisterTriggerWorkerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[34]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1900,7 +2287,232 @@ func (x *UnregisterTriggerWorkerResponse) String() string {
 func (*UnregisterTriggerWorkerResponse) ProtoMessage() {}
 
 func (x *UnregisterTriggerWorkerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[34]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterTriggerWorkerResponse.ProtoReflect.Descriptor instead.
+func (*UnregisterTriggerWorkerResponse) Descriptor() ([]byte, []int) {
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{39}
+}
+
+type TriggerWorkerHeartbeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address          string                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Started          bool                     `protobuf:"varint,2,opt,name=started,proto3" json:"started,omitempty"`
+	SubscriptionInfo []*meta.SubscriptionInfo `protobuf:"bytes,3,rep,name=subscription_info,json=subscriptionInfo,proto3" json:"subscription_info,omitempty"`
+	SubscriptionLoad []*SubscriptionLoad      `protobuf:"bytes,4,rep,name=subscription_load,json=subscriptionLoad,proto3" json:"subscription_load,omitempty"`
+}
+
+func (x *TriggerWorkerHeartbeatRequest) Reset() {
+	*x = TriggerWorkerHeartbeatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerWorkerHeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerWorkerHeartbeatRequest) ProtoMessage() {}
+
+func (x *TriggerWorkerHeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerWorkerHeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*TriggerWorkerHeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TriggerWorkerHeartbeatRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TriggerWorkerHeartbeatRequest) GetStarted() bool {
+	if x != nil {
+		return x.Started
+	}
+	return false
+}
+
+func (x *TriggerWorkerHeartbeatRequest) GetSubscriptionInfo() []*meta.SubscriptionInfo {
+	if x != nil {
+		return x.SubscriptionInfo
+	}
+	return nil
+}
+
+func (x *TriggerWorkerHeartbeatRequest) GetSubscriptionLoad() []*SubscriptionLoad {
+	if x != nil {
+		return x.SubscriptionLoad
+	}
+	return nil
+}
+
+type SubscriptionLoad struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// events per second received since the previous heartbeat.
+	Throughput float64 `protobuf:"fixed64,2,opt,name=throughput,proto3" json:"throughput,omitempty"`
+	// events read from the eventbus but not yet delivered.
+	Lag uint64 `protobuf:"varint,3,opt,name=lag,proto3" json:"lag,omitempty"`
+	// goroutines currently running in the delivery pool.
+	Goroutines int32 `protobuf:"varint,4,opt,name=goroutines,proto3" json:"goroutines,omitempty"`
+}
+
+func (x *SubscriptionLoad) Reset() {
+	*x = SubscriptionLoad{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscriptionLoad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionLoad) ProtoMessage() {}
+
+func (x *SubscriptionLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionLoad.ProtoReflect.Descriptor instead.
+func (*SubscriptionLoad) Descriptor() ([]byte, []int) {
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SubscriptionLoad) GetSubscriptionId() uint64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *SubscriptionLoad) GetThroughput() float64 {
+	if x != nil {
+		return x.Throughput
+	}
+	return 0
+}
+
+func (x *SubscriptionLoad) GetLag() uint64 {
+	if x != nil {
+		return x.Lag
+	}
+	return 0
+}
+
+func (x *SubscriptionLoad) GetGoroutines() int32 {
+	if x != nil {
+		return x.Goroutines
+	}
+	return 0
+}
+
+type TriggerWorkerHeartbeatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TriggerWorkerHeartbeatResponse) Reset() {
+	*x = TriggerWorkerHeartbeatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerWorkerHeartbeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerWorkerHeartbeatResponse) ProtoMessage() {}
+
+func (x *TriggerWorkerHeartbeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerWorkerHeartbeatResponse.ProtoReflect.Descriptor instead.
+func (*TriggerWorkerHeartbeatResponse) Descriptor() ([]byte, []int) {
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{42}
+}
+
+type ResetOffsetToTimestampRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// utc time milliseconds
+	Timestamp uint64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *ResetOffsetToTimestampRequest) Reset() {
+	*x = ResetOffsetToTimestampRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetOffsetToTimestampRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetOffsetToTimestampRequest) ProtoMessage() {}
+
+func (x *ResetOffsetToTimestampRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1908,41 +2520,53 @@ func (x *UnregisterTriggerWorkerResponse) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetOffsetToTimestampRequest.ProtoReflect.Descriptor instead.
+func (*ResetOffsetToTimestampRequest) Descriptor() ([]byte, []int) {
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ResetOffsetToTimestampRequest) GetSubscriptionId() uint64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
 }
 
-// Deprecated: Use UnregisterTriggerWorkerResponse.ProtoReflect.Descriptor instead.
-func (*UnregisterTriggerWorkerResponse) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{34}
+func (x *ResetOffsetToTimestampRequest) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
 }
 
-type TriggerWorkerHeartbeatRequest struct {
+type ResetOffsetToTimestampResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address          string                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Started          bool                     `protobuf:"varint,2,opt,name=started,proto3" json:"started,omitempty"`
-	SubscriptionInfo []*meta.SubscriptionInfo `protobuf:"bytes,3,rep,name=subscription_info,json=subscriptionInfo,proto3" json:"subscription_info,omitempty"`
+	Offsets []*meta.OffsetInfo `protobuf:"bytes,1,rep,name=offsets,proto3" json:"offsets,omitempty"`
 }
 
-func (x *TriggerWorkerHeartbeatRequest) Reset() {
-	*x = TriggerWorkerHeartbeatRequest{}
+func (x *ResetOffsetToTimestampResponse) Reset() {
+	*x = ResetOffsetToTimestampResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[35]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TriggerWorkerHeartbeatRequest) String() string {
+func (x *ResetOffsetToTimestampResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TriggerWorkerHeartbeatRequest) ProtoMessage() {}
+func (*ResetOffsetToTimestampResponse) ProtoMessage() {}
 
-func (x *TriggerWorkerHeartbeatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[35]
+func (x *ResetOffsetToTimestampResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1953,55 +2577,43 @@ func (x *TriggerWorkerHeartbeatRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TriggerWorkerHeartbeatRequest.ProtoReflect.Descriptor instead.
-func (*TriggerWorkerHeartbeatRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *TriggerWorkerHeartbeatRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *TriggerWorkerHeartbeatRequest) GetStarted() bool {
-	if x != nil {
-		return x.Started
-	}
-	return false
+// Deprecated: Use ResetOffsetToTimestampResponse.ProtoReflect.Descriptor instead.
+func (*ResetOffsetToTimestampResponse) Descriptor() ([]byte, []int) {
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *TriggerWorkerHeartbeatRequest) GetSubscriptionInfo() []*meta.SubscriptionInfo {
+func (x *ResetOffsetToTimestampResponse) GetOffsets() []*meta.OffsetInfo {
 	if x != nil {
-		return x.SubscriptionInfo
+		return x.Offsets
 	}
 	return nil
 }
 
-type TriggerWorkerHeartbeatResponse struct {
+type GetSubscriptionLagRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
 }
 
-func (x *TriggerWorkerHeartbeatResponse) Reset() {
-	*x = TriggerWorkerHeartbeatResponse{}
+func (x *GetSubscriptionLagRequest) Reset() {
+	*x = GetSubscriptionLagRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[36]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TriggerWorkerHeartbeatResponse) String() string {
+func (x *GetSubscriptionLagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TriggerWorkerHeartbeatResponse) ProtoMessage() {}
+func (*GetSubscriptionLagRequest) ProtoMessage() {}
 
-func (x *TriggerWorkerHeartbeatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[36]
+func (x *GetSubscriptionLagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2012,38 +2624,49 @@ func (x *TriggerWorkerHeartbeatResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TriggerWorkerHeartbeatResponse.ProtoReflect.Descriptor instead.
-func (*TriggerWorkerHeartbeatResponse) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use GetSubscriptionLagRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionLagRequest) Descriptor() ([]byte, []int) {
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{45}
 }
 
-type ResetOffsetToTimestampRequest struct {
+func (x *GetSubscriptionLagRequest) GetSubscriptionId() uint64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+type EventlogLag struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
-	// utc time milliseconds
-	Timestamp uint64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	EventlogId      uint64 `protobuf:"varint,1,opt,name=eventlog_id,json=eventlogId,proto3" json:"eventlog_id,omitempty"`
+	CommittedOffset uint64 `protobuf:"varint,2,opt,name=committed_offset,json=committedOffset,proto3" json:"committed_offset,omitempty"`
+	LatestOffset    uint64 `protobuf:"varint,3,opt,name=latest_offset,json=latestOffset,proto3" json:"latest_offset,omitempty"`
+	// the number of events which haven't been consumed
+	Lag uint64 `protobuf:"varint,4,opt,name=lag,proto3" json:"lag,omitempty"`
+	// milliseconds since the first unconsumed event was written
+	LagTime uint64 `protobuf:"varint,5,opt,name=lag_time,json=lagTime,proto3" json:"lag_time,omitempty"`
 }
 
-func (x *ResetOffsetToTimestampRequest) Reset() {
-	*x = ResetOffsetToTimestampRequest{}
+func (x *EventlogLag) Reset() {
+	*x = EventlogLag{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[37]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResetOffsetToTimestampRequest) String() string {
+func (x *EventlogLag) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetOffsetToTimestampRequest) ProtoMessage() {}
+func (*EventlogLag) ProtoMessage() {}
 
-func (x *ResetOffsetToTimestampRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[37]
+func (x *EventlogLag) ProtoReflect() protoreflect.Message {
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2054,50 +2677,76 @@ func (x *ResetOffsetToTimestampRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetOffsetToTimestampRequest.ProtoReflect.Descriptor instead.
-func (*ResetOffsetToTimestampRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use EventlogLag.ProtoReflect.Descriptor instead.
+func (*EventlogLag) Descriptor() ([]byte, []int) {
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ResetOffsetToTimestampRequest) GetSubscriptionId() uint64 {
+func (x *EventlogLag) GetEventlogId() uint64 {
 	if x != nil {
-		return x.SubscriptionId
+		return x.EventlogId
 	}
 	return 0
 }
 
-func (x *ResetOffsetToTimestampRequest) GetTimestamp() uint64 {
+func (x *EventlogLag) GetCommittedOffset() uint64 {
 	if x != nil {
-		return x.Timestamp
+		return x.CommittedOffset
 	}
 	return 0
 }
 
-type ResetOffsetToTimestampResponse struct {
+func (x *EventlogLag) GetLatestOffset() uint64 {
+	if x != nil {
+		return x.LatestOffset
+	}
+	return 0
+}
+
+func (x *EventlogLag) GetLag() uint64 {
+	if x != nil {
+		return x.Lag
+	}
+	return 0
+}
+
+func (x *EventlogLag) GetLagTime() uint64 {
+	if x != nil {
+		return x.LagTime
+	}
+	return 0
+}
+
+type GetSubscriptionLagResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Offsets []*meta.OffsetInfo `protobuf:"bytes,1,rep,name=offsets,proto3" json:"offsets,omitempty"`
+	SubscriptionId uint64         `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Eventlogs      []*EventlogLag `protobuf:"bytes,2,rep,name=eventlogs,proto3" json:"eventlogs,omitempty"`
+	// the sum of lag of all eventlogs
+	Lag uint64 `protobuf:"varint,3,opt,name=lag,proto3" json:"lag,omitempty"`
+	// the max lag time of all eventlogs in milliseconds
+	LagTime uint64 `protobuf:"varint,4,opt,name=lag_time,json=lagTime,proto3" json:"lag_time,omitempty"`
 }
 
-func (x *ResetOffsetToTimestampResponse) Reset() {
-	*x = ResetOffsetToTimestampResponse{}
+func (x *GetSubscriptionLagResponse) Reset() {
+	*x = GetSubscriptionLagResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[38]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResetOffsetToTimestampResponse) String() string {
+func (x *GetSubscriptionLagResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetOffsetToTimestampResponse) ProtoMessage() {}
+func (*GetSubscriptionLagResponse) ProtoMessage() {}
 
-func (x *ResetOffsetToTimestampResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[38]
+func (x *GetSubscriptionLagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2108,18 +2757,39 @@ func (x *ResetOffsetToTimestampResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetOffsetToTimestampResponse.ProtoReflect.Descriptor instead.
-func (*ResetOffsetToTimestampResponse) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use GetSubscriptionLagResponse.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionLagResponse) Descriptor() ([]byte, []int) {
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ResetOffsetToTimestampResponse) GetOffsets() []*meta.OffsetInfo {
+func (x *GetSubscriptionLagResponse) GetSubscriptionId() uint64 {
 	if x != nil {
-		return x.Offsets
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *GetSubscriptionLagResponse) GetEventlogs() []*EventlogLag {
+	if x != nil {
+		return x.Eventlogs
 	}
 	return nil
 }
 
+func (x *GetSubscriptionLagResponse) GetLag() uint64 {
+	if x != nil {
+		return x.Lag
+	}
+	return 0
+}
+
+func (x *GetSubscriptionLagResponse) GetLagTime() uint64 {
+	if x != nil {
+		return x.LagTime
+	}
+	return 0
+}
+
 type CommitOffsetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2132,7 +2802,7 @@ type CommitOffsetRequest struct {
 func (x *CommitOffsetRequest) Reset() {
 	*x = CommitOffsetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[39]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2145,7 +2815,7 @@ func (x *CommitOffsetRequest) String() string {
 func (*CommitOffsetRequest) ProtoMessage() {}
 
 func (x *CommitOffsetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[39]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2158,7 +2828,7 @@ func (x *CommitOffsetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommitOffsetRequest.ProtoReflect.Descriptor instead.
 func (*CommitOffsetRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{39}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *CommitOffsetRequest) GetSubscriptionInfo() []*meta.SubscriptionInfo {
@@ -2186,7 +2856,7 @@ type CommitOffsetResponse struct {
 func (x *CommitOffsetResponse) Reset() {
 	*x = CommitOffsetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[40]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2199,7 +2869,7 @@ func (x *CommitOffsetResponse) String() string {
 func (*CommitOffsetResponse) ProtoMessage() {}
 
 func (x *CommitOffsetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[40]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2212,7 +2882,7 @@ func (x *CommitOffsetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommitOffsetResponse.ProtoReflect.Descriptor instead.
 func (*CommitOffsetResponse) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{40}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *CommitOffsetResponse) GetFailSubscriptionId() []uint64 {
@@ -2242,7 +2912,7 @@ type ListSegmentRequest struct {
 func (x *ListSegmentRequest) Reset() {
 	*x = ListSegmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[41]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2255,7 +2925,7 @@ func (x *ListSegmentRequest) String() string {
 func (*ListSegmentRequest) ProtoMessage() {}
 
 func (x *ListSegmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[41]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2268,7 +2938,7 @@ func (x *ListSegmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSegmentRequest.ProtoReflect.Descriptor instead.
 func (*ListSegmentRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{41}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *ListSegmentRequest) GetEventbusId() uint64 {
@@ -2317,7 +2987,7 @@ type ListSegmentResponse struct {
 func (x *ListSegmentResponse) Reset() {
 	*x = ListSegmentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[42]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2330,7 +3000,7 @@ func (x *ListSegmentResponse) String() string {
 func (*ListSegmentResponse) ProtoMessage() {}
 
 func (x *ListSegmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[42]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2343,7 +3013,7 @@ func (x *ListSegmentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSegmentResponse.ProtoReflect.Descriptor instead.
 func (*ListSegmentResponse) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{42}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ListSegmentResponse) GetSegments() []*meta.Segment {
@@ -2367,7 +3037,7 @@ type GetAppendableSegmentRequest struct {
 func (x *GetAppendableSegmentRequest) Reset() {
 	*x = GetAppendableSegmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[43]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2380,7 +3050,7 @@ func (x *GetAppendableSegmentRequest) String() string {
 func (*GetAppendableSegmentRequest) ProtoMessage() {}
 
 func (x *GetAppendableSegmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[43]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2393,7 +3063,7 @@ func (x *GetAppendableSegmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAppendableSegmentRequest.ProtoReflect.Descriptor instead.
 func (*GetAppendableSegmentRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{43}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *GetAppendableSegmentRequest) GetEventbusId() uint64 {
@@ -2428,7 +3098,7 @@ type GetAppendableSegmentResponse struct {
 func (x *GetAppendableSegmentResponse) Reset() {
 	*x = GetAppendableSegmentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[44]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2441,7 +3111,7 @@ func (x *GetAppendableSegmentResponse) String() string {
 func (*GetAppendableSegmentResponse) ProtoMessage() {}
 
 func (x *GetAppendableSegmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[44]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2454,7 +3124,7 @@ func (x *GetAppendableSegmentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAppendableSegmentResponse.ProtoReflect.Descriptor instead.
 func (*GetAppendableSegmentResponse) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{44}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *GetAppendableSegmentResponse) GetSegments() []*meta.Segment {
@@ -2476,7 +3146,7 @@ type CreateUserRequest struct {
 func (x *CreateUserRequest) Reset() {
 	*x = CreateUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[45]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2489,7 +3159,7 @@ func (x *CreateUserRequest) String() string {
 func (*CreateUserRequest) ProtoMessage() {}
 
 func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[45]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2502,7 +3172,7 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{45}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *CreateUserRequest) GetIdentifier() string {
@@ -2530,7 +3200,7 @@ type DeleteUserResponse struct {
 func (x *DeleteUserResponse) Reset() {
 	*x = DeleteUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[46]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2543,7 +3213,7 @@ func (x *DeleteUserResponse) String() string {
 func (*DeleteUserResponse) ProtoMessage() {}
 
 func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[46]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2556,7 +3226,7 @@ func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
 func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{46}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *DeleteUserResponse) GetIdentifier() string {
@@ -2577,7 +3247,7 @@ type ListUserResponse struct {
 func (x *ListUserResponse) Reset() {
 	*x = ListUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[47]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2590,7 +3260,7 @@ func (x *ListUserResponse) String() string {
 func (*ListUserResponse) ProtoMessage() {}
 
 func (x *ListUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[47]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2603,7 +3273,7 @@ func (x *ListUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserResponse.ProtoReflect.Descriptor instead.
 func (*ListUserResponse) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{47}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *ListUserResponse) GetUsers() []*meta.User {
@@ -2625,7 +3295,7 @@ type CreateTokenRequest struct {
 func (x *CreateTokenRequest) Reset() {
 	*x = CreateTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[48]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2638,7 +3308,7 @@ func (x *CreateTokenRequest) String() string {
 func (*CreateTokenRequest) ProtoMessage() {}
 
 func (x *CreateTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[48]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2651,7 +3321,7 @@ func (x *CreateTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTokenRequest.ProtoReflect.Descriptor instead.
 func (*CreateTokenRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{48}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *CreateTokenRequest) GetUserIdentifier() string {
@@ -2679,7 +3349,7 @@ type DeleteTokenRequest struct {
 func (x *DeleteTokenRequest) Reset() {
 	*x = DeleteTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[49]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2692,7 +3362,7 @@ func (x *DeleteTokenRequest) String() string {
 func (*DeleteTokenRequest) ProtoMessage() {}
 
 func (x *DeleteTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[49]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2705,7 +3375,7 @@ func (x *DeleteTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTokenRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTokenRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{49}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *DeleteTokenRequest) GetId() uint64 {
@@ -2726,7 +3396,7 @@ type GetTokenResponse struct {
 func (x *GetTokenResponse) Reset() {
 	*x = GetTokenResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[50]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2739,7 +3409,7 @@ func (x *GetTokenResponse) String() string {
 func (*GetTokenResponse) ProtoMessage() {}
 
 func (x *GetTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[50]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2752,7 +3422,7 @@ func (x *GetTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTokenResponse.ProtoReflect.Descriptor instead.
 func (*GetTokenResponse) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{50}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *GetTokenResponse) GetToken() []*meta.Token {
@@ -2773,7 +3443,7 @@ type ListTokenResponse struct {
 func (x *ListTokenResponse) Reset() {
 	*x = ListTokenResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[51]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2786,7 +3456,7 @@ func (x *ListTokenResponse) String() string {
 func (*ListTokenResponse) ProtoMessage() {}
 
 func (x *ListTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[51]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2799,7 +3469,7 @@ func (x *ListTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTokenResponse.ProtoReflect.Descriptor instead.
 func (*ListTokenResponse) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{51}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *ListTokenResponse) GetToken() []*meta.Token {
@@ -2826,7 +3496,7 @@ type RoleRequest struct {
 func (x *RoleRequest) Reset() {
 	*x = RoleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[52]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2839,7 +3509,7 @@ func (x *RoleRequest) String() string {
 func (*RoleRequest) ProtoMessage() {}
 
 func (x *RoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[52]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2852,7 +3522,7 @@ func (x *RoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoleRequest.ProtoReflect.Descriptor instead.
 func (*RoleRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{52}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *RoleRequest) GetUserIdentifier() string {
@@ -2901,7 +3571,7 @@ type GetUserRoleRequest struct {
 func (x *GetUserRoleRequest) Reset() {
 	*x = GetUserRoleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[53]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2914,7 +3584,7 @@ func (x *GetUserRoleRequest) String() string {
 func (*GetUserRoleRequest) ProtoMessage() {}
 
 func (x *GetUserRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[53]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2927,7 +3597,7 @@ func (x *GetUserRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRoleRequest.ProtoReflect.Descriptor instead.
 func (*GetUserRoleRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{53}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *GetUserRoleRequest) GetUserIdentifier() string {
@@ -2948,7 +3618,7 @@ type GetUserRoleResponse struct {
 func (x *GetUserRoleResponse) Reset() {
 	*x = GetUserRoleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[54]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2961,7 +3631,7 @@ func (x *GetUserRoleResponse) String() string {
 func (*GetUserRoleResponse) ProtoMessage() {}
 
 func (x *GetUserRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[54]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2974,7 +3644,7 @@ func (x *GetUserRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRoleResponse.ProtoReflect.Descriptor instead.
 func (*GetUserRoleResponse) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{54}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *GetUserRoleResponse) GetUserRole() []*meta.UserRole {
@@ -2996,7 +3666,7 @@ type GetResourceRoleRequest struct {
 func (x *GetResourceRoleRequest) Reset() {
 	*x = GetResourceRoleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[55]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3009,7 +3679,7 @@ func (x *GetResourceRoleRequest) String() string {
 func (*GetResourceRoleRequest) ProtoMessage() {}
 
 func (x *GetResourceRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[55]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3022,7 +3692,7 @@ func (x *GetResourceRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetResourceRoleRequest.ProtoReflect.Descriptor instead.
 func (*GetResourceRoleRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{55}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *GetResourceRoleRequest) GetId() uint64 {
@@ -3050,7 +3720,7 @@ type GetResourceRoleResponse struct {
 func (x *GetResourceRoleResponse) Reset() {
 	*x = GetResourceRoleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_controller_controller_proto_msgTypes[56]
+		mi := &file_vanus_core_controller_controller_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3063,7 +3733,7 @@ func (x *GetResourceRoleResponse) String() string {
 func (*GetResourceRoleResponse) ProtoMessage() {}
 
 func (x *GetResourceRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_controller_controller_proto_msgTypes[56]
+	mi := &file_vanus_core_controller_controller_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3076,7 +3746,7 @@ func (x *GetResourceRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetResourceRoleResponse.ProtoReflect.Descriptor instead.
 func (*GetResourceRoleResponse) Descriptor() ([]byte, []int) {
-	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{56}
+	return file_vanus_core_controller_controller_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *GetResourceRoleResponse) GetResourceRole() []*meta.ResourceRole {
@@ -3124,7 +3794,7 @@ var file_vanus_core_controller_controller_proto_rawDesc = []byte{
 	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
 	0x02, 0x69, 0x64, 0x22, 0x28, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d,
 	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x9f, 0x01,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0xb2, 0x02,
 	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c,
@@ -3134,647 +3804,778 @@ var file_vanus_core_controller_controller_proto_rawDesc = []byte{
 	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
 	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
 	0x28, 0x04, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x38, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x14, 0x4c, 0x69, 0x73,
-	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x52, 0x08,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x22, 0x6d, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x57, 0x69, 0x74, 0x68, 0x48, 0x75, 0x6d, 0x61, 0x6e,
-	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x62, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x1e, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x1f, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xc3, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61,
-	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0b, 0x68, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74,
-	0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64,
-	0x72, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x71, 0x0a, 0x1c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a,
-	0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
-	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x61,
-	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x22, 0xf9, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x76, 0x61, 0x6e,
-	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
-	0x65, 0x72, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08,
-	0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x76, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x49, 0x64, 0x1a, 0x55, 0x0a, 0x0d, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x01,
-	0x10, 0x02, 0x22, 0x5d, 0x0a, 0x1e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b,
-	0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x01, 0x10,
-	0x02, 0x22, 0x21, 0x0a, 0x1f, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6c, 0x0a, 0x1a, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x65,
-	0x72, 0x6d, 0x22, 0xf1, 0x04, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x31, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
-	0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x6e, 0x6b,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x6e, 0x6b, 0x12, 0x48, 0x0a, 0x0f,
-	0x73, 0x69, 0x6e, 0x6b, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x69, 0x6e, 0x6b, 0x43, 0x72, 0x65, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0e, 0x73, 0x69, 0x6e, 0x6b, 0x43, 0x72, 0x65, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x35, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x4d, 0x0a,
-	0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x3e, 0x0a, 0x0b,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d,
-	0x65, 0x74, 0x61, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x52,
-	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x49, 0x64, 0x12, 0x21, 0x0a,
-	0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64,
-	0x4a, 0x04, 0x08, 0x09, 0x10, 0x0a, 0x22, 0x7b, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x3e, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x51, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x61, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x76, 0x61,
+	0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52,
+	0x11, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x22, 0x38, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75,
+	0x73, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x22, 0x6d, 0x0a, 0x23, 0x47,
+	0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x57, 0x69, 0x74, 0x68, 0x48, 0x75,
+	0x6d, 0x61, 0x6e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75,
+	0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xac, 0x02, 0x0a, 0x15, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x6c,
+	0x6f, 0x67, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x6c, 0x6f, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x56, 0x0a, 0x12, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x48, 0x01, 0x52, 0x11, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x88,
+	0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x5f,
+	0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x22, 0x1e, 0x0a, 0x1c, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1f, 0x0a, 0x1d, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x17, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02,
+	0x22, 0x1a, 0x0a, 0x18, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74,
+	0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbb, 0x01, 0x0a,
+	0x0e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22,
+	0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x74, 0x6f, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x18, 0x52, 0x65,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x76, 0x61, 0x6e, 0x75,
 	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x7b, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62,
+	0x72, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x22, 0x50, 0x0a, 0x19, 0x44,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x22, 0x9e, 0x01,
+	0x0a, 0x1a, 0x44, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x72, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x72, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xb4,
+	0x01, 0x0a, 0x1c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x12, 0x41, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x08, 0x74, 0x6f, 0x70,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x22, 0x4c, 0x0a, 0x0e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x54,
+	0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
+	0x6f, 0x73, 0x74, 0x22, 0xf9, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x73, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x49, 0x64, 0x1a, 0x55, 0x0a, 0x0d, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22,
+	0x5d, 0x0a, 0x1e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x21,
+	0x0a, 0x1f, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x6c, 0x0a, 0x1a, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x22,
+	0xf1, 0x04, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x31, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x6e, 0x6b, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x6e, 0x6b, 0x12, 0x48, 0x0a, 0x0f, 0x73, 0x69, 0x6e,
+	0x6b, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x69, 0x6e, 0x6b, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x52, 0x0e, 0x73, 0x69, 0x6e, 0x6b, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x12, 0x35, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x4d, 0x0a, 0x11, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x3e, 0x0a, 0x0b, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x52, 0x0b, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x62, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x4a, 0x04, 0x08,
+	0x09, 0x10, 0x0a, 0x22, 0x7b, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62,
 	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
 	0x12, 0x4e, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
 	0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x53,
 	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x28, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2b, 0x0a, 0x19, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x7b, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4e, 0x0a,
+	0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x28, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x52, 0x0a, 0x1a, 0x44, 0x69, 0x73, 0x61, 0x62,
-	0x6c, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2b, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x52, 0x0a, 0x1a, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x65, 0x63, 0x6c, 0x61,
+	0x72, 0x61, 0x74, 0x69, 0x76, 0x65, 0x6c, 0x79, 0x22, 0x2b, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x61,
-	0x74, 0x69, 0x76, 0x65, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x65,
-	0x63, 0x6c, 0x61, 0x72, 0x61, 0x74, 0x69, 0x76, 0x65, 0x6c, 0x79, 0x22, 0x2b, 0x0a, 0x19, 0x52,
-	0x65, 0x73, 0x75, 0x6d, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x77, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x62, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x01, 0x10,
-	0x02, 0x22, 0x5d, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
-	0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x62, 0x0a, 0x1f, 0x53, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
-	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x22, 0x4a, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c,
-	0x65, 0x74, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x22, 0x3a, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
-	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x38, 0x0a, 0x1c,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x1f, 0x0a, 0x1d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x1e, 0x55, 0x6e, 0x72, 0x65, 0x67,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x77, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x62, 0x75, 0x73, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x5d,
+	0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65,
+	0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x62, 0x0a,
+	0x1f, 0x53, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x22, 0x4a, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x3a, 0x0a,
+	0x20, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x38, 0x0a, 0x1c, 0x52, 0x65, 0x67,
 	0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b,
 	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
 	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0x21, 0x0a, 0x1f, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa3, 0x01, 0x0a, 0x1d, 0x54, 0x72, 0x69, 0x67, 0x67,
-	0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x11,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66,
-	0x6f, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x20, 0x0a, 0x1e,
-	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x65, 0x61,
-	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x66,
-	0x0a, 0x1d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x65, 0x73, 0x73, 0x22, 0x1f, 0x0a, 0x1d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x1e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x21, 0x0a, 0x1f, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xf9, 0x01, 0x0a, 0x1d, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x11, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x54, 0x0a, 0x11, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x10, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x22,
+	0x8d, 0x01, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x6f, 0x61, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6c, 0x61, 0x67, 0x12,
+	0x1e, 0x0a, 0x0a, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x22,
+	0x20, 0x0a, 0x1e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x66, 0x0a, 0x1d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x54, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x57, 0x0a, 0x1e, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x73, 0x22, 0x44, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x57, 0x0a, 0x1e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x61, 0x6e, 0x75,
-	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x4f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x22,
-	0x88, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x11, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x63, 0x65,
-	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66,
-	0x6f, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x48, 0x0a, 0x14, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04,
-	0x52, 0x12, 0x66, 0x61, 0x69, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x22, 0xb2, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x67,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x49, 0x64, 0x12, 0x21, 0x0a,
-	0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x22, 0x4b, 0x0a, 0x13, 0x4c, 0x69, 0x73,
-	0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x34, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x79, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70,
-	0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75,
-	0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x62, 0x75, 0x73, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x6c,
-	0x6f, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65,
-	0x64, 0x22, 0x54, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62,
-	0x6c, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x34, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x55, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x34,
-	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x22, 0x3f, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x5f, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x24, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x40, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2c, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74,
-	0x61, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x41,
-	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x22, 0xb2, 0x01, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f,
-	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c,
-	0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
-	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6b, 0x69,
-	0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x22, 0x3d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x4d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x09,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74,
-	0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x52, 0x6f, 0x6c, 0x65, 0x22, 0x3c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69,
-	0x6e, 0x64, 0x22, 0x5d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a,
-	0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
-	0x6f, 0x6c, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x6f, 0x6c,
-	0x65, 0x32, 0x51, 0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12,
-	0x43, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x23, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0xd7, 0x03, 0x0a, 0x13, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x5c, 0x0a, 0x0f,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
-	0x2d, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
-	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61,
-	0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x0d, 0x4c, 0x69,
-	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x2c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x56, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x12, 0x2a, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
-	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e,
-	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x58, 0x0a, 0x0f, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x2d, 0x2e, 0x76,
-	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x59, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x57, 0x69, 0x74, 0x68, 0x48, 0x75, 0x6d, 0x61, 0x6e, 0x46, 0x72, 0x69, 0x65,
-	0x6e, 0x64, 0x6c, 0x79, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x1a, 0x1a, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x9b,
-	0x05, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x59, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x12, 0x2c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73,
-	0x12, 0x5f, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x12, 0x2c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75,
-	0x73, 0x12, 0x46, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x62, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x0b, 0x47, 0x65, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36,
-	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x19, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75,
-	0x73, 0x12, 0x67, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75,
-	0x73, 0x12, 0x2a, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xab, 0x01, 0x0a, 0x0b, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x4c, 0x61, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6c, 0x61, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x6c,
+	0x61, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c,
+	0x61, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xb4, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x40,
+	0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x6c,
+	0x6f, 0x67, 0x4c, 0x61, 0x67, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x73,
+	0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6c,
+	0x61, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x61, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x88, 0x01,
+	0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x11, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65,
+	0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x10, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x48, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x30, 0x0a, 0x14, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x12,
+	0x66, 0x61, 0x69, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x22, 0xb2, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x62, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x22, 0x4b, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34,
+	0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65,
+	0x74, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x79, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x65, 0x6e,
+	0x64, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62,
+	0x75, 0x73, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x6c, 0x6f, 0x67, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x22,
+	0x54, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x34, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d,
+	0x65, 0x74, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x55, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x34, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x22, 0x3f, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x22, 0x5f, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x24, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x40, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x41, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2c, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65,
+	0x74, 0x61, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xb2, 0x01, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x27, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x4b, 0x69, 0x6e, 0x64, 0x22, 0x3d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x22, 0x4d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x52, 0x6f,
+	0x6c, 0x65, 0x22, 0x3c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x22, 0x5d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x32,
+	0x51, 0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x43, 0x0a,
+	0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23, 0x2e,
 	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62,
-	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x12, 0x2c, 0x2e, 0x76,
-	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x62, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x61, 0x6e,
-	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x62, 0x75, 0x73, 0x12, 0x75, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x62, 0x75, 0x73, 0x57, 0x69, 0x74, 0x68, 0x48, 0x75, 0x6d, 0x61, 0x6e, 0x46, 0x72, 0x69,
-	0x65, 0x6e, 0x64, 0x6c, 0x79, 0x12, 0x3a, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65,
-	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x57, 0x69, 0x74, 0x68, 0x48, 0x75, 0x6d,
-	0x61, 0x6e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x19, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d,
-	0x65, 0x74, 0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x32, 0xfb, 0x01, 0x0a,
-	0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x6c, 0x65, 0x72, 0x12, 0x64, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x29, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0xd7, 0x03, 0x0a, 0x13, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x5c, 0x0a, 0x0f, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x2d, 0x2e,
 	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x32, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70,
-	0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe5, 0x05, 0x0a, 0x11, 0x53,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
-	0x12, 0x82, 0x01, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x33, 0x2e, 0x76, 0x61, 0x6e,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x2c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x56, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x2a, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x61,
+	0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x58, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x2d, 0x2e, 0x76, 0x61, 0x6e,
 	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
-	0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x34, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x67,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x10, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x2e, 0x2e, 0x76, 0x61, 0x6e, 0x75,
-	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x72, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
-	0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x76, 0x61, 0x6e, 0x75,
-	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x72, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
-	0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x82, 0x01, 0x0a,
-	0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x33, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x76, 0x61,
+	0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x59, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x57, 0x69, 0x74, 0x68, 0x48, 0x75, 0x6d, 0x61, 0x6e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x6c, 0x79, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x1a, 0x1a, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65,
+	0x74, 0x61, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x32, 0x9b, 0x05, 0x0a,
+	0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x12, 0x59, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x62, 0x75, 0x73, 0x12, 0x2c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x12, 0x5f,
+	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x12, 0x2c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x12,
+	0x46, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75,
+	0x73, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x1a, 0x19, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x12,
+	0x67, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x12,
+	0x2a, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x62, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x76, 0x61,
 	0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x88, 0x01, 0x0a, 0x17, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x35, 0x2e,
-	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x72,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x18,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x49, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x2e, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x12, 0x2c, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x62, 0x75, 0x73, 0x12, 0x75, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62,
+	0x75, 0x73, 0x57, 0x69, 0x74, 0x68, 0x48, 0x75, 0x6d, 0x61, 0x6e, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x6c, 0x79, 0x12, 0x3a, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x57, 0x69, 0x74, 0x68, 0x48, 0x75, 0x6d, 0x61, 0x6e,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74,
+	0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x32, 0xfb, 0x01, 0x0a, 0x12, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x12, 0x64, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x29, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x76, 0x61,
+	0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x70,
+	0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x32, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x65, 0x6e,
+	0x64, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x70, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xbd, 0x07, 0x0a, 0x11, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x82,
+	0x01, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x33, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
 	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
-	0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x60, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x31, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x10, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65,
+	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x2e, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
 	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x32, 0xe8, 0x0c, 0x0a, 0x11, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x67, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30,
-	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1d, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65,
-	0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x00, 0x12, 0x67, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
 	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x61, 0x6e, 0x75,
-	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x12, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x30, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x13,
-	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x82, 0x01, 0x0a, 0x15, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x12, 0x33, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x88, 0x01, 0x0a, 0x17, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x35, 0x2e, 0x76, 0x61,
+	0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x36, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x18, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x49, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x2e, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5e,
-	0x0a, 0x12, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x60,
+	0x0a, 0x13, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x31, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x5b, 0x0a, 0x10, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2f, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a,
+	0x12, 0x44, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x12, 0x30, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe3, 0x0d, 0x0a, 0x11, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x67,
+	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
 	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73,
-	0x75, 0x6d, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x61,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x2d, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1d, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65,
-	0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x00, 0x12, 0x75, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x87, 0x01, 0x0a, 0x16, 0x54, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62,
-	0x65, 0x61, 0x74, 0x12, 0x34, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
-	0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74,
+	0x61, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00,
+	0x12, 0x60, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x60, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x76, 0x61, 0x6e, 0x75,
 	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x72, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48,
-	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x28, 0x01, 0x12, 0x82, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x33, 0x2e, 0x76,
-	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x34, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x17, 0x55, 0x6e, 0x72, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x12, 0x35, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x72, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x76, 0x61, 0x6e,
+	0x72, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x5e, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x2e, 0x76, 0x61, 0x6e,
 	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
-	0x65, 0x72, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x54, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x34, 0x2e,
-	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x54, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x61, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x76, 0x61,
+	0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x76, 0x61,
+	0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x87,
+	0x01, 0x0a, 0x16, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x34, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48,
+	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x35, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x82, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x12, 0x33, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x88, 0x01,
+	0x0a, 0x17, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x35, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x36, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x34, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
 	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x65,
 	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x0c, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x2a, 0x2e, 0x76, 0x61, 0x6e,
-	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
-	0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
-	0x74, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
-	0x36, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c,
-	0x65, 0x74, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x8b, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
-	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x36, 0x2e, 0x76,
+	0x6d, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x67, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x2a, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x76,
 	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
-	0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x18, 0x53, 0x65, 0x74,
 	0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xee, 0x01,
-	0x0a, 0x13, 0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x12, 0x44, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x36, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65,
+	0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x8b, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61,
+	0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x36, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x67, 0x12, 0x30, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x76, 0x61,
+	0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xee,
+	0x01, 0x0a, 0x13, 0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x44, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64,
+	0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x0e, 0x55, 0x6e, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32,
+	0xf5, 0x08, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x12, 0x4d, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x28, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x42, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
 	0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x0e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33,
-	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xf5,
-	0x08, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x72, 0x12, 0x4d, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12,
-	0x28, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x76, 0x61, 0x6e, 0x75,
-	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x42, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x15,
+	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x27, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x50, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x29, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x50, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x29, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x3e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x15, 0x2e,
-	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x27, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x50, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x29,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16,
+	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61,
+	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x55, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x1a, 0x27, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a,
+	0x09, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x28, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x09,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x48, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52,
+	0x6f, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x64, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x29,
 	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x61, 0x6e, 0x75,
-	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x50, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x29, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e,
-	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x55, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x1a, 0x27, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x09,
-	0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x28, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x09, 0x47,
-	0x72, 0x61, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
-	0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x48, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x6f,
-	0x6c, 0x65, 0x12, 0x22, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x64,
-	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x29, 0x2e,
-	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x2d, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
 	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
-	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x2d, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
 	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
 	0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f,
-	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2d, 0x6c, 0x61, 0x62, 0x73,
+	0x2f, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3789,7 +4590,7 @@ func file_vanus_core_controller_controller_proto_rawDescGZIP() []byte {
 	return file_vanus_core_controller_controller_proto_rawDescData
 }
 
-var file_vanus_core_controller_controller_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
+var file_vanus_core_controller_controller_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
 var file_vanus_core_controller_controller_proto_goTypes = []interface{}{
 	(*PingResponse)(nil),                        // 0: vanus.core.controller.PingResponse
 	(*CreateNamespaceRequest)(nil),              // 1: vanus.core.controller.CreateNamespaceRequest
@@ -3805,207 +4606,232 @@ var file_vanus_core_controller_controller_proto_goTypes = []interface{}{
 	(*QuerySegmentRouteInfoResponse)(nil),       // 11: vanus.core.controller.QuerySegmentRouteInfoResponse
 	(*SegmentHeartbeatRequest)(nil),             // 12: vanus.core.controller.SegmentHeartbeatRequest
 	(*SegmentHeartbeatResponse)(nil),            // 13: vanus.core.controller.SegmentHeartbeatResponse
-	(*RegisterSegmentServerRequest)(nil),        // 14: vanus.core.controller.RegisterSegmentServerRequest
-	(*RegisterSegmentServerResponse)(nil),       // 15: vanus.core.controller.RegisterSegmentServerResponse
-	(*UnregisterSegmentServerRequest)(nil),      // 16: vanus.core.controller.UnregisterSegmentServerRequest
-	(*UnregisterSegmentServerResponse)(nil),     // 17: vanus.core.controller.UnregisterSegmentServerResponse
-	(*ReportSegmentLeaderRequest)(nil),          // 18: vanus.core.controller.ReportSegmentLeaderRequest
-	(*SubscriptionRequest)(nil),                 // 19: vanus.core.controller.SubscriptionRequest
-	(*CreateSubscriptionRequest)(nil),           // 20: vanus.core.controller.CreateSubscriptionRequest
-	(*UpdateSubscriptionRequest)(nil),           // 21: vanus.core.controller.UpdateSubscriptionRequest
-	(*GetSubscriptionRequest)(nil),              // 22: vanus.core.controller.GetSubscriptionRequest
-	(*DeleteSubscriptionRequest)(nil),           // 23: vanus.core.controller.DeleteSubscriptionRequest
-	(*DisableSubscriptionRequest)(nil),          // 24: vanus.core.controller.DisableSubscriptionRequest
-	(*ResumeSubscriptionRequest)(nil),           // 25: vanus.core.controller.ResumeSubscriptionRequest
-	(*ListSubscriptionRequest)(nil),             // 26: vanus.core.controller.ListSubscriptionRequest
-	(*ListSubscriptionResponse)(nil),            // 27: vanus.core.controller.ListSubscriptionResponse
-	(*SetDeadLetterEventOffsetRequest)(nil),     // 28: vanus.core.controller.SetDeadLetterEventOffsetRequest
-	(*GetDeadLetterEventOffsetRequest)(nil),     // 29: vanus.core.controller.GetDeadLetterEventOffsetRequest
-	(*GetDeadLetterEventOffsetResponse)(nil),    // 30: vanus.core.controller.GetDeadLetterEventOffsetResponse
-	(*RegisterTriggerWorkerRequest)(nil),        // 31: vanus.core.controller.RegisterTriggerWorkerRequest
-	(*RegisterTriggerWorkerResponse)(nil),       // 32: vanus.core.controller.RegisterTriggerWorkerResponse
-	(*UnregisterTriggerWorkerRequest)(nil),      // 33: vanus.core.controller.UnregisterTriggerWorkerRequest
-	(*UnregisterTriggerWorkerResponse)(nil),     // 34: vanus.core.controller.UnregisterTriggerWorkerResponse
-	(*TriggerWorkerHeartbeatRequest)(nil),       // 35: vanus.core.controller.TriggerWorkerHeartbeatRequest
-	(*TriggerWorkerHeartbeatResponse)(nil),      // 36: vanus.core.controller.TriggerWorkerHeartbeatResponse
-	(*ResetOffsetToTimestampRequest)(nil),       // 37: vanus.core.controller.ResetOffsetToTimestampRequest
-	(*ResetOffsetToTimestampResponse)(nil),      // 38: vanus.core.controller.ResetOffsetToTimestampResponse
-	(*CommitOffsetRequest)(nil),                 // 39: vanus.core.controller.CommitOffsetRequest
-	(*CommitOffsetResponse)(nil),                // 40: vanus.core.controller.CommitOffsetResponse
-	(*ListSegmentRequest)(nil),                  // 41: vanus.core.controller.ListSegmentRequest
-	(*ListSegmentResponse)(nil),                 // 42: vanus.core.controller.ListSegmentResponse
-	(*GetAppendableSegmentRequest)(nil),         // 43: vanus.core.controller.GetAppendableSegmentRequest
-	(*GetAppendableSegmentResponse)(nil),        // 44: vanus.core.controller.GetAppendableSegmentResponse
-	(*CreateUserRequest)(nil),                   // 45: vanus.core.controller.CreateUserRequest
-	(*DeleteUserResponse)(nil),                  // 46: vanus.core.controller.DeleteUserResponse
-	(*ListUserResponse)(nil),                    // 47: vanus.core.controller.ListUserResponse
-	(*CreateTokenRequest)(nil),                  // 48: vanus.core.controller.CreateTokenRequest
-	(*DeleteTokenRequest)(nil),                  // 49: vanus.core.controller.DeleteTokenRequest
-	(*GetTokenResponse)(nil),                    // 50: vanus.core.controller.GetTokenResponse
-	(*ListTokenResponse)(nil),                   // 51: vanus.core.controller.ListTokenResponse
-	(*RoleRequest)(nil),                         // 52: vanus.core.controller.RoleRequest
-	(*GetUserRoleRequest)(nil),                  // 53: vanus.core.controller.GetUserRoleRequest
-	(*GetUserRoleResponse)(nil),                 // 54: vanus.core.controller.GetUserRoleResponse
-	(*GetResourceRoleRequest)(nil),              // 55: vanus.core.controller.GetResourceRoleRequest
-	(*GetResourceRoleResponse)(nil),             // 56: vanus.core.controller.GetResourceRoleResponse
-	nil,                                         // 57: vanus.core.controller.RegisterSegmentServerResponse.SegmentsEntry
-	(*meta.Namespace)(nil),                      // 58: vanus.core.meta.Namespace
-	(*meta.Eventbus)(nil),                       // 59: vanus.core.meta.Eventbus
-	(*meta.SegmentHealthInfo)(nil),              // 60: vanus.core.meta.SegmentHealthInfo
-	(*meta.SubscriptionConfig)(nil),             // 61: vanus.core.meta.SubscriptionConfig
-	(*meta.Filter)(nil),                         // 62: vanus.core.meta.Filter
-	(*meta.SinkCredential)(nil),                 // 63: vanus.core.meta.SinkCredential
-	(meta.Protocol)(0),                          // 64: vanus.core.meta.Protocol
-	(*meta.ProtocolSetting)(nil),                // 65: vanus.core.meta.ProtocolSetting
-	(*meta.Transformer)(nil),                    // 66: vanus.core.meta.Transformer
-	(*meta.Subscription)(nil),                   // 67: vanus.core.meta.Subscription
-	(*meta.SubscriptionInfo)(nil),               // 68: vanus.core.meta.SubscriptionInfo
-	(*meta.OffsetInfo)(nil),                     // 69: vanus.core.meta.OffsetInfo
-	(*meta.Segment)(nil),                        // 70: vanus.core.meta.Segment
-	(*meta.User)(nil),                           // 71: vanus.core.meta.User
-	(*meta.Token)(nil),                          // 72: vanus.core.meta.Token
-	(*meta.UserRole)(nil),                       // 73: vanus.core.meta.UserRole
-	(*meta.ResourceRole)(nil),                   // 74: vanus.core.meta.ResourceRole
-	(*emptypb.Empty)(nil),                       // 75: google.protobuf.Empty
-	(*wrapperspb.StringValue)(nil),              // 76: google.protobuf.StringValue
-	(*wrapperspb.UInt64Value)(nil),              // 77: google.protobuf.UInt64Value
-	(*wrapperspb.UInt32Value)(nil),              // 78: google.protobuf.UInt32Value
-	(*timestamppb.Timestamp)(nil),               // 79: google.protobuf.Timestamp
+	(*LeaderTransfer)(nil),                      // 14: vanus.core.controller.LeaderTransfer
+	(*RebalanceLeadersResponse)(nil),            // 15: vanus.core.controller.RebalanceLeadersResponse
+	(*DecommissionVolumeRequest)(nil),           // 16: vanus.core.controller.DecommissionVolumeRequest
+	(*DecommissionVolumeResponse)(nil),          // 17: vanus.core.controller.DecommissionVolumeResponse
+	(*RegisterSegmentServerRequest)(nil),        // 18: vanus.core.controller.RegisterSegmentServerRequest
+	(*VolumeTopology)(nil),                      // 19: vanus.core.controller.VolumeTopology
+	(*RegisterSegmentServerResponse)(nil),       // 20: vanus.core.controller.RegisterSegmentServerResponse
+	(*UnregisterSegmentServerRequest)(nil),      // 21: vanus.core.controller.UnregisterSegmentServerRequest
+	(*UnregisterSegmentServerResponse)(nil),     // 22: vanus.core.controller.UnregisterSegmentServerResponse
+	(*ReportSegmentLeaderRequest)(nil),          // 23: vanus.core.controller.ReportSegmentLeaderRequest
+	(*SubscriptionRequest)(nil),                 // 24: vanus.core.controller.SubscriptionRequest
+	(*CreateSubscriptionRequest)(nil),           // 25: vanus.core.controller.CreateSubscriptionRequest
+	(*UpdateSubscriptionRequest)(nil),           // 26: vanus.core.controller.UpdateSubscriptionRequest
+	(*GetSubscriptionRequest)(nil),              // 27: vanus.core.controller.GetSubscriptionRequest
+	(*DeleteSubscriptionRequest)(nil),           // 28: vanus.core.controller.DeleteSubscriptionRequest
+	(*DisableSubscriptionRequest)(nil),          // 29: vanus.core.controller.DisableSubscriptionRequest
+	(*ResumeSubscriptionRequest)(nil),           // 30: vanus.core.controller.ResumeSubscriptionRequest
+	(*ListSubscriptionRequest)(nil),             // 31: vanus.core.controller.ListSubscriptionRequest
+	(*ListSubscriptionResponse)(nil),            // 32: vanus.core.controller.ListSubscriptionResponse
+	(*SetDeadLetterEventOffsetRequest)(nil),     // 33: vanus.core.controller.SetDeadLetterEventOffsetRequest
+	(*GetDeadLetterEventOffsetRequest)(nil),     // 34: vanus.core.controller.GetDeadLetterEventOffsetRequest
+	(*GetDeadLetterEventOffsetResponse)(nil),    // 35: vanus.core.controller.GetDeadLetterEventOffsetResponse
+	(*RegisterTriggerWorkerRequest)(nil),        // 36: vanus.core.controller.RegisterTriggerWorkerRequest
+	(*RegisterTriggerWorkerResponse)(nil),       // 37: vanus.core.controller.RegisterTriggerWorkerResponse
+	(*UnregisterTriggerWorkerRequest)(nil),      // 38: vanus.core.controller.UnregisterTriggerWorkerRequest
+	(*UnregisterTriggerWorkerResponse)(nil),     // 39: vanus.core.controller.UnregisterTriggerWorkerResponse
+	(*TriggerWorkerHeartbeatRequest)(nil),       // 40: vanus.core.controller.TriggerWorkerHeartbeatRequest
+	(*SubscriptionLoad)(nil),                    // 41: vanus.core.controller.SubscriptionLoad
+	(*TriggerWorkerHeartbeatResponse)(nil),      // 42: vanus.core.controller.TriggerWorkerHeartbeatResponse
+	(*ResetOffsetToTimestampRequest)(nil),       // 43: vanus.core.controller.ResetOffsetToTimestampRequest
+	(*ResetOffsetToTimestampResponse)(nil),      // 44: vanus.core.controller.ResetOffsetToTimestampResponse
+	(*GetSubscriptionLagRequest)(nil),           // 45: vanus.core.controller.GetSubscriptionLagRequest
+	(*EventlogLag)(nil),                         // 46: vanus.core.controller.EventlogLag
+	(*GetSubscriptionLagResponse)(nil),          // 47: vanus.core.controller.GetSubscriptionLagResponse
+	(*CommitOffsetRequest)(nil),                 // 48: vanus.core.controller.CommitOffsetRequest
+	(*CommitOffsetResponse)(nil),                // 49: vanus.core.controller.CommitOffsetResponse
+	(*ListSegmentRequest)(nil),                  // 50: vanus.core.controller.ListSegmentRequest
+	(*ListSegmentResponse)(nil),                 // 51: vanus.core.controller.ListSegmentResponse
+	(*GetAppendableSegmentRequest)(nil),         // 52: vanus.core.controller.GetAppendableSegmentRequest
+	(*GetAppendableSegmentResponse)(nil),        // 53: vanus.core.controller.GetAppendableSegmentResponse
+	(*CreateUserRequest)(nil),                   // 54: vanus.core.controller.CreateUserRequest
+	(*DeleteUserResponse)(nil),                  // 55: vanus.core.controller.DeleteUserResponse
+	(*ListUserResponse)(nil),                    // 56: vanus.core.controller.ListUserResponse
+	(*CreateTokenRequest)(nil),                  // 57: vanus.core.controller.CreateTokenRequest
+	(*DeleteTokenRequest)(nil),                  // 58: vanus.core.controller.DeleteTokenRequest
+	(*GetTokenResponse)(nil),                    // 59: vanus.core.controller.GetTokenResponse
+	(*ListTokenResponse)(nil),                   // 60: vanus.core.controller.ListTokenResponse
+	(*RoleRequest)(nil),                         // 61: vanus.core.controller.RoleRequest
+	(*GetUserRoleRequest)(nil),                  // 62: vanus.core.controller.GetUserRoleRequest
+	(*GetUserRoleResponse)(nil),                 // 63: vanus.core.controller.GetUserRoleResponse
+	(*GetResourceRoleRequest)(nil),              // 64: vanus.core.controller.GetResourceRoleRequest
+	(*GetResourceRoleResponse)(nil),             // 65: vanus.core.controller.GetResourceRoleResponse
+	nil,                                         // 66: vanus.core.controller.RegisterSegmentServerResponse.SegmentsEntry
+	(*meta.Namespace)(nil),                      // 67: vanus.core.meta.Namespace
+	(*meta.RetentionPolicy)(nil),                // 68: vanus.core.meta.RetentionPolicy
+	(meta.CompressAlgorithm)(0),                 // 69: vanus.core.meta.CompressAlgorithm
+	(*meta.Eventbus)(nil),                       // 70: vanus.core.meta.Eventbus
+	(*meta.SegmentHealthInfo)(nil),              // 71: vanus.core.meta.SegmentHealthInfo
+	(*meta.SubscriptionConfig)(nil),             // 72: vanus.core.meta.SubscriptionConfig
+	(*meta.Filter)(nil),                         // 73: vanus.core.meta.Filter
+	(*meta.SinkCredential)(nil),                 // 74: vanus.core.meta.SinkCredential
+	(meta.Protocol)(0),                          // 75: vanus.core.meta.Protocol
+	(*meta.ProtocolSetting)(nil),                // 76: vanus.core.meta.ProtocolSetting
+	(*meta.Transformer)(nil),                    // 77: vanus.core.meta.Transformer
+	(*meta.Subscription)(nil),                   // 78: vanus.core.meta.Subscription
+	(*meta.SubscriptionInfo)(nil),               // 79: vanus.core.meta.SubscriptionInfo
+	(*meta.OffsetInfo)(nil),                     // 80: vanus.core.meta.OffsetInfo
+	(*meta.Segment)(nil),                        // 81: vanus.core.meta.Segment
+	(*meta.User)(nil),                           // 82: vanus.core.meta.User
+	(*meta.Token)(nil),                          // 83: vanus.core.meta.Token
+	(*meta.UserRole)(nil),                       // 84: vanus.core.meta.UserRole
+	(*meta.ResourceRole)(nil),                   // 85: vanus.core.meta.ResourceRole
+	(*emptypb.Empty)(nil),                       // 86: google.protobuf.Empty
+	(*wrapperspb.StringValue)(nil),              // 87: google.protobuf.StringValue
+	(*wrapperspb.UInt64Value)(nil),              // 88: google.protobuf.UInt64Value
+	(*wrapperspb.UInt32Value)(nil),              // 89: google.protobuf.UInt32Value
+	(*timestamppb.Timestamp)(nil),               // 90: google.protobuf.Timestamp
 }
 var file_vanus_core_controller_controller_proto_depIdxs = []int32{
-	58, // 0: vanus.core.controller.ListNamespaceResponse.namespace:type_name -> vanus.core.meta.Namespace
-	59, // 1: vanus.core.controller.ListEventbusResponse.eventbus:type_name -> vanus.core.meta.Eventbus
-	60, // 2: vanus.core.controller.SegmentHeartbeatRequest.health_info:type_name -> vanus.core.meta.SegmentHealthInfo
-	57, // 3: vanus.core.controller.RegisterSegmentServerResponse.segments:type_name -> vanus.core.controller.RegisterSegmentServerResponse.SegmentsEntry
-	61, // 4: vanus.core.controller.SubscriptionRequest.config:type_name -> vanus.core.meta.SubscriptionConfig
-	62, // 5: vanus.core.controller.SubscriptionRequest.filters:type_name -> vanus.core.meta.Filter
-	63, // 6: vanus.core.controller.SubscriptionRequest.sink_credential:type_name -> vanus.core.meta.SinkCredential
-	64, // 7: vanus.core.controller.SubscriptionRequest.protocol:type_name -> vanus.core.meta.Protocol
-	65, // 8: vanus.core.controller.SubscriptionRequest.protocol_settings:type_name -> vanus.core.meta.ProtocolSetting
-	66, // 9: vanus.core.controller.SubscriptionRequest.transformer:type_name -> vanus.core.meta.Transformer
-	19, // 10: vanus.core.controller.CreateSubscriptionRequest.subscription:type_name -> vanus.core.controller.SubscriptionRequest
-	19, // 11: vanus.core.controller.UpdateSubscriptionRequest.subscription:type_name -> vanus.core.controller.SubscriptionRequest
-	67, // 12: vanus.core.controller.ListSubscriptionResponse.subscription:type_name -> vanus.core.meta.Subscription
-	68, // 13: vanus.core.controller.TriggerWorkerHeartbeatRequest.subscription_info:type_name -> vanus.core.meta.SubscriptionInfo
-	69, // 14: vanus.core.controller.ResetOffsetToTimestampResponse.offsets:type_name -> vanus.core.meta.OffsetInfo
-	68, // 15: vanus.core.controller.CommitOffsetRequest.subscription_info:type_name -> vanus.core.meta.SubscriptionInfo
-	70, // 16: vanus.core.controller.ListSegmentResponse.segments:type_name -> vanus.core.meta.Segment
-	70, // 17: vanus.core.controller.GetAppendableSegmentResponse.segments:type_name -> vanus.core.meta.Segment
-	71, // 18: vanus.core.controller.ListUserResponse.users:type_name -> vanus.core.meta.User
-	72, // 19: vanus.core.controller.GetTokenResponse.token:type_name -> vanus.core.meta.Token
-	72, // 20: vanus.core.controller.ListTokenResponse.token:type_name -> vanus.core.meta.Token
-	73, // 21: vanus.core.controller.GetUserRoleResponse.user_role:type_name -> vanus.core.meta.UserRole
-	74, // 22: vanus.core.controller.GetResourceRoleResponse.resource_role:type_name -> vanus.core.meta.ResourceRole
-	70, // 23: vanus.core.controller.RegisterSegmentServerResponse.SegmentsEntry.value:type_name -> vanus.core.meta.Segment
-	75, // 24: vanus.core.controller.PingServer.Ping:input_type -> google.protobuf.Empty
-	1,  // 25: vanus.core.controller.NamespaceController.CreateNamespace:input_type -> vanus.core.controller.CreateNamespaceRequest
-	75, // 26: vanus.core.controller.NamespaceController.ListNamespace:input_type -> google.protobuf.Empty
-	3,  // 27: vanus.core.controller.NamespaceController.GetNamespace:input_type -> vanus.core.controller.GetNamespaceRequest
-	4,  // 28: vanus.core.controller.NamespaceController.DeleteNamespace:input_type -> vanus.core.controller.DeleteNamespaceRequest
-	76, // 29: vanus.core.controller.NamespaceController.GetNamespaceWithHumanFriendly:input_type -> google.protobuf.StringValue
-	5,  // 30: vanus.core.controller.EventbusController.CreateEventbus:input_type -> vanus.core.controller.CreateEventbusRequest
-	5,  // 31: vanus.core.controller.EventbusController.CreateSystemEventbus:input_type -> vanus.core.controller.CreateEventbusRequest
-	77, // 32: vanus.core.controller.EventbusController.DeleteEventbus:input_type -> google.protobuf.UInt64Value
-	77, // 33: vanus.core.controller.EventbusController.GetEventbus:input_type -> google.protobuf.UInt64Value
-	6,  // 34: vanus.core.controller.EventbusController.ListEventbus:input_type -> vanus.core.controller.ListEventbusRequest
-	9,  // 35: vanus.core.controller.EventbusController.UpdateEventbus:input_type -> vanus.core.controller.UpdateEventbusRequest
-	8,  // 36: vanus.core.controller.EventbusController.GetEventbusWithHumanFriendly:input_type -> vanus.core.controller.GetEventbusWithHumanFriendlyRequest
-	41, // 37: vanus.core.controller.EventlogController.ListSegment:input_type -> vanus.core.controller.ListSegmentRequest
-	43, // 38: vanus.core.controller.EventlogController.GetAppendableSegment:input_type -> vanus.core.controller.GetAppendableSegmentRequest
-	10, // 39: vanus.core.controller.SegmentController.QuerySegmentRouteInfo:input_type -> vanus.core.controller.QuerySegmentRouteInfoRequest
-	12, // 40: vanus.core.controller.SegmentController.SegmentHeartbeat:input_type -> vanus.core.controller.SegmentHeartbeatRequest
-	14, // 41: vanus.core.controller.SegmentController.RegisterSegmentServer:input_type -> vanus.core.controller.RegisterSegmentServerRequest
-	16, // 42: vanus.core.controller.SegmentController.UnregisterSegmentServer:input_type -> vanus.core.controller.UnregisterSegmentServerRequest
-	12, // 43: vanus.core.controller.SegmentController.ReportSegmentBlockIsFull:input_type -> vanus.core.controller.SegmentHeartbeatRequest
-	18, // 44: vanus.core.controller.SegmentController.ReportSegmentLeader:input_type -> vanus.core.controller.ReportSegmentLeaderRequest
-	20, // 45: vanus.core.controller.TriggerController.CreateSubscription:input_type -> vanus.core.controller.CreateSubscriptionRequest
-	21, // 46: vanus.core.controller.TriggerController.UpdateSubscription:input_type -> vanus.core.controller.UpdateSubscriptionRequest
-	23, // 47: vanus.core.controller.TriggerController.DeleteSubscription:input_type -> vanus.core.controller.DeleteSubscriptionRequest
-	24, // 48: vanus.core.controller.TriggerController.DisableSubscription:input_type -> vanus.core.controller.DisableSubscriptionRequest
-	25, // 49: vanus.core.controller.TriggerController.ResumeSubscription:input_type -> vanus.core.controller.ResumeSubscriptionRequest
-	22, // 50: vanus.core.controller.TriggerController.GetSubscription:input_type -> vanus.core.controller.GetSubscriptionRequest
-	26, // 51: vanus.core.controller.TriggerController.ListSubscription:input_type -> vanus.core.controller.ListSubscriptionRequest
-	35, // 52: vanus.core.controller.TriggerController.TriggerWorkerHeartbeat:input_type -> vanus.core.controller.TriggerWorkerHeartbeatRequest
-	31, // 53: vanus.core.controller.TriggerController.RegisterTriggerWorker:input_type -> vanus.core.controller.RegisterTriggerWorkerRequest
-	33, // 54: vanus.core.controller.TriggerController.UnregisterTriggerWorker:input_type -> vanus.core.controller.UnregisterTriggerWorkerRequest
-	37, // 55: vanus.core.controller.TriggerController.ResetOffsetToTimestamp:input_type -> vanus.core.controller.ResetOffsetToTimestampRequest
-	39, // 56: vanus.core.controller.TriggerController.CommitOffset:input_type -> vanus.core.controller.CommitOffsetRequest
-	28, // 57: vanus.core.controller.TriggerController.SetDeadLetterEventOffset:input_type -> vanus.core.controller.SetDeadLetterEventOffsetRequest
-	29, // 58: vanus.core.controller.TriggerController.GetDeadLetterEventOffset:input_type -> vanus.core.controller.GetDeadLetterEventOffsetRequest
-	75, // 59: vanus.core.controller.SnowflakeController.GetClusterStartTime:input_type -> google.protobuf.Empty
-	78, // 60: vanus.core.controller.SnowflakeController.RegisterNode:input_type -> google.protobuf.UInt32Value
-	78, // 61: vanus.core.controller.SnowflakeController.UnregisterNode:input_type -> google.protobuf.UInt32Value
-	45, // 62: vanus.core.controller.AuthController.CreateUser:input_type -> vanus.core.controller.CreateUserRequest
-	76, // 63: vanus.core.controller.AuthController.DeleteUser:input_type -> google.protobuf.StringValue
-	76, // 64: vanus.core.controller.AuthController.GetUser:input_type -> google.protobuf.StringValue
-	75, // 65: vanus.core.controller.AuthController.ListUser:input_type -> google.protobuf.Empty
-	76, // 66: vanus.core.controller.AuthController.GetUserByToken:input_type -> google.protobuf.StringValue
-	48, // 67: vanus.core.controller.AuthController.CreateToken:input_type -> vanus.core.controller.CreateTokenRequest
-	49, // 68: vanus.core.controller.AuthController.DeleteToken:input_type -> vanus.core.controller.DeleteTokenRequest
-	77, // 69: vanus.core.controller.AuthController.GetToken:input_type -> google.protobuf.UInt64Value
-	76, // 70: vanus.core.controller.AuthController.GetUserToken:input_type -> google.protobuf.StringValue
-	75, // 71: vanus.core.controller.AuthController.ListToken:input_type -> google.protobuf.Empty
-	52, // 72: vanus.core.controller.AuthController.GrantRole:input_type -> vanus.core.controller.RoleRequest
-	52, // 73: vanus.core.controller.AuthController.RevokeRole:input_type -> vanus.core.controller.RoleRequest
-	53, // 74: vanus.core.controller.AuthController.GetUserRole:input_type -> vanus.core.controller.GetUserRoleRequest
-	55, // 75: vanus.core.controller.AuthController.GetResourceRole:input_type -> vanus.core.controller.GetResourceRoleRequest
-	0,  // 76: vanus.core.controller.PingServer.Ping:output_type -> vanus.core.controller.PingResponse
-	58, // 77: vanus.core.controller.NamespaceController.CreateNamespace:output_type -> vanus.core.meta.Namespace
-	2,  // 78: vanus.core.controller.NamespaceController.ListNamespace:output_type -> vanus.core.controller.ListNamespaceResponse
-	58, // 79: vanus.core.controller.NamespaceController.GetNamespace:output_type -> vanus.core.meta.Namespace
-	75, // 80: vanus.core.controller.NamespaceController.DeleteNamespace:output_type -> google.protobuf.Empty
-	58, // 81: vanus.core.controller.NamespaceController.GetNamespaceWithHumanFriendly:output_type -> vanus.core.meta.Namespace
-	59, // 82: vanus.core.controller.EventbusController.CreateEventbus:output_type -> vanus.core.meta.Eventbus
-	59, // 83: vanus.core.controller.EventbusController.CreateSystemEventbus:output_type -> vanus.core.meta.Eventbus
-	75, // 84: vanus.core.controller.EventbusController.DeleteEventbus:output_type -> google.protobuf.Empty
-	59, // 85: vanus.core.controller.EventbusController.GetEventbus:output_type -> vanus.core.meta.Eventbus
-	7,  // 86: vanus.core.controller.EventbusController.ListEventbus:output_type -> vanus.core.controller.ListEventbusResponse
-	59, // 87: vanus.core.controller.EventbusController.UpdateEventbus:output_type -> vanus.core.meta.Eventbus
-	59, // 88: vanus.core.controller.EventbusController.GetEventbusWithHumanFriendly:output_type -> vanus.core.meta.Eventbus
-	42, // 89: vanus.core.controller.EventlogController.ListSegment:output_type -> vanus.core.controller.ListSegmentResponse
-	44, // 90: vanus.core.controller.EventlogController.GetAppendableSegment:output_type -> vanus.core.controller.GetAppendableSegmentResponse
-	11, // 91: vanus.core.controller.SegmentController.QuerySegmentRouteInfo:output_type -> vanus.core.controller.QuerySegmentRouteInfoResponse
-	13, // 92: vanus.core.controller.SegmentController.SegmentHeartbeat:output_type -> vanus.core.controller.SegmentHeartbeatResponse
-	15, // 93: vanus.core.controller.SegmentController.RegisterSegmentServer:output_type -> vanus.core.controller.RegisterSegmentServerResponse
-	17, // 94: vanus.core.controller.SegmentController.UnregisterSegmentServer:output_type -> vanus.core.controller.UnregisterSegmentServerResponse
-	75, // 95: vanus.core.controller.SegmentController.ReportSegmentBlockIsFull:output_type -> google.protobuf.Empty
-	75, // 96: vanus.core.controller.SegmentController.ReportSegmentLeader:output_type -> google.protobuf.Empty
-	67, // 97: vanus.core.controller.TriggerController.CreateSubscription:output_type -> vanus.core.meta.Subscription
-	67, // 98: vanus.core.controller.TriggerController.UpdateSubscription:output_type -> vanus.core.meta.Subscription
-	75, // 99: vanus.core.controller.TriggerController.DeleteSubscription:output_type -> google.protobuf.Empty
-	75, // 100: vanus.core.controller.TriggerController.DisableSubscription:output_type -> google.protobuf.Empty
-	75, // 101: vanus.core.controller.TriggerController.ResumeSubscription:output_type -> google.protobuf.Empty
-	67, // 102: vanus.core.controller.TriggerController.GetSubscription:output_type -> vanus.core.meta.Subscription
-	27, // 103: vanus.core.controller.TriggerController.ListSubscription:output_type -> vanus.core.controller.ListSubscriptionResponse
-	36, // 104: vanus.core.controller.TriggerController.TriggerWorkerHeartbeat:output_type -> vanus.core.controller.TriggerWorkerHeartbeatResponse
-	32, // 105: vanus.core.controller.TriggerController.RegisterTriggerWorker:output_type -> vanus.core.controller.RegisterTriggerWorkerResponse
-	34, // 106: vanus.core.controller.TriggerController.UnregisterTriggerWorker:output_type -> vanus.core.controller.UnregisterTriggerWorkerResponse
-	38, // 107: vanus.core.controller.TriggerController.ResetOffsetToTimestamp:output_type -> vanus.core.controller.ResetOffsetToTimestampResponse
-	40, // 108: vanus.core.controller.TriggerController.CommitOffset:output_type -> vanus.core.controller.CommitOffsetResponse
-	75, // 109: vanus.core.controller.TriggerController.SetDeadLetterEventOffset:output_type -> google.protobuf.Empty
-	30, // 110: vanus.core.controller.TriggerController.GetDeadLetterEventOffset:output_type -> vanus.core.controller.GetDeadLetterEventOffsetResponse
-	79, // 111: vanus.core.controller.SnowflakeController.GetClusterStartTime:output_type -> google.protobuf.Timestamp
-	75, // 112: vanus.core.controller.SnowflakeController.RegisterNode:output_type -> google.protobuf.Empty
-	75, // 113: vanus.core.controller.SnowflakeController.UnregisterNode:output_type -> google.protobuf.Empty
-	71, // 114: vanus.core.controller.AuthController.CreateUser:output_type -> vanus.core.meta.User
-	75, // 115: vanus.core.controller.AuthController.DeleteUser:output_type -> google.protobuf.Empty
-	71, // 116: vanus.core.controller.AuthController.GetUser:output_type -> vanus.core.meta.User
-	47, // 117: vanus.core.controller.AuthController.ListUser:output_type -> vanus.core.controller.ListUserResponse
-	76, // 118: vanus.core.controller.AuthController.GetUserByToken:output_type -> google.protobuf.StringValue
-	72, // 119: vanus.core.controller.AuthController.CreateToken:output_type -> vanus.core.meta.Token
-	75, // 120: vanus.core.controller.AuthController.DeleteToken:output_type -> google.protobuf.Empty
-	72, // 121: vanus.core.controller.AuthController.GetToken:output_type -> vanus.core.meta.Token
-	50, // 122: vanus.core.controller.AuthController.GetUserToken:output_type -> vanus.core.controller.GetTokenResponse
-	51, // 123: vanus.core.controller.AuthController.ListToken:output_type -> vanus.core.controller.ListTokenResponse
-	75, // 124: vanus.core.controller.AuthController.GrantRole:output_type -> google.protobuf.Empty
-	75, // 125: vanus.core.controller.AuthController.RevokeRole:output_type -> google.protobuf.Empty
-	54, // 126: vanus.core.controller.AuthController.GetUserRole:output_type -> vanus.core.controller.GetUserRoleResponse
-	56, // 127: vanus.core.controller.AuthController.GetResourceRole:output_type -> vanus.core.controller.GetResourceRoleResponse
-	76, // [76:128] is the sub-list for method output_type
-	24, // [24:76] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	67, // 0: vanus.core.controller.ListNamespaceResponse.namespace:type_name -> vanus.core.meta.Namespace
+	68, // 1: vanus.core.controller.CreateEventbusRequest.retention:type_name -> vanus.core.meta.RetentionPolicy
+	69, // 2: vanus.core.controller.CreateEventbusRequest.compress_algorithm:type_name -> vanus.core.meta.CompressAlgorithm
+	70, // 3: vanus.core.controller.ListEventbusResponse.eventbus:type_name -> vanus.core.meta.Eventbus
+	68, // 4: vanus.core.controller.UpdateEventbusRequest.retention:type_name -> vanus.core.meta.RetentionPolicy
+	69, // 5: vanus.core.controller.UpdateEventbusRequest.compress_algorithm:type_name -> vanus.core.meta.CompressAlgorithm
+	71, // 6: vanus.core.controller.SegmentHeartbeatRequest.health_info:type_name -> vanus.core.meta.SegmentHealthInfo
+	14, // 7: vanus.core.controller.RebalanceLeadersResponse.transfers:type_name -> vanus.core.controller.LeaderTransfer
+	19, // 8: vanus.core.controller.RegisterSegmentServerRequest.topology:type_name -> vanus.core.controller.VolumeTopology
+	66, // 9: vanus.core.controller.RegisterSegmentServerResponse.segments:type_name -> vanus.core.controller.RegisterSegmentServerResponse.SegmentsEntry
+	72, // 10: vanus.core.controller.SubscriptionRequest.config:type_name -> vanus.core.meta.SubscriptionConfig
+	73, // 11: vanus.core.controller.SubscriptionRequest.filters:type_name -> vanus.core.meta.Filter
+	74, // 12: vanus.core.controller.SubscriptionRequest.sink_credential:type_name -> vanus.core.meta.SinkCredential
+	75, // 13: vanus.core.controller.SubscriptionRequest.protocol:type_name -> vanus.core.meta.Protocol
+	76, // 14: vanus.core.controller.SubscriptionRequest.protocol_settings:type_name -> vanus.core.meta.ProtocolSetting
+	77, // 15: vanus.core.controller.SubscriptionRequest.transformer:type_name -> vanus.core.meta.Transformer
+	24, // 16: vanus.core.controller.CreateSubscriptionRequest.subscription:type_name -> vanus.core.controller.SubscriptionRequest
+	24, // 17: vanus.core.controller.UpdateSubscriptionRequest.subscription:type_name -> vanus.core.controller.SubscriptionRequest
+	78, // 18: vanus.core.controller.ListSubscriptionResponse.subscription:type_name -> vanus.core.meta.Subscription
+	79, // 19: vanus.core.controller.TriggerWorkerHeartbeatRequest.subscription_info:type_name -> vanus.core.meta.SubscriptionInfo
+	41, // 20: vanus.core.controller.TriggerWorkerHeartbeatRequest.subscription_load:type_name -> vanus.core.controller.SubscriptionLoad
+	80, // 21: vanus.core.controller.ResetOffsetToTimestampResponse.offsets:type_name -> vanus.core.meta.OffsetInfo
+	46, // 22: vanus.core.controller.GetSubscriptionLagResponse.eventlogs:type_name -> vanus.core.controller.EventlogLag
+	79, // 23: vanus.core.controller.CommitOffsetRequest.subscription_info:type_name -> vanus.core.meta.SubscriptionInfo
+	81, // 24: vanus.core.controller.ListSegmentResponse.segments:type_name -> vanus.core.meta.Segment
+	81, // 25: vanus.core.controller.GetAppendableSegmentResponse.segments:type_name -> vanus.core.meta.Segment
+	82, // 26: vanus.core.controller.ListUserResponse.users:type_name -> vanus.core.meta.User
+	83, // 27: vanus.core.controller.GetTokenResponse.token:type_name -> vanus.core.meta.Token
+	83, // 28: vanus.core.controller.ListTokenResponse.token:type_name -> vanus.core.meta.Token
+	84, // 29: vanus.core.controller.GetUserRoleResponse.user_role:type_name -> vanus.core.meta.UserRole
+	85, // 30: vanus.core.controller.GetResourceRoleResponse.resource_role:type_name -> vanus.core.meta.ResourceRole
+	81, // 31: vanus.core.controller.RegisterSegmentServerResponse.SegmentsEntry.value:type_name -> vanus.core.meta.Segment
+	86, // 32: vanus.core.controller.PingServer.Ping:input_type -> google.protobuf.Empty
+	1,  // 33: vanus.core.controller.NamespaceController.CreateNamespace:input_type -> vanus.core.controller.CreateNamespaceRequest
+	86, // 34: vanus.core.controller.NamespaceController.ListNamespace:input_type -> google.protobuf.Empty
+	3,  // 35: vanus.core.controller.NamespaceController.GetNamespace:input_type -> vanus.core.controller.GetNamespaceRequest
+	4,  // 36: vanus.core.controller.NamespaceController.DeleteNamespace:input_type -> vanus.core.controller.DeleteNamespaceRequest
+	87, // 37: vanus.core.controller.NamespaceController.GetNamespaceWithHumanFriendly:input_type -> google.protobuf.StringValue
+	5,  // 38: vanus.core.controller.EventbusController.CreateEventbus:input_type -> vanus.core.controller.CreateEventbusRequest
+	5,  // 39: vanus.core.controller.EventbusController.CreateSystemEventbus:input_type -> vanus.core.controller.CreateEventbusRequest
+	88, // 40: vanus.core.controller.EventbusController.DeleteEventbus:input_type -> google.protobuf.UInt64Value
+	88, // 41: vanus.core.controller.EventbusController.GetEventbus:input_type -> google.protobuf.UInt64Value
+	6,  // 42: vanus.core.controller.EventbusController.ListEventbus:input_type -> vanus.core.controller.ListEventbusRequest
+	9,  // 43: vanus.core.controller.EventbusController.UpdateEventbus:input_type -> vanus.core.controller.UpdateEventbusRequest
+	8,  // 44: vanus.core.controller.EventbusController.GetEventbusWithHumanFriendly:input_type -> vanus.core.controller.GetEventbusWithHumanFriendlyRequest
+	50, // 45: vanus.core.controller.EventlogController.ListSegment:input_type -> vanus.core.controller.ListSegmentRequest
+	52, // 46: vanus.core.controller.EventlogController.GetAppendableSegment:input_type -> vanus.core.controller.GetAppendableSegmentRequest
+	10, // 47: vanus.core.controller.SegmentController.QuerySegmentRouteInfo:input_type -> vanus.core.controller.QuerySegmentRouteInfoRequest
+	12, // 48: vanus.core.controller.SegmentController.SegmentHeartbeat:input_type -> vanus.core.controller.SegmentHeartbeatRequest
+	18, // 49: vanus.core.controller.SegmentController.RegisterSegmentServer:input_type -> vanus.core.controller.RegisterSegmentServerRequest
+	21, // 50: vanus.core.controller.SegmentController.UnregisterSegmentServer:input_type -> vanus.core.controller.UnregisterSegmentServerRequest
+	12, // 51: vanus.core.controller.SegmentController.ReportSegmentBlockIsFull:input_type -> vanus.core.controller.SegmentHeartbeatRequest
+	23, // 52: vanus.core.controller.SegmentController.ReportSegmentLeader:input_type -> vanus.core.controller.ReportSegmentLeaderRequest
+	86, // 53: vanus.core.controller.SegmentController.RebalanceLeaders:input_type -> google.protobuf.Empty
+	16, // 54: vanus.core.controller.SegmentController.DecommissionVolume:input_type -> vanus.core.controller.DecommissionVolumeRequest
+	25, // 55: vanus.core.controller.TriggerController.CreateSubscription:input_type -> vanus.core.controller.CreateSubscriptionRequest
+	26, // 56: vanus.core.controller.TriggerController.UpdateSubscription:input_type -> vanus.core.controller.UpdateSubscriptionRequest
+	28, // 57: vanus.core.controller.TriggerController.DeleteSubscription:input_type -> vanus.core.controller.DeleteSubscriptionRequest
+	29, // 58: vanus.core.controller.TriggerController.DisableSubscription:input_type -> vanus.core.controller.DisableSubscriptionRequest
+	30, // 59: vanus.core.controller.TriggerController.ResumeSubscription:input_type -> vanus.core.controller.ResumeSubscriptionRequest
+	27, // 60: vanus.core.controller.TriggerController.GetSubscription:input_type -> vanus.core.controller.GetSubscriptionRequest
+	31, // 61: vanus.core.controller.TriggerController.ListSubscription:input_type -> vanus.core.controller.ListSubscriptionRequest
+	40, // 62: vanus.core.controller.TriggerController.TriggerWorkerHeartbeat:input_type -> vanus.core.controller.TriggerWorkerHeartbeatRequest
+	36, // 63: vanus.core.controller.TriggerController.RegisterTriggerWorker:input_type -> vanus.core.controller.RegisterTriggerWorkerRequest
+	38, // 64: vanus.core.controller.TriggerController.UnregisterTriggerWorker:input_type -> vanus.core.controller.UnregisterTriggerWorkerRequest
+	43, // 65: vanus.core.controller.TriggerController.ResetOffsetToTimestamp:input_type -> vanus.core.controller.ResetOffsetToTimestampRequest
+	48, // 66: vanus.core.controller.TriggerController.CommitOffset:input_type -> vanus.core.controller.CommitOffsetRequest
+	33, // 67: vanus.core.controller.TriggerController.SetDeadLetterEventOffset:input_type -> vanus.core.controller.SetDeadLetterEventOffsetRequest
+	34, // 68: vanus.core.controller.TriggerController.GetDeadLetterEventOffset:input_type -> vanus.core.controller.GetDeadLetterEventOffsetRequest
+	45, // 69: vanus.core.controller.TriggerController.GetSubscriptionLag:input_type -> vanus.core.controller.GetSubscriptionLagRequest
+	86, // 70: vanus.core.controller.SnowflakeController.GetClusterStartTime:input_type -> google.protobuf.Empty
+	89, // 71: vanus.core.controller.SnowflakeController.RegisterNode:input_type -> google.protobuf.UInt32Value
+	89, // 72: vanus.core.controller.SnowflakeController.UnregisterNode:input_type -> google.protobuf.UInt32Value
+	54, // 73: vanus.core.controller.AuthController.CreateUser:input_type -> vanus.core.controller.CreateUserRequest
+	87, // 74: vanus.core.controller.AuthController.DeleteUser:input_type -> google.protobuf.StringValue
+	87, // 75: vanus.core.controller.AuthController.GetUser:input_type -> google.protobuf.StringValue
+	86, // 76: vanus.core.controller.AuthController.ListUser:input_type -> google.protobuf.Empty
+	87, // 77: vanus.core.controller.AuthController.GetUserByToken:input_type -> google.protobuf.StringValue
+	57, // 78: vanus.core.controller.AuthController.CreateToken:input_type -> vanus.core.controller.CreateTokenRequest
+	58, // 79: vanus.core.controller.AuthController.DeleteToken:input_type -> vanus.core.controller.DeleteTokenRequest
+	88, // 80: vanus.core.controller.AuthController.GetToken:input_type -> google.protobuf.UInt64Value
+	87, // 81: vanus.core.controller.AuthController.GetUserToken:input_type -> google.protobuf.StringValue
+	86, // 82: vanus.core.controller.AuthController.ListToken:input_type -> google.protobuf.Empty
+	61, // 83: vanus.core.controller.AuthController.GrantRole:input_type -> vanus.core.controller.RoleRequest
+	61, // 84: vanus.core.controller.AuthController.RevokeRole:input_type -> vanus.core.controller.RoleRequest
+	62, // 85: vanus.core.controller.AuthController.GetUserRole:input_type -> vanus.core.controller.GetUserRoleRequest
+	64, // 86: vanus.core.controller.AuthController.GetResourceRole:input_type -> vanus.core.controller.GetResourceRoleRequest
+	0,  // 87: vanus.core.controller.PingServer.Ping:output_type -> vanus.core.controller.PingResponse
+	67, // 88: vanus.core.controller.NamespaceController.CreateNamespace:output_type -> vanus.core.meta.Namespace
+	2,  // 89: vanus.core.controller.NamespaceController.ListNamespace:output_type -> vanus.core.controller.ListNamespaceResponse
+	67, // 90: vanus.core.controller.NamespaceController.GetNamespace:output_type -> vanus.core.meta.Namespace
+	86, // 91: vanus.core.controller.NamespaceController.DeleteNamespace:output_type -> google.protobuf.Empty
+	67, // 92: vanus.core.controller.NamespaceController.GetNamespaceWithHumanFriendly:output_type -> vanus.core.meta.Namespace
+	70, // 93: vanus.core.controller.EventbusController.CreateEventbus:output_type -> vanus.core.meta.Eventbus
+	70, // 94: vanus.core.controller.EventbusController.CreateSystemEventbus:output_type -> vanus.core.meta.Eventbus
+	86, // 95: vanus.core.controller.EventbusController.DeleteEventbus:output_type -> google.protobuf.Empty
+	70, // 96: vanus.core.controller.EventbusController.GetEventbus:output_type -> vanus.core.meta.Eventbus
+	7,  // 97: vanus.core.controller.EventbusController.ListEventbus:output_type -> vanus.core.controller.ListEventbusResponse
+	70, // 98: vanus.core.controller.EventbusController.UpdateEventbus:output_type -> vanus.core.meta.Eventbus
+	70, // 99: vanus.core.controller.EventbusController.GetEventbusWithHumanFriendly:output_type -> vanus.core.meta.Eventbus
+	51, // 100: vanus.core.controller.EventlogController.ListSegment:output_type -> vanus.core.controller.ListSegmentResponse
+	53, // 101: vanus.core.controller.EventlogController.GetAppendableSegment:output_type -> vanus.core.controller.GetAppendableSegmentResponse
+	11, // 102: vanus.core.controller.SegmentController.QuerySegmentRouteInfo:output_type -> vanus.core.controller.QuerySegmentRouteInfoResponse
+	13, // 103: vanus.core.controller.SegmentController.SegmentHeartbeat:output_type -> vanus.core.controller.SegmentHeartbeatResponse
+	20, // 104: vanus.core.controller.SegmentController.RegisterSegmentServer:output_type -> vanus.core.controller.RegisterSegmentServerResponse
+	22, // 105: vanus.core.controller.SegmentController.UnregisterSegmentServer:output_type -> vanus.core.controller.UnregisterSegmentServerResponse
+	86, // 106: vanus.core.controller.SegmentController.ReportSegmentBlockIsFull:output_type -> google.protobuf.Empty
+	86, // 107: vanus.core.controller.SegmentController.ReportSegmentLeader:output_type -> google.protobuf.Empty
+	15, // 108: vanus.core.controller.SegmentController.RebalanceLeaders:output_type -> vanus.core.controller.RebalanceLeadersResponse
+	17, // 109: vanus.core.controller.SegmentController.DecommissionVolume:output_type -> vanus.core.controller.DecommissionVolumeResponse
+	78, // 110: vanus.core.controller.TriggerController.CreateSubscription:output_type -> vanus.core.meta.Subscription
+	78, // 111: vanus.core.controller.TriggerController.UpdateSubscription:output_type -> vanus.core.meta.Subscription
+	86, // 112: vanus.core.controller.TriggerController.DeleteSubscription:output_type -> google.protobuf.Empty
+	86, // 113: vanus.core.controller.TriggerController.DisableSubscription:output_type -> google.protobuf.Empty
+	86, // 114: vanus.core.controller.TriggerController.ResumeSubscription:output_type -> google.protobuf.Empty
+	78, // 115: vanus.core.controller.TriggerController.GetSubscription:output_type -> vanus.core.meta.Subscription
+	32, // 116: vanus.core.controller.TriggerController.ListSubscription:output_type -> vanus.core.controller.ListSubscriptionResponse
+	42, // 117: vanus.core.controller.TriggerController.TriggerWorkerHeartbeat:output_type -> vanus.core.controller.TriggerWorkerHeartbeatResponse
+	37, // 118: vanus.core.controller.TriggerController.RegisterTriggerWorker:output_type -> vanus.core.controller.RegisterTriggerWorkerResponse
+	39, // 119: vanus.core.controller.TriggerController.UnregisterTriggerWorker:output_type -> vanus.core.controller.UnregisterTriggerWorkerResponse
+	44, // 120: vanus.core.controller.TriggerController.ResetOffsetToTimestamp:output_type -> vanus.core.controller.ResetOffsetToTimestampResponse
+	49, // 121: vanus.core.controller.TriggerController.CommitOffset:output_type -> vanus.core.controller.CommitOffsetResponse
+	86, // 122: vanus.core.controller.TriggerController.SetDeadLetterEventOffset:output_type -> google.protobuf.Empty
+	35, // 123: vanus.core.controller.TriggerController.GetDeadLetterEventOffset:output_type -> vanus.core.controller.GetDeadLetterEventOffsetResponse
+	47, // 124: vanus.core.controller.TriggerController.GetSubscriptionLag:output_type -> vanus.core.controller.GetSubscriptionLagResponse
+	90, // 125: vanus.core.controller.SnowflakeController.GetClusterStartTime:output_type -> google.protobuf.Timestamp
+	86, // 126: vanus.core.controller.SnowflakeController.RegisterNode:output_type -> google.protobuf.Empty
+	86, // 127: vanus.core.controller.SnowflakeController.UnregisterNode:output_type -> google.protobuf.Empty
+	82, // 128: vanus.core.controller.AuthController.CreateUser:output_type -> vanus.core.meta.User
+	86, // 129: vanus.core.controller.AuthController.DeleteUser:output_type -> google.protobuf.Empty
+	82, // 130: vanus.core.controller.AuthController.GetUser:output_type -> vanus.core.meta.User
+	56, // 131: vanus.core.controller.AuthController.ListUser:output_type -> vanus.core.controller.ListUserResponse
+	87, // 132: vanus.core.controller.AuthController.GetUserByToken:output_type -> google.protobuf.StringValue
+	83, // 133: vanus.core.controller.AuthController.CreateToken:output_type -> vanus.core.meta.Token
+	86, // 134: vanus.core.controller.AuthController.DeleteToken:output_type -> google.protobuf.Empty
+	83, // 135: vanus.core.controller.AuthController.GetToken:output_type -> vanus.core.meta.Token
+	59, // 136: vanus.core.controller.AuthController.GetUserToken:output_type -> vanus.core.controller.GetTokenResponse
+	60, // 137: vanus.core.controller.AuthController.ListToken:output_type -> vanus.core.controller.ListTokenResponse
+	86, // 138: vanus.core.controller.AuthController.GrantRole:output_type -> google.protobuf.Empty
+	86, // 139: vanus.core.controller.AuthController.RevokeRole:output_type -> google.protobuf.Empty
+	63, // 140: vanus.core.controller.AuthController.GetUserRole:output_type -> vanus.core.controller.GetUserRoleResponse
+	65, // 141: vanus.core.controller.AuthController.GetResourceRole:output_type -> vanus.core.controller.GetResourceRoleResponse
+	87, // [87:142] is the sub-list for method output_type
+	32, // [32:87] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_vanus_core_controller_controller_proto_init() }
@@ -4183,7 +5009,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterSegmentServerRequest); i {
+			switch v := v.(*LeaderTransfer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4195,7 +5021,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterSegmentServerResponse); i {
+			switch v := v.(*RebalanceLeadersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4207,7 +5033,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnregisterSegmentServerRequest); i {
+			switch v := v.(*DecommissionVolumeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4219,7 +5045,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnregisterSegmentServerResponse); i {
+			switch v := v.(*DecommissionVolumeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4231,7 +5057,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReportSegmentLeaderRequest); i {
+			switch v := v.(*RegisterSegmentServerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4243,7 +5069,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscriptionRequest); i {
+			switch v := v.(*VolumeTopology); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4255,7 +5081,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSubscriptionRequest); i {
+			switch v := v.(*RegisterSegmentServerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4267,7 +5093,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSubscriptionRequest); i {
+			switch v := v.(*UnregisterSegmentServerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4279,7 +5105,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSubscriptionRequest); i {
+			switch v := v.(*UnregisterSegmentServerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4291,7 +5117,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSubscriptionRequest); i {
+			switch v := v.(*ReportSegmentLeaderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4303,7 +5129,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DisableSubscriptionRequest); i {
+			switch v := v.(*SubscriptionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4315,7 +5141,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResumeSubscriptionRequest); i {
+			switch v := v.(*CreateSubscriptionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4327,7 +5153,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSubscriptionRequest); i {
+			switch v := v.(*UpdateSubscriptionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4339,7 +5165,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSubscriptionResponse); i {
+			switch v := v.(*GetSubscriptionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4351,7 +5177,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetDeadLetterEventOffsetRequest); i {
+			switch v := v.(*DeleteSubscriptionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4363,7 +5189,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDeadLetterEventOffsetRequest); i {
+			switch v := v.(*DisableSubscriptionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4375,7 +5201,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDeadLetterEventOffsetResponse); i {
+			switch v := v.(*ResumeSubscriptionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4387,7 +5213,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterTriggerWorkerRequest); i {
+			switch v := v.(*ListSubscriptionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4399,7 +5225,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterTriggerWorkerResponse); i {
+			switch v := v.(*ListSubscriptionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4411,7 +5237,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnregisterTriggerWorkerRequest); i {
+			switch v := v.(*SetDeadLetterEventOffsetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4423,7 +5249,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnregisterTriggerWorkerResponse); i {
+			switch v := v.(*GetDeadLetterEventOffsetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4435,7 +5261,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerWorkerHeartbeatRequest); i {
+			switch v := v.(*GetDeadLetterEventOffsetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4447,7 +5273,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerWorkerHeartbeatResponse); i {
+			switch v := v.(*RegisterTriggerWorkerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4459,7 +5285,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetOffsetToTimestampRequest); i {
+			switch v := v.(*RegisterTriggerWorkerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4471,7 +5297,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetOffsetToTimestampResponse); i {
+			switch v := v.(*UnregisterTriggerWorkerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4483,7 +5309,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitOffsetRequest); i {
+			switch v := v.(*UnregisterTriggerWorkerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4495,7 +5321,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitOffsetResponse); i {
+			switch v := v.(*TriggerWorkerHeartbeatRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4507,7 +5333,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSegmentRequest); i {
+			switch v := v.(*SubscriptionLoad); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4519,7 +5345,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSegmentResponse); i {
+			switch v := v.(*TriggerWorkerHeartbeatResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4531,7 +5357,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAppendableSegmentRequest); i {
+			switch v := v.(*ResetOffsetToTimestampRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4543,7 +5369,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAppendableSegmentResponse); i {
+			switch v := v.(*ResetOffsetToTimestampResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4555,7 +5381,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserRequest); i {
+			switch v := v.(*GetSubscriptionLagRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4567,7 +5393,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteUserResponse); i {
+			switch v := v.(*EventlogLag); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4579,7 +5405,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUserResponse); i {
+			switch v := v.(*GetSubscriptionLagResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4591,7 +5417,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTokenRequest); i {
+			switch v := v.(*CommitOffsetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4603,7 +5429,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteTokenRequest); i {
+			switch v := v.(*CommitOffsetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4615,7 +5441,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTokenResponse); i {
+			switch v := v.(*ListSegmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4627,7 +5453,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTokenResponse); i {
+			switch v := v.(*ListSegmentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4639,7 +5465,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoleRequest); i {
+			switch v := v.(*GetAppendableSegmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4651,7 +5477,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserRoleRequest); i {
+			switch v := v.(*GetAppendableSegmentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4663,7 +5489,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserRoleResponse); i {
+			switch v := v.(*CreateUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4675,7 +5501,7 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetResourceRoleRequest); i {
+			switch v := v.(*DeleteUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4687,6 +5513,114 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 		file_vanus_core_controller_controller_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vanus_core_controller_controller_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vanus_core_controller_controller_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vanus_core_controller_controller_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vanus_core_controller_controller_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vanus_core_controller_controller_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vanus_core_controller_controller_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserRoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vanus_core_controller_controller_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserRoleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vanus_core_controller_controller_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResourceRoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vanus_core_controller_controller_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetResourceRoleResponse); i {
 			case 0:
 				return &v.state
@@ -4699,13 +5633,14 @@ func file_vanus_core_controller_controller_proto_init() {
 			}
 		}
 	}
+	file_vanus_core_controller_controller_proto_msgTypes[9].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_vanus_core_controller_controller_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   58,
+			NumMessages:   67,
 			NumExtensions: 0,
 			NumServices:   8,
 		},
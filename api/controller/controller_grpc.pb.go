@@ -804,6 +804,8 @@ const (
 	SegmentController_UnregisterSegmentServer_FullMethodName  = "/vanus.core.controller.SegmentController/UnregisterSegmentServer"
 	SegmentController_ReportSegmentBlockIsFull_FullMethodName = "/vanus.core.controller.SegmentController/ReportSegmentBlockIsFull"
 	SegmentController_ReportSegmentLeader_FullMethodName      = "/vanus.core.controller.SegmentController/ReportSegmentLeader"
+	SegmentController_RebalanceLeaders_FullMethodName         = "/vanus.core.controller.SegmentController/RebalanceLeaders"
+	SegmentController_DecommissionVolume_FullMethodName       = "/vanus.core.controller.SegmentController/DecommissionVolume"
 )
 
 // SegmentControllerClient is the client API for SegmentController service.
@@ -816,6 +818,11 @@ type SegmentControllerClient interface {
 	UnregisterSegmentServer(ctx context.Context, in *UnregisterSegmentServerRequest, opts ...grpc.CallOption) (*UnregisterSegmentServerResponse, error)
 	ReportSegmentBlockIsFull(ctx context.Context, in *SegmentHeartbeatRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ReportSegmentLeader(ctx context.Context, in *ReportSegmentLeaderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RebalanceLeaders transfers leaderships of working segments to even out leaders across volumes.
+	RebalanceLeaders(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RebalanceLeadersResponse, error)
+	// DecommissionVolume marks a volume as draining (or not if cancel is set), and reports the progress
+	// of migrating replicas out of it. It's idempotent, so it can be used to poll the progress.
+	DecommissionVolume(ctx context.Context, in *DecommissionVolumeRequest, opts ...grpc.CallOption) (*DecommissionVolumeResponse, error)
 }
 
 type segmentControllerClient struct {
@@ -905,6 +912,24 @@ func (c *segmentControllerClient) ReportSegmentLeader(ctx context.Context, in *R
 	return out, nil
 }
 
+func (c *segmentControllerClient) RebalanceLeaders(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RebalanceLeadersResponse, error) {
+	out := new(RebalanceLeadersResponse)
+	err := c.cc.Invoke(ctx, SegmentController_RebalanceLeaders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentControllerClient) DecommissionVolume(ctx context.Context, in *DecommissionVolumeRequest, opts ...grpc.CallOption) (*DecommissionVolumeResponse, error) {
+	out := new(DecommissionVolumeResponse)
+	err := c.cc.Invoke(ctx, SegmentController_DecommissionVolume_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SegmentControllerServer is the server API for SegmentController service.
 // All implementations should embed UnimplementedSegmentControllerServer
 // for forward compatibility
@@ -915,6 +940,11 @@ type SegmentControllerServer interface {
 	UnregisterSegmentServer(context.Context, *UnregisterSegmentServerRequest) (*UnregisterSegmentServerResponse, error)
 	ReportSegmentBlockIsFull(context.Context, *SegmentHeartbeatRequest) (*emptypb.Empty, error)
 	ReportSegmentLeader(context.Context, *ReportSegmentLeaderRequest) (*emptypb.Empty, error)
+	// RebalanceLeaders transfers leaderships of working segments to even out leaders across volumes.
+	RebalanceLeaders(context.Context, *emptypb.Empty) (*RebalanceLeadersResponse, error)
+	// DecommissionVolume marks a volume as draining (or not if cancel is set), and reports the progress
+	// of migrating replicas out of it. It's idempotent, so it can be used to poll the progress.
+	DecommissionVolume(context.Context, *DecommissionVolumeRequest) (*DecommissionVolumeResponse, error)
 }
 
 // UnimplementedSegmentControllerServer should be embedded to have forward compatible implementations.
@@ -939,6 +969,12 @@ func (UnimplementedSegmentControllerServer) ReportSegmentBlockIsFull(context.Con
 func (UnimplementedSegmentControllerServer) ReportSegmentLeader(context.Context, *ReportSegmentLeaderRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportSegmentLeader not implemented")
 }
+func (UnimplementedSegmentControllerServer) RebalanceLeaders(context.Context, *emptypb.Empty) (*RebalanceLeadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalanceLeaders not implemented")
+}
+func (UnimplementedSegmentControllerServer) DecommissionVolume(context.Context, *DecommissionVolumeRequest) (*DecommissionVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecommissionVolume not implemented")
+}
 
 // UnsafeSegmentControllerServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to SegmentControllerServer will
@@ -1067,6 +1103,42 @@ func _SegmentController_ReportSegmentLeader_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SegmentController_RebalanceLeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentControllerServer).RebalanceLeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SegmentController_RebalanceLeaders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentControllerServer).RebalanceLeaders(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentController_DecommissionVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecommissionVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentControllerServer).DecommissionVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SegmentController_DecommissionVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentControllerServer).DecommissionVolume(ctx, req.(*DecommissionVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SegmentController_ServiceDesc is the grpc.ServiceDesc for SegmentController service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1094,6 +1166,14 @@ var SegmentController_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReportSegmentLeader",
 			Handler:    _SegmentController_ReportSegmentLeader_Handler,
 		},
+		{
+			MethodName: "RebalanceLeaders",
+			Handler:    _SegmentController_RebalanceLeaders_Handler,
+		},
+		{
+			MethodName: "DecommissionVolume",
+			Handler:    _SegmentController_DecommissionVolume_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1120,6 +1200,7 @@ const (
 	TriggerController_CommitOffset_FullMethodName             = "/vanus.core.controller.TriggerController/CommitOffset"
 	TriggerController_SetDeadLetterEventOffset_FullMethodName = "/vanus.core.controller.TriggerController/SetDeadLetterEventOffset"
 	TriggerController_GetDeadLetterEventOffset_FullMethodName = "/vanus.core.controller.TriggerController/GetDeadLetterEventOffset"
+	TriggerController_GetSubscriptionLag_FullMethodName       = "/vanus.core.controller.TriggerController/GetSubscriptionLag"
 )
 
 // TriggerControllerClient is the client API for TriggerController service.
@@ -1140,6 +1221,7 @@ type TriggerControllerClient interface {
 	CommitOffset(ctx context.Context, in *CommitOffsetRequest, opts ...grpc.CallOption) (*CommitOffsetResponse, error)
 	SetDeadLetterEventOffset(ctx context.Context, in *SetDeadLetterEventOffsetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetDeadLetterEventOffset(ctx context.Context, in *GetDeadLetterEventOffsetRequest, opts ...grpc.CallOption) (*GetDeadLetterEventOffsetResponse, error)
+	GetSubscriptionLag(ctx context.Context, in *GetSubscriptionLagRequest, opts ...grpc.CallOption) (*GetSubscriptionLagResponse, error)
 }
 
 type triggerControllerClient struct {
@@ -1301,6 +1383,15 @@ func (c *triggerControllerClient) GetDeadLetterEventOffset(ctx context.Context,
 	return out, nil
 }
 
+func (c *triggerControllerClient) GetSubscriptionLag(ctx context.Context, in *GetSubscriptionLagRequest, opts ...grpc.CallOption) (*GetSubscriptionLagResponse, error) {
+	out := new(GetSubscriptionLagResponse)
+	err := c.cc.Invoke(ctx, TriggerController_GetSubscriptionLag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TriggerControllerServer is the server API for TriggerController service.
 // All implementations should embed UnimplementedTriggerControllerServer
 // for forward compatibility
@@ -1319,6 +1410,7 @@ type TriggerControllerServer interface {
 	CommitOffset(context.Context, *CommitOffsetRequest) (*CommitOffsetResponse, error)
 	SetDeadLetterEventOffset(context.Context, *SetDeadLetterEventOffsetRequest) (*emptypb.Empty, error)
 	GetDeadLetterEventOffset(context.Context, *GetDeadLetterEventOffsetRequest) (*GetDeadLetterEventOffsetResponse, error)
+	GetSubscriptionLag(context.Context, *GetSubscriptionLagRequest) (*GetSubscriptionLagResponse, error)
 }
 
 // UnimplementedTriggerControllerServer should be embedded to have forward compatible implementations.
@@ -1367,6 +1459,9 @@ func (UnimplementedTriggerControllerServer) SetDeadLetterEventOffset(context.Con
 func (UnimplementedTriggerControllerServer) GetDeadLetterEventOffset(context.Context, *GetDeadLetterEventOffsetRequest) (*GetDeadLetterEventOffsetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDeadLetterEventOffset not implemented")
 }
+func (UnimplementedTriggerControllerServer) GetSubscriptionLag(context.Context, *GetSubscriptionLagRequest) (*GetSubscriptionLagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscriptionLag not implemented")
+}
 
 // UnsafeTriggerControllerServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to TriggerControllerServer will
@@ -1639,6 +1734,24 @@ func _TriggerController_GetDeadLetterEventOffset_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TriggerController_GetSubscriptionLag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscriptionLagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).GetSubscriptionLag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TriggerController_GetSubscriptionLag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).GetSubscriptionLag(ctx, req.(*GetSubscriptionLagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TriggerController_ServiceDesc is the grpc.ServiceDesc for TriggerController service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1698,6 +1811,10 @@ var TriggerController_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDeadLetterEventOffset",
 			Handler:    _TriggerController_GetDeadLetterEventOffset_Handler,
 		},
+		{
+			MethodName: "GetSubscriptionLag",
+			Handler:    _TriggerController_GetSubscriptionLag_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
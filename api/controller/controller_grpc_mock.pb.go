@@ -1019,6 +1019,26 @@ func (m *MockSegmentControllerClient) EXPECT() *MockSegmentControllerClientMockR
 	return m.recorder
 }
 
+// DecommissionVolume mocks base method.
+func (m *MockSegmentControllerClient) DecommissionVolume(ctx context.Context, in *DecommissionVolumeRequest, opts ...grpc.CallOption) (*DecommissionVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DecommissionVolume", varargs...)
+	ret0, _ := ret[0].(*DecommissionVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecommissionVolume indicates an expected call of DecommissionVolume.
+func (mr *MockSegmentControllerClientMockRecorder) DecommissionVolume(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecommissionVolume", reflect.TypeOf((*MockSegmentControllerClient)(nil).DecommissionVolume), varargs...)
+}
+
 // QuerySegmentRouteInfo mocks base method.
 func (m *MockSegmentControllerClient) QuerySegmentRouteInfo(ctx context.Context, in *QuerySegmentRouteInfoRequest, opts ...grpc.CallOption) (*QuerySegmentRouteInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -1039,6 +1059,26 @@ func (mr *MockSegmentControllerClientMockRecorder) QuerySegmentRouteInfo(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuerySegmentRouteInfo", reflect.TypeOf((*MockSegmentControllerClient)(nil).QuerySegmentRouteInfo), varargs...)
 }
 
+// RebalanceLeaders mocks base method.
+func (m *MockSegmentControllerClient) RebalanceLeaders(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RebalanceLeadersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RebalanceLeaders", varargs...)
+	ret0, _ := ret[0].(*RebalanceLeadersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebalanceLeaders indicates an expected call of RebalanceLeaders.
+func (mr *MockSegmentControllerClientMockRecorder) RebalanceLeaders(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebalanceLeaders", reflect.TypeOf((*MockSegmentControllerClient)(nil).RebalanceLeaders), varargs...)
+}
+
 // RegisterSegmentServer mocks base method.
 func (m *MockSegmentControllerClient) RegisterSegmentServer(ctx context.Context, in *RegisterSegmentServerRequest, opts ...grpc.CallOption) (*RegisterSegmentServerResponse, error) {
 	m.ctrl.T.Helper()
@@ -1162,6 +1202,21 @@ func (m *MockSegmentControllerServer) EXPECT() *MockSegmentControllerServerMockR
 	return m.recorder
 }
 
+// DecommissionVolume mocks base method.
+func (m *MockSegmentControllerServer) DecommissionVolume(ctx context.Context, in *DecommissionVolumeRequest) (*DecommissionVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecommissionVolume", ctx, in)
+	ret0, _ := ret[0].(*DecommissionVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecommissionVolume indicates an expected call of DecommissionVolume.
+func (mr *MockSegmentControllerServerMockRecorder) DecommissionVolume(ctx, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecommissionVolume", reflect.TypeOf((*MockSegmentControllerServer)(nil).DecommissionVolume), ctx, in)
+}
+
 // QuerySegmentRouteInfo mocks base method.
 func (m *MockSegmentControllerServer) QuerySegmentRouteInfo(ctx context.Context, in *QuerySegmentRouteInfoRequest) (*QuerySegmentRouteInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -1177,6 +1232,21 @@ func (mr *MockSegmentControllerServerMockRecorder) QuerySegmentRouteInfo(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuerySegmentRouteInfo", reflect.TypeOf((*MockSegmentControllerServer)(nil).QuerySegmentRouteInfo), ctx, in)
 }
 
+// RebalanceLeaders mocks base method.
+func (m *MockSegmentControllerServer) RebalanceLeaders(ctx context.Context, in *emptypb.Empty) (*RebalanceLeadersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebalanceLeaders", ctx, in)
+	ret0, _ := ret[0].(*RebalanceLeadersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebalanceLeaders indicates an expected call of RebalanceLeaders.
+func (mr *MockSegmentControllerServerMockRecorder) RebalanceLeaders(ctx, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebalanceLeaders", reflect.TypeOf((*MockSegmentControllerServer)(nil).RebalanceLeaders), ctx, in)
+}
+
 // RegisterSegmentServer mocks base method.
 func (m *MockSegmentControllerServer) RegisterSegmentServer(ctx context.Context, in *RegisterSegmentServerRequest) (*RegisterSegmentServerResponse, error) {
 	m.ctrl.T.Helper()
@@ -1665,6 +1735,26 @@ func (mr *MockTriggerControllerClientMockRecorder) GetSubscription(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).GetSubscription), varargs...)
 }
 
+// GetSubscriptionLag mocks base method.
+func (m *MockTriggerControllerClient) GetSubscriptionLag(ctx context.Context, in *GetSubscriptionLagRequest, opts ...grpc.CallOption) (*GetSubscriptionLagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSubscriptionLag", varargs...)
+	ret0, _ := ret[0].(*GetSubscriptionLagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscriptionLag indicates an expected call of GetSubscriptionLag.
+func (mr *MockTriggerControllerClientMockRecorder) GetSubscriptionLag(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionLag", reflect.TypeOf((*MockTriggerControllerClient)(nil).GetSubscriptionLag), varargs...)
+}
+
 // ListSubscription mocks base method.
 func (m *MockTriggerControllerClient) ListSubscription(ctx context.Context, in *ListSubscriptionRequest, opts ...grpc.CallOption) (*ListSubscriptionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1938,6 +2028,21 @@ func (mr *MockTriggerControllerServerMockRecorder) GetSubscription(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockTriggerControllerServer)(nil).GetSubscription), ctx, in)
 }
 
+// GetSubscriptionLag mocks base method.
+func (m *MockTriggerControllerServer) GetSubscriptionLag(ctx context.Context, in *GetSubscriptionLagRequest) (*GetSubscriptionLagResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptionLag", ctx, in)
+	ret0, _ := ret[0].(*GetSubscriptionLagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscriptionLag indicates an expected call of GetSubscriptionLag.
+func (mr *MockTriggerControllerServerMockRecorder) GetSubscriptionLag(ctx, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionLag", reflect.TypeOf((*MockTriggerControllerServer)(nil).GetSubscriptionLag), ctx, in)
+}
+
 // ListSubscription mocks base method.
 func (m *MockTriggerControllerServer) ListSubscription(ctx context.Context, in *ListSubscriptionRequest) (*ListSubscriptionResponse, error) {
 	m.ctrl.T.Helper()
@@ -54,12 +54,16 @@ const (
 	ControllerProxy_DisableSubscription_FullMethodName           = "/vanus.core.proxy.ControllerProxy/DisableSubscription"
 	ControllerProxy_ResumeSubscription_FullMethodName            = "/vanus.core.proxy.ControllerProxy/ResumeSubscription"
 	ControllerProxy_ResetOffsetToTimestamp_FullMethodName        = "/vanus.core.proxy.ControllerProxy/ResetOffsetToTimestamp"
+	ControllerProxy_GetSubscriptionLag_FullMethodName            = "/vanus.core.proxy.ControllerProxy/GetSubscriptionLag"
 	ControllerProxy_ClusterInfo_FullMethodName                   = "/vanus.core.proxy.ControllerProxy/ClusterInfo"
 	ControllerProxy_LookupOffset_FullMethodName                  = "/vanus.core.proxy.ControllerProxy/LookupOffset"
 	ControllerProxy_GetEvent_FullMethodName                      = "/vanus.core.proxy.ControllerProxy/GetEvent"
 	ControllerProxy_ValidateSubscription_FullMethodName          = "/vanus.core.proxy.ControllerProxy/ValidateSubscription"
+	ControllerProxy_RebalanceLeaders_FullMethodName              = "/vanus.core.proxy.ControllerProxy/RebalanceLeaders"
+	ControllerProxy_DecommissionVolume_FullMethodName            = "/vanus.core.proxy.ControllerProxy/DecommissionVolume"
 	ControllerProxy_GetDeadLetterEvent_FullMethodName            = "/vanus.core.proxy.ControllerProxy/GetDeadLetterEvent"
 	ControllerProxy_ResendDeadLetterEvent_FullMethodName         = "/vanus.core.proxy.ControllerProxy/ResendDeadLetterEvent"
+	ControllerProxy_GetDeadLetterStats_FullMethodName            = "/vanus.core.proxy.ControllerProxy/GetDeadLetterStats"
 	ControllerProxy_SetDeadLetterEventOffset_FullMethodName      = "/vanus.core.proxy.ControllerProxy/SetDeadLetterEventOffset"
 	ControllerProxy_GetNamespaceWithHumanFriendly_FullMethodName = "/vanus.core.proxy.ControllerProxy/GetNamespaceWithHumanFriendly"
 	ControllerProxy_CreateNamespace_FullMethodName               = "/vanus.core.proxy.ControllerProxy/CreateNamespace"
@@ -103,14 +107,18 @@ type ControllerProxyClient interface {
 	DisableSubscription(ctx context.Context, in *controller.DisableSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ResumeSubscription(ctx context.Context, in *controller.ResumeSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ResetOffsetToTimestamp(ctx context.Context, in *controller.ResetOffsetToTimestampRequest, opts ...grpc.CallOption) (*controller.ResetOffsetToTimestampResponse, error)
+	GetSubscriptionLag(ctx context.Context, in *controller.GetSubscriptionLagRequest, opts ...grpc.CallOption) (*controller.GetSubscriptionLagResponse, error)
 	// custom
 	ClusterInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClusterInfoResponse, error)
 	LookupOffset(ctx context.Context, in *LookupOffsetRequest, opts ...grpc.CallOption) (*LookupOffsetResponse, error)
 	GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*GetEventResponse, error)
 	ValidateSubscription(ctx context.Context, in *ValidateSubscriptionRequest, opts ...grpc.CallOption) (*ValidateSubscriptionResponse, error)
+	RebalanceLeaders(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.RebalanceLeadersResponse, error)
+	DecommissionVolume(ctx context.Context, in *controller.DecommissionVolumeRequest, opts ...grpc.CallOption) (*controller.DecommissionVolumeResponse, error)
 	// dead letter
 	GetDeadLetterEvent(ctx context.Context, in *GetDeadLetterEventRequest, opts ...grpc.CallOption) (*GetDeadLetterEventResponse, error)
-	ResendDeadLetterEvent(ctx context.Context, in *ResendDeadLetterEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResendDeadLetterEvent(ctx context.Context, in *ResendDeadLetterEventRequest, opts ...grpc.CallOption) (*ResendDeadLetterEventResponse, error)
+	GetDeadLetterStats(ctx context.Context, in *GetDeadLetterStatsRequest, opts ...grpc.CallOption) (*GetDeadLetterStatsResponse, error)
 	SetDeadLetterEventOffset(ctx context.Context, in *controller.SetDeadLetterEventOffsetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// multiple tenant
 	GetNamespaceWithHumanFriendly(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*meta.Namespace, error)
@@ -293,6 +301,15 @@ func (c *controllerProxyClient) ResetOffsetToTimestamp(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *controllerProxyClient) GetSubscriptionLag(ctx context.Context, in *controller.GetSubscriptionLagRequest, opts ...grpc.CallOption) (*controller.GetSubscriptionLagResponse, error) {
+	out := new(controller.GetSubscriptionLagResponse)
+	err := c.cc.Invoke(ctx, ControllerProxy_GetSubscriptionLag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controllerProxyClient) ClusterInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClusterInfoResponse, error) {
 	out := new(ClusterInfoResponse)
 	err := c.cc.Invoke(ctx, ControllerProxy_ClusterInfo_FullMethodName, in, out, opts...)
@@ -329,6 +346,24 @@ func (c *controllerProxyClient) ValidateSubscription(ctx context.Context, in *Va
 	return out, nil
 }
 
+func (c *controllerProxyClient) RebalanceLeaders(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.RebalanceLeadersResponse, error) {
+	out := new(controller.RebalanceLeadersResponse)
+	err := c.cc.Invoke(ctx, ControllerProxy_RebalanceLeaders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) DecommissionVolume(ctx context.Context, in *controller.DecommissionVolumeRequest, opts ...grpc.CallOption) (*controller.DecommissionVolumeResponse, error) {
+	out := new(controller.DecommissionVolumeResponse)
+	err := c.cc.Invoke(ctx, ControllerProxy_DecommissionVolume_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controllerProxyClient) GetDeadLetterEvent(ctx context.Context, in *GetDeadLetterEventRequest, opts ...grpc.CallOption) (*GetDeadLetterEventResponse, error) {
 	out := new(GetDeadLetterEventResponse)
 	err := c.cc.Invoke(ctx, ControllerProxy_GetDeadLetterEvent_FullMethodName, in, out, opts...)
@@ -338,8 +373,8 @@ func (c *controllerProxyClient) GetDeadLetterEvent(ctx context.Context, in *GetD
 	return out, nil
 }
 
-func (c *controllerProxyClient) ResendDeadLetterEvent(ctx context.Context, in *ResendDeadLetterEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *controllerProxyClient) ResendDeadLetterEvent(ctx context.Context, in *ResendDeadLetterEventRequest, opts ...grpc.CallOption) (*ResendDeadLetterEventResponse, error) {
+	out := new(ResendDeadLetterEventResponse)
 	err := c.cc.Invoke(ctx, ControllerProxy_ResendDeadLetterEvent_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -347,6 +382,15 @@ func (c *controllerProxyClient) ResendDeadLetterEvent(ctx context.Context, in *R
 	return out, nil
 }
 
+func (c *controllerProxyClient) GetDeadLetterStats(ctx context.Context, in *GetDeadLetterStatsRequest, opts ...grpc.CallOption) (*GetDeadLetterStatsResponse, error) {
+	out := new(GetDeadLetterStatsResponse)
+	err := c.cc.Invoke(ctx, ControllerProxy_GetDeadLetterStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controllerProxyClient) SetDeadLetterEventOffset(ctx context.Context, in *controller.SetDeadLetterEventOffsetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, ControllerProxy_SetDeadLetterEventOffset_FullMethodName, in, out, opts...)
@@ -532,14 +576,18 @@ type ControllerProxyServer interface {
 	DisableSubscription(context.Context, *controller.DisableSubscriptionRequest) (*emptypb.Empty, error)
 	ResumeSubscription(context.Context, *controller.ResumeSubscriptionRequest) (*emptypb.Empty, error)
 	ResetOffsetToTimestamp(context.Context, *controller.ResetOffsetToTimestampRequest) (*controller.ResetOffsetToTimestampResponse, error)
+	GetSubscriptionLag(context.Context, *controller.GetSubscriptionLagRequest) (*controller.GetSubscriptionLagResponse, error)
 	// custom
 	ClusterInfo(context.Context, *emptypb.Empty) (*ClusterInfoResponse, error)
 	LookupOffset(context.Context, *LookupOffsetRequest) (*LookupOffsetResponse, error)
 	GetEvent(context.Context, *GetEventRequest) (*GetEventResponse, error)
 	ValidateSubscription(context.Context, *ValidateSubscriptionRequest) (*ValidateSubscriptionResponse, error)
+	RebalanceLeaders(context.Context, *emptypb.Empty) (*controller.RebalanceLeadersResponse, error)
+	DecommissionVolume(context.Context, *controller.DecommissionVolumeRequest) (*controller.DecommissionVolumeResponse, error)
 	// dead letter
 	GetDeadLetterEvent(context.Context, *GetDeadLetterEventRequest) (*GetDeadLetterEventResponse, error)
-	ResendDeadLetterEvent(context.Context, *ResendDeadLetterEventRequest) (*emptypb.Empty, error)
+	ResendDeadLetterEvent(context.Context, *ResendDeadLetterEventRequest) (*ResendDeadLetterEventResponse, error)
+	GetDeadLetterStats(context.Context, *GetDeadLetterStatsRequest) (*GetDeadLetterStatsResponse, error)
 	SetDeadLetterEventOffset(context.Context, *controller.SetDeadLetterEventOffsetRequest) (*emptypb.Empty, error)
 	// multiple tenant
 	GetNamespaceWithHumanFriendly(context.Context, *wrapperspb.StringValue) (*meta.Namespace, error)
@@ -616,6 +664,9 @@ func (UnimplementedControllerProxyServer) ResumeSubscription(context.Context, *c
 func (UnimplementedControllerProxyServer) ResetOffsetToTimestamp(context.Context, *controller.ResetOffsetToTimestampRequest) (*controller.ResetOffsetToTimestampResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetOffsetToTimestamp not implemented")
 }
+func (UnimplementedControllerProxyServer) GetSubscriptionLag(context.Context, *controller.GetSubscriptionLagRequest) (*controller.GetSubscriptionLagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscriptionLag not implemented")
+}
 func (UnimplementedControllerProxyServer) ClusterInfo(context.Context, *emptypb.Empty) (*ClusterInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClusterInfo not implemented")
 }
@@ -628,12 +679,21 @@ func (UnimplementedControllerProxyServer) GetEvent(context.Context, *GetEventReq
 func (UnimplementedControllerProxyServer) ValidateSubscription(context.Context, *ValidateSubscriptionRequest) (*ValidateSubscriptionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateSubscription not implemented")
 }
+func (UnimplementedControllerProxyServer) RebalanceLeaders(context.Context, *emptypb.Empty) (*controller.RebalanceLeadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalanceLeaders not implemented")
+}
+func (UnimplementedControllerProxyServer) DecommissionVolume(context.Context, *controller.DecommissionVolumeRequest) (*controller.DecommissionVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecommissionVolume not implemented")
+}
 func (UnimplementedControllerProxyServer) GetDeadLetterEvent(context.Context, *GetDeadLetterEventRequest) (*GetDeadLetterEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDeadLetterEvent not implemented")
 }
-func (UnimplementedControllerProxyServer) ResendDeadLetterEvent(context.Context, *ResendDeadLetterEventRequest) (*emptypb.Empty, error) {
+func (UnimplementedControllerProxyServer) ResendDeadLetterEvent(context.Context, *ResendDeadLetterEventRequest) (*ResendDeadLetterEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResendDeadLetterEvent not implemented")
 }
+func (UnimplementedControllerProxyServer) GetDeadLetterStats(context.Context, *GetDeadLetterStatsRequest) (*GetDeadLetterStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeadLetterStats not implemented")
+}
 func (UnimplementedControllerProxyServer) SetDeadLetterEventOffset(context.Context, *controller.SetDeadLetterEventOffsetRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetDeadLetterEventOffset not implemented")
 }
@@ -1006,6 +1066,24 @@ func _ControllerProxy_ResetOffsetToTimestamp_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControllerProxy_GetSubscriptionLag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.GetSubscriptionLagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).GetSubscriptionLag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerProxy_GetSubscriptionLag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).GetSubscriptionLag(ctx, req.(*controller.GetSubscriptionLagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ControllerProxy_ClusterInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -1078,6 +1156,42 @@ func _ControllerProxy_ValidateSubscription_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControllerProxy_RebalanceLeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).RebalanceLeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerProxy_RebalanceLeaders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).RebalanceLeaders(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerProxy_DecommissionVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.DecommissionVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).DecommissionVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerProxy_DecommissionVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).DecommissionVolume(ctx, req.(*controller.DecommissionVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ControllerProxy_GetDeadLetterEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDeadLetterEventRequest)
 	if err := dec(in); err != nil {
@@ -1114,6 +1228,24 @@ func _ControllerProxy_ResendDeadLetterEvent_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControllerProxy_GetDeadLetterStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeadLetterStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).GetDeadLetterStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerProxy_GetDeadLetterStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).GetDeadLetterStats(ctx, req.(*GetDeadLetterStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ControllerProxy_SetDeadLetterEventOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(controller.SetDeadLetterEventOffsetRequest)
 	if err := dec(in); err != nil {
@@ -1513,6 +1645,10 @@ var ControllerProxy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetOffsetToTimestamp",
 			Handler:    _ControllerProxy_ResetOffsetToTimestamp_Handler,
 		},
+		{
+			MethodName: "GetSubscriptionLag",
+			Handler:    _ControllerProxy_GetSubscriptionLag_Handler,
+		},
 		{
 			MethodName: "ClusterInfo",
 			Handler:    _ControllerProxy_ClusterInfo_Handler,
@@ -1529,6 +1665,14 @@ var ControllerProxy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateSubscription",
 			Handler:    _ControllerProxy_ValidateSubscription_Handler,
 		},
+		{
+			MethodName: "RebalanceLeaders",
+			Handler:    _ControllerProxy_RebalanceLeaders_Handler,
+		},
+		{
+			MethodName: "DecommissionVolume",
+			Handler:    _ControllerProxy_DecommissionVolume_Handler,
+		},
 		{
 			MethodName: "GetDeadLetterEvent",
 			Handler:    _ControllerProxy_GetDeadLetterEvent_Handler,
@@ -1537,6 +1681,10 @@ var ControllerProxy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResendDeadLetterEvent",
 			Handler:    _ControllerProxy_ResendDeadLetterEvent_Handler,
 		},
+		{
+			MethodName: "GetDeadLetterStats",
+			Handler:    _ControllerProxy_GetDeadLetterStats_Handler,
+		},
 		{
 			MethodName: "SetDeadLetterEventOffset",
 			Handler:    _ControllerProxy_SetDeadLetterEventOffset_Handler,
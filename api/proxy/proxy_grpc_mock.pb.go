@@ -179,6 +179,26 @@ func (mr *MockControllerProxyClientMockRecorder) CreateUser(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockControllerProxyClient)(nil).CreateUser), varargs...)
 }
 
+// DecommissionVolume mocks base method.
+func (m *MockControllerProxyClient) DecommissionVolume(ctx context.Context, in *controller.DecommissionVolumeRequest, opts ...grpc.CallOption) (*controller.DecommissionVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DecommissionVolume", varargs...)
+	ret0, _ := ret[0].(*controller.DecommissionVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecommissionVolume indicates an expected call of DecommissionVolume.
+func (mr *MockControllerProxyClientMockRecorder) DecommissionVolume(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecommissionVolume", reflect.TypeOf((*MockControllerProxyClient)(nil).DecommissionVolume), varargs...)
+}
+
 // DeleteEventbus mocks base method.
 func (m *MockControllerProxyClient) DeleteEventbus(ctx context.Context, in *wrapperspb.UInt64Value, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -319,6 +339,26 @@ func (mr *MockControllerProxyClientMockRecorder) GetDeadLetterEvent(ctx, in inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetterEvent", reflect.TypeOf((*MockControllerProxyClient)(nil).GetDeadLetterEvent), varargs...)
 }
 
+// GetDeadLetterStats mocks base method.
+func (m *MockControllerProxyClient) GetDeadLetterStats(ctx context.Context, in *GetDeadLetterStatsRequest, opts ...grpc.CallOption) (*GetDeadLetterStatsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDeadLetterStats", varargs...)
+	ret0, _ := ret[0].(*GetDeadLetterStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetterStats indicates an expected call of GetDeadLetterStats.
+func (mr *MockControllerProxyClientMockRecorder) GetDeadLetterStats(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetterStats", reflect.TypeOf((*MockControllerProxyClient)(nil).GetDeadLetterStats), varargs...)
+}
+
 // GetEvent mocks base method.
 func (m *MockControllerProxyClient) GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*GetEventResponse, error) {
 	m.ctrl.T.Helper()
@@ -459,6 +499,26 @@ func (mr *MockControllerProxyClientMockRecorder) GetSubscription(ctx, in interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockControllerProxyClient)(nil).GetSubscription), varargs...)
 }
 
+// GetSubscriptionLag mocks base method.
+func (m *MockControllerProxyClient) GetSubscriptionLag(ctx context.Context, in *controller.GetSubscriptionLagRequest, opts ...grpc.CallOption) (*controller.GetSubscriptionLagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSubscriptionLag", varargs...)
+	ret0, _ := ret[0].(*controller.GetSubscriptionLagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscriptionLag indicates an expected call of GetSubscriptionLag.
+func (mr *MockControllerProxyClientMockRecorder) GetSubscriptionLag(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionLag", reflect.TypeOf((*MockControllerProxyClient)(nil).GetSubscriptionLag), varargs...)
+}
+
 // GetUser mocks base method.
 func (m *MockControllerProxyClient) GetUser(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*meta.User, error) {
 	m.ctrl.T.Helper()
@@ -679,15 +739,35 @@ func (mr *MockControllerProxyClientMockRecorder) LookupOffset(ctx, in interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupOffset", reflect.TypeOf((*MockControllerProxyClient)(nil).LookupOffset), varargs...)
 }
 
+// RebalanceLeaders mocks base method.
+func (m *MockControllerProxyClient) RebalanceLeaders(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.RebalanceLeadersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RebalanceLeaders", varargs...)
+	ret0, _ := ret[0].(*controller.RebalanceLeadersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebalanceLeaders indicates an expected call of RebalanceLeaders.
+func (mr *MockControllerProxyClientMockRecorder) RebalanceLeaders(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebalanceLeaders", reflect.TypeOf((*MockControllerProxyClient)(nil).RebalanceLeaders), varargs...)
+}
+
 // ResendDeadLetterEvent mocks base method.
-func (m *MockControllerProxyClient) ResendDeadLetterEvent(ctx context.Context, in *ResendDeadLetterEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (m *MockControllerProxyClient) ResendDeadLetterEvent(ctx context.Context, in *ResendDeadLetterEventRequest, opts ...grpc.CallOption) (*ResendDeadLetterEventResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ResendDeadLetterEvent", varargs...)
-	ret0, _ := ret[0].(*emptypb.Empty)
+	ret0, _ := ret[0].(*ResendDeadLetterEventResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -987,6 +1067,21 @@ func (mr *MockControllerProxyServerMockRecorder) CreateUser(ctx, in interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockControllerProxyServer)(nil).CreateUser), ctx, in)
 }
 
+// DecommissionVolume mocks base method.
+func (m *MockControllerProxyServer) DecommissionVolume(ctx context.Context, in *controller.DecommissionVolumeRequest) (*controller.DecommissionVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecommissionVolume", ctx, in)
+	ret0, _ := ret[0].(*controller.DecommissionVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecommissionVolume indicates an expected call of DecommissionVolume.
+func (mr *MockControllerProxyServerMockRecorder) DecommissionVolume(ctx, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecommissionVolume", reflect.TypeOf((*MockControllerProxyServer)(nil).DecommissionVolume), ctx, in)
+}
+
 // DeleteEventbus mocks base method.
 func (m *MockControllerProxyServer) DeleteEventbus(ctx context.Context, in *wrapperspb.UInt64Value) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -1092,6 +1187,21 @@ func (mr *MockControllerProxyServerMockRecorder) GetDeadLetterEvent(ctx, in inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetterEvent", reflect.TypeOf((*MockControllerProxyServer)(nil).GetDeadLetterEvent), ctx, in)
 }
 
+// GetDeadLetterStats mocks base method.
+func (m *MockControllerProxyServer) GetDeadLetterStats(ctx context.Context, in *GetDeadLetterStatsRequest) (*GetDeadLetterStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetterStats", ctx, in)
+	ret0, _ := ret[0].(*GetDeadLetterStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetterStats indicates an expected call of GetDeadLetterStats.
+func (mr *MockControllerProxyServerMockRecorder) GetDeadLetterStats(ctx, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetterStats", reflect.TypeOf((*MockControllerProxyServer)(nil).GetDeadLetterStats), ctx, in)
+}
+
 // GetEvent mocks base method.
 func (m *MockControllerProxyServer) GetEvent(ctx context.Context, in *GetEventRequest) (*GetEventResponse, error) {
 	m.ctrl.T.Helper()
@@ -1197,6 +1307,21 @@ func (mr *MockControllerProxyServerMockRecorder) GetSubscription(ctx, in interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockControllerProxyServer)(nil).GetSubscription), ctx, in)
 }
 
+// GetSubscriptionLag mocks base method.
+func (m *MockControllerProxyServer) GetSubscriptionLag(ctx context.Context, in *controller.GetSubscriptionLagRequest) (*controller.GetSubscriptionLagResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptionLag", ctx, in)
+	ret0, _ := ret[0].(*controller.GetSubscriptionLagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscriptionLag indicates an expected call of GetSubscriptionLag.
+func (mr *MockControllerProxyServerMockRecorder) GetSubscriptionLag(ctx, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionLag", reflect.TypeOf((*MockControllerProxyServer)(nil).GetSubscriptionLag), ctx, in)
+}
+
 // GetUser mocks base method.
 func (m *MockControllerProxyServer) GetUser(ctx context.Context, in *wrapperspb.StringValue) (*meta.User, error) {
 	m.ctrl.T.Helper()
@@ -1362,11 +1487,26 @@ func (mr *MockControllerProxyServerMockRecorder) LookupOffset(ctx, in interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupOffset", reflect.TypeOf((*MockControllerProxyServer)(nil).LookupOffset), ctx, in)
 }
 
+// RebalanceLeaders mocks base method.
+func (m *MockControllerProxyServer) RebalanceLeaders(ctx context.Context, in *emptypb.Empty) (*controller.RebalanceLeadersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebalanceLeaders", ctx, in)
+	ret0, _ := ret[0].(*controller.RebalanceLeadersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebalanceLeaders indicates an expected call of RebalanceLeaders.
+func (mr *MockControllerProxyServerMockRecorder) RebalanceLeaders(ctx, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebalanceLeaders", reflect.TypeOf((*MockControllerProxyServer)(nil).RebalanceLeaders), ctx, in)
+}
+
 // ResendDeadLetterEvent mocks base method.
-func (m *MockControllerProxyServer) ResendDeadLetterEvent(ctx context.Context, in *ResendDeadLetterEventRequest) (*emptypb.Empty, error) {
+func (m *MockControllerProxyServer) ResendDeadLetterEvent(ctx context.Context, in *ResendDeadLetterEventRequest) (*ResendDeadLetterEventResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ResendDeadLetterEvent", ctx, in)
-	ret0, _ := ret[0].(*emptypb.Empty)
+	ret0, _ := ret[0].(*ResendDeadLetterEventResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -38,6 +38,160 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type MembershipChangeType int32
+
+const (
+	MembershipChangeType_ADD_LEARNER     MembershipChangeType = 0
+	MembershipChangeType_PROMOTE_LEARNER MembershipChangeType = 1
+	MembershipChangeType_REMOVE_PEER     MembershipChangeType = 2
+)
+
+// Enum value maps for MembershipChangeType.
+var (
+	MembershipChangeType_name = map[int32]string{
+		0: "ADD_LEARNER",
+		1: "PROMOTE_LEARNER",
+		2: "REMOVE_PEER",
+	}
+	MembershipChangeType_value = map[string]int32{
+		"ADD_LEARNER":     0,
+		"PROMOTE_LEARNER": 1,
+		"REMOVE_PEER":     2,
+	}
+)
+
+func (x MembershipChangeType) Enum() *MembershipChangeType {
+	p := new(MembershipChangeType)
+	*p = x
+	return p
+}
+
+func (x MembershipChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MembershipChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_vanus_core_segment_segment_proto_enumTypes[0].Descriptor()
+}
+
+func (MembershipChangeType) Type() protoreflect.EnumType {
+	return &file_vanus_core_segment_segment_proto_enumTypes[0]
+}
+
+func (x MembershipChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MembershipChangeType.Descriptor instead.
+func (MembershipChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_vanus_core_segment_segment_proto_rawDescGZIP(), []int{0}
+}
+
+type BlockIndex int32
+
+const (
+	BlockIndex_STIME     BlockIndex = 0
+	BlockIndex_ID        BlockIndex = 1
+	BlockIndex_EXTENSION BlockIndex = 2
+)
+
+// Enum value maps for BlockIndex.
+var (
+	BlockIndex_name = map[int32]string{
+		0: "STIME",
+		1: "ID",
+		2: "EXTENSION",
+	}
+	BlockIndex_value = map[string]int32{
+		"STIME":     0,
+		"ID":        1,
+		"EXTENSION": 2,
+	}
+)
+
+func (x BlockIndex) Enum() *BlockIndex {
+	p := new(BlockIndex)
+	*p = x
+	return p
+}
+
+func (x BlockIndex) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BlockIndex) Descriptor() protoreflect.EnumDescriptor {
+	return file_vanus_core_segment_segment_proto_enumTypes[1].Descriptor()
+}
+
+func (BlockIndex) Type() protoreflect.EnumType {
+	return &file_vanus_core_segment_segment_proto_enumTypes[1]
+}
+
+func (x BlockIndex) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BlockIndex.Descriptor instead.
+func (BlockIndex) EnumDescriptor() ([]byte, []int) {
+	return file_vanus_core_segment_segment_proto_rawDescGZIP(), []int{1}
+}
+
+type LookupKeyFlag int32
+
+const (
+	// The first event whose key equals to key.
+	LookupKeyFlag_EXACT LookupKeyFlag = 0
+	// The first event in key order whose key has prefix key.
+	LookupKeyFlag_PREFIX LookupKeyFlag = 1
+	// The last event in key order whose key has prefix key.
+	LookupKeyFlag_PREFIX_LAST LookupKeyFlag = 2
+	// Like PREFIX_LAST, but the last event whose key is less than key if no key has the prefix.
+	LookupKeyFlag_PREFIX_LAST_OR_PREV LookupKeyFlag = 3
+)
+
+// Enum value maps for LookupKeyFlag.
+var (
+	LookupKeyFlag_name = map[int32]string{
+		0: "EXACT",
+		1: "PREFIX",
+		2: "PREFIX_LAST",
+		3: "PREFIX_LAST_OR_PREV",
+	}
+	LookupKeyFlag_value = map[string]int32{
+		"EXACT":               0,
+		"PREFIX":              1,
+		"PREFIX_LAST":         2,
+		"PREFIX_LAST_OR_PREV": 3,
+	}
+)
+
+func (x LookupKeyFlag) Enum() *LookupKeyFlag {
+	p := new(LookupKeyFlag)
+	*p = x
+	return p
+}
+
+func (x LookupKeyFlag) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LookupKeyFlag) Descriptor() protoreflect.EnumDescriptor {
+	return file_vanus_core_segment_segment_proto_enumTypes[2].Descriptor()
+}
+
+func (LookupKeyFlag) Type() protoreflect.EnumType {
+	return &file_vanus_core_segment_segment_proto_enumTypes[2]
+}
+
+func (x LookupKeyFlag) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LookupKeyFlag.Descriptor instead.
+func (LookupKeyFlag) EnumDescriptor() ([]byte, []int) {
+	return file_vanus_core_segment_segment_proto_rawDescGZIP(), []int{2}
+}
+
 type StartSegmentServerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -204,8 +358,9 @@ type CreateBlockRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Size int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Id                uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Size              int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	CompressAlgorithm meta.CompressAlgorithm `protobuf:"varint,3,opt,name=compress_algorithm,json=compressAlgorithm,proto3,enum=vanus.core.meta.CompressAlgorithm" json:"compress_algorithm,omitempty"`
 }
 
 func (x *CreateBlockRequest) Reset() {
@@ -254,6 +409,13 @@ func (x *CreateBlockRequest) GetSize() int64 {
 	return 0
 }
 
+func (x *CreateBlockRequest) GetCompressAlgorithm() meta.CompressAlgorithm {
+	if x != nil {
+		return x.CompressAlgorithm
+	}
+	return meta.CompressAlgorithm(0)
+}
+
 type RemoveBlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -573,6 +735,136 @@ func (*InactivateSegmentResponse) Descriptor() ([]byte, []int) {
 	return file_vanus_core_segment_segment_proto_rawDescGZIP(), []int{11}
 }
 
+type ChangeMembershipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the leader block of the replica group.
+	BlockId uint64               `protobuf:"varint,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	Type    MembershipChangeType `protobuf:"varint,2,opt,name=type,proto3,enum=vanus.core.segment.MembershipChangeType" json:"type,omitempty"`
+	// the block to be added, promoted or removed, and its server endpoint.
+	PeerId   uint64 `protobuf:"varint,3,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Endpoint string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+}
+
+func (x *ChangeMembershipRequest) Reset() {
+	*x = ChangeMembershipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vanus_core_segment_segment_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeMembershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeMembershipRequest) ProtoMessage() {}
+
+func (x *ChangeMembershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vanus_core_segment_segment_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeMembershipRequest.ProtoReflect.Descriptor instead.
+func (*ChangeMembershipRequest) Descriptor() ([]byte, []int) {
+	return file_vanus_core_segment_segment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ChangeMembershipRequest) GetBlockId() uint64 {
+	if x != nil {
+		return x.BlockId
+	}
+	return 0
+}
+
+func (x *ChangeMembershipRequest) GetType() MembershipChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return MembershipChangeType_ADD_LEARNER
+}
+
+func (x *ChangeMembershipRequest) GetPeerId() uint64 {
+	if x != nil {
+		return x.PeerId
+	}
+	return 0
+}
+
+func (x *ChangeMembershipRequest) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+type TransferLeadershipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the leader block of the replica group.
+	BlockId uint64 `protobuf:"varint,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	// the voter block which will be the new leader.
+	TransfereeId uint64 `protobuf:"varint,2,opt,name=transferee_id,json=transfereeId,proto3" json:"transferee_id,omitempty"`
+}
+
+func (x *TransferLeadershipRequest) Reset() {
+	*x = TransferLeadershipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vanus_core_segment_segment_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferLeadershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferLeadershipRequest) ProtoMessage() {}
+
+func (x *TransferLeadershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vanus_core_segment_segment_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferLeadershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferLeadershipRequest) Descriptor() ([]byte, []int) {
+	return file_vanus_core_segment_segment_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TransferLeadershipRequest) GetBlockId() uint64 {
+	if x != nil {
+		return x.BlockId
+	}
+	return 0
+}
+
+func (x *TransferLeadershipRequest) GetTransfereeId() uint64 {
+	if x != nil {
+		return x.TransfereeId
+	}
+	return 0
+}
+
 type AppendToBlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -585,7 +877,7 @@ type AppendToBlockRequest struct {
 func (x *AppendToBlockRequest) Reset() {
 	*x = AppendToBlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_segment_segment_proto_msgTypes[12]
+		mi := &file_vanus_core_segment_segment_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -598,7 +890,7 @@ func (x *AppendToBlockRequest) String() string {
 func (*AppendToBlockRequest) ProtoMessage() {}
 
 func (x *AppendToBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_segment_segment_proto_msgTypes[12]
+	mi := &file_vanus_core_segment_segment_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -611,7 +903,7 @@ func (x *AppendToBlockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppendToBlockRequest.ProtoReflect.Descriptor instead.
 func (*AppendToBlockRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_segment_segment_proto_rawDescGZIP(), []int{12}
+	return file_vanus_core_segment_segment_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AppendToBlockRequest) GetBlockId() uint64 {
@@ -639,7 +931,7 @@ type AppendToBlockResponse struct {
 func (x *AppendToBlockResponse) Reset() {
 	*x = AppendToBlockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_segment_segment_proto_msgTypes[13]
+		mi := &file_vanus_core_segment_segment_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -652,7 +944,7 @@ func (x *AppendToBlockResponse) String() string {
 func (*AppendToBlockResponse) ProtoMessage() {}
 
 func (x *AppendToBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_segment_segment_proto_msgTypes[13]
+	mi := &file_vanus_core_segment_segment_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -665,7 +957,7 @@ func (x *AppendToBlockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppendToBlockResponse.ProtoReflect.Descriptor instead.
 func (*AppendToBlockResponse) Descriptor() ([]byte, []int) {
-	return file_vanus_core_segment_segment_proto_rawDescGZIP(), []int{13}
+	return file_vanus_core_segment_segment_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AppendToBlockResponse) GetOffsets() []int64 {
@@ -685,12 +977,14 @@ type ReadFromBlockRequest struct {
 	Number  int64  `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
 	// polling timeout in milliseconds, 0 is disable.
 	PollingTimeout uint32 `protobuf:"varint,4,opt,name=polling_timeout,json=pollingTimeout,proto3" json:"polling_timeout,omitempty"`
+	// only events matching the filter are returned if it is set.
+	Filter *AttributeFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
 func (x *ReadFromBlockRequest) Reset() {
 	*x = ReadFromBlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_segment_segment_proto_msgTypes[14]
+		mi := &file_vanus_core_segment_segment_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -703,7 +997,7 @@ func (x *ReadFromBlockRequest) String() string {
 func (*ReadFromBlockRequest) ProtoMessage() {}
 
 func (x *ReadFromBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_segment_segment_proto_msgTypes[14]
+	mi := &file_vanus_core_segment_segment_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -716,7 +1010,7 @@ func (x *ReadFromBlockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadFromBlockRequest.ProtoReflect.Descriptor instead.
 func (*ReadFromBlockRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_segment_segment_proto_rawDescGZIP(), []int{14}
+	return file_vanus_core_segment_segment_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ReadFromBlockRequest) GetBlockId() uint64 {
@@ -747,6 +1041,13 @@ func (x *ReadFromBlockRequest) GetPollingTimeout() uint32 {
 	return 0
 }
 
+func (x *ReadFromBlockRequest) GetFilter() *AttributeFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
 type ReadFromBlockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -756,12 +1057,15 @@ type ReadFromBlockResponse struct {
 	// Don't use this now, just used to optimize cpu overhead of SegmentServer in
 	// the future for backward compatibility
 	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// the offset next to the last scanned event, events between the last
+	// returned event and it don't match the filter.
+	NextOffset int64 `protobuf:"varint,3,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"`
 }
 
 func (x *ReadFromBlockResponse) Reset() {
 	*x = ReadFromBlockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_segment_segment_proto_msgTypes[15]
+		mi := &file_vanus_core_segment_segment_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -774,7 +1078,7 @@ func (x *ReadFromBlockResponse) String() string {
 func (*ReadFromBlockResponse) ProtoMessage() {}
 
 func (x *ReadFromBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_segment_segment_proto_msgTypes[15]
+	mi := &file_vanus_core_segment_segment_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -787,7 +1091,7 @@ func (x *ReadFromBlockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadFromBlockResponse.ProtoReflect.Descriptor instead.
 func (*ReadFromBlockResponse) Descriptor() ([]byte, []int) {
-	return file_vanus_core_segment_segment_proto_rawDescGZIP(), []int{15}
+	return file_vanus_core_segment_segment_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ReadFromBlockResponse) GetEvents() *cloudevents.CloudEventBatch {
@@ -804,6 +1108,79 @@ func (x *ReadFromBlockResponse) GetPayload() []byte {
 	return nil
 }
 
+func (x *ReadFromBlockResponse) GetNextOffset() int64 {
+	if x != nil {
+		return x.NextOffset
+	}
+	return 0
+}
+
+// AttributeFilter matches the attributes of CloudEvents, an event matches it
+// only if all conditions are met. The key of conditions is the name of an
+// attribute, and the value is compared with the value of the attribute.
+type AttributeFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exact  map[string]string `protobuf:"bytes,1,rep,name=exact,proto3" json:"exact,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Prefix map[string]string `protobuf:"bytes,2,rep,name=prefix,proto3" json:"prefix,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Suffix map[string]string `protobuf:"bytes,3,rep,name=suffix,proto3" json:"suffix,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *AttributeFilter) Reset() {
+	*x = AttributeFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vanus_core_segment_segment_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttributeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeFilter) ProtoMessage() {}
+
+func (x *AttributeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_vanus_core_segment_segment_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeFilter.ProtoReflect.Descriptor instead.
+func (*AttributeFilter) Descriptor() ([]byte, []int) {
+	return file_vanus_core_segment_segment_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AttributeFilter) GetExact() map[string]string {
+	if x != nil {
+		return x.Exact
+	}
+	return nil
+}
+
+func (x *AttributeFilter) GetPrefix() map[string]string {
+	if x != nil {
+		return x.Prefix
+	}
+	return nil
+}
+
+func (x *AttributeFilter) GetSuffix() map[string]string {
+	if x != nil {
+		return x.Suffix
+	}
+	return nil
+}
+
 type LookupOffsetInBlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -811,12 +1188,18 @@ type LookupOffsetInBlockRequest struct {
 
 	BlockId uint64 `protobuf:"varint,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
 	Stime   int64  `protobuf:"varint,2,opt,name=stime,proto3" json:"stime,omitempty"`
+	// index is the index of block used to lookup, stime index is used by default.
+	Index BlockIndex `protobuf:"varint,3,opt,name=index,proto3,enum=vanus.core.segment.BlockIndex" json:"index,omitempty"`
+	// key is the attribute value to lookup, used by id and extension index.
+	Key string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	// flag is the way to match key, used by id and extension index.
+	Flag LookupKeyFlag `protobuf:"varint,5,opt,name=flag,proto3,enum=vanus.core.segment.LookupKeyFlag" json:"flag,omitempty"`
 }
 
 func (x *LookupOffsetInBlockRequest) Reset() {
 	*x = LookupOffsetInBlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_segment_segment_proto_msgTypes[16]
+		mi := &file_vanus_core_segment_segment_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -829,7 +1212,7 @@ func (x *LookupOffsetInBlockRequest) String() string {
 func (*LookupOffsetInBlockRequest) ProtoMessage() {}
 
 func (x *LookupOffsetInBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_segment_segment_proto_msgTypes[16]
+	mi := &file_vanus_core_segment_segment_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -842,7 +1225,7 @@ func (x *LookupOffsetInBlockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LookupOffsetInBlockRequest.ProtoReflect.Descriptor instead.
 func (*LookupOffsetInBlockRequest) Descriptor() ([]byte, []int) {
-	return file_vanus_core_segment_segment_proto_rawDescGZIP(), []int{16}
+	return file_vanus_core_segment_segment_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *LookupOffsetInBlockRequest) GetBlockId() uint64 {
@@ -859,6 +1242,27 @@ func (x *LookupOffsetInBlockRequest) GetStime() int64 {
 	return 0
 }
 
+func (x *LookupOffsetInBlockRequest) GetIndex() BlockIndex {
+	if x != nil {
+		return x.Index
+	}
+	return BlockIndex_STIME
+}
+
+func (x *LookupOffsetInBlockRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LookupOffsetInBlockRequest) GetFlag() LookupKeyFlag {
+	if x != nil {
+		return x.Flag
+	}
+	return LookupKeyFlag_EXACT
+}
+
 type LookupOffsetInBlockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -870,7 +1274,7 @@ type LookupOffsetInBlockResponse struct {
 func (x *LookupOffsetInBlockResponse) Reset() {
 	*x = LookupOffsetInBlockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_segment_segment_proto_msgTypes[17]
+		mi := &file_vanus_core_segment_segment_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -883,7 +1287,7 @@ func (x *LookupOffsetInBlockResponse) String() string {
 func (*LookupOffsetInBlockResponse) ProtoMessage() {}
 
 func (x *LookupOffsetInBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_segment_segment_proto_msgTypes[17]
+	mi := &file_vanus_core_segment_segment_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -896,7 +1300,7 @@ func (x *LookupOffsetInBlockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LookupOffsetInBlockResponse.ProtoReflect.Descriptor instead.
 func (*LookupOffsetInBlockResponse) Descriptor() ([]byte, []int) {
-	return file_vanus_core_segment_segment_proto_rawDescGZIP(), []int{17}
+	return file_vanus_core_segment_segment_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *LookupOffsetInBlockResponse) GetOffset() int64 {
@@ -917,7 +1321,7 @@ type StatusResponse struct {
 func (x *StatusResponse) Reset() {
 	*x = StatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vanus_core_segment_segment_proto_msgTypes[18]
+		mi := &file_vanus_core_segment_segment_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -930,7 +1334,7 @@ func (x *StatusResponse) String() string {
 func (*StatusResponse) ProtoMessage() {}
 
 func (x *StatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vanus_core_segment_segment_proto_msgTypes[18]
+	mi := &file_vanus_core_segment_segment_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -943,7 +1347,7 @@ func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return file_vanus_core_segment_segment_proto_rawDescGZIP(), []int{18}
+	return file_vanus_core_segment_segment_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *StatusResponse) GetStatus() string {
@@ -978,150 +1382,235 @@ var file_vanus_core_segment_segment_proto_rawDesc = []byte{
 	0x6e, 0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x67, 0x6d, 0x65,
 	0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
 	0x1b, 0x0a, 0x19, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x0a, 0x12,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x24, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x26, 0x0a, 0x14,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x15, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
-	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x61,
-	0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0xf6, 0x01, 0x0a, 0x16, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x49,
-	0x64, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x54, 0x0a, 0x08, 0x72,
-	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e,
-	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x19,
-	0x0a, 0x17, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x49, 0x6e, 0x61,
+	0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8b, 0x01, 0x0a,
+	0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x22, 0x24, 0x0a, 0x12, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x15, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x36, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x6d, 0x65, 0x74,
+	0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0xf6, 0x01, 0x0a, 0x16, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x6c, 0x6f, 0x67, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12,
+	0x54, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x38, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x0a,
+	0x18, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x49, 0x6e, 0x61,
 	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x72, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43,
-	0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x06,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x31, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64,
-	0x54, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03,
-	0x52, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x14, 0x52, 0x65,
-	0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x27, 0x0a,
-	0x0f, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x54,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x72, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x46, 0x72,
-	0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3f, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x27, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6c, 0x6f,
-	0x75, 0x64, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x4d, 0x0a, 0x1a, 0x4c, 0x6f,
-	0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x35, 0x0a, 0x1b, 0x4c, 0x6f, 0x6f,
-	0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x22, 0x28, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0xb1, 0x08, 0x0a, 0x0d, 0x53,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x66, 0x0a, 0x05,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2d, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x2c, 0x2e, 0x76,
-	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x76, 0x61, 0x6e,
-	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0b, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x26, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4d, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x26, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x64, 0x0a, 0x0d, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x28, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a,
-	0x0f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x2a, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x76,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x3c, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x76, 0x61,
+	0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x65,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x22, 0x5b, 0x0a, 0x19, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x65, 0x65, 0x49, 0x64, 0x22, 0x72, 0x0a,
+	0x14, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64,
+	0x12, 0x3f, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x31, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x61, 0x64, 0x46, 0x72, 0x6f,
+	0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x6f, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x3b, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x93,
+	0x01, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x22, 0x99, 0x03, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x05, 0x65, 0x78, 0x61, 0x63,
+	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x61,
+	0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x12, 0x47,
+	0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69,
+	0x78, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x66,
+	0x66, 0x69, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78,
+	0x1a, 0x38, 0x0a, 0x0a, 0x45, 0x78, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xcc, 0x01, 0x0a, 0x1a, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x49, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x34, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1e, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x4b, 0x65, 0x79, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x22,
+	0x35, 0x0a, 0x1b, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49,
+	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x28, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x2a, 0x4d, 0x0a, 0x14, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x44, 0x44, 0x5f,
+	0x4c, 0x45, 0x41, 0x52, 0x4e, 0x45, 0x52, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f,
+	0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x4c, 0x45, 0x41, 0x52, 0x4e, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0f,
+	0x0a, 0x0b, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x50, 0x45, 0x45, 0x52, 0x10, 0x02, 0x2a,
+	0x2e, 0x0a, 0x0a, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x09, 0x0a,
+	0x05, 0x53, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x49, 0x44, 0x10, 0x01,
+	0x12, 0x0d, 0x0a, 0x09, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x2a,
+	0x50, 0x0a, 0x0d, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x46, 0x6c, 0x61, 0x67,
+	0x12, 0x09, 0x0a, 0x05, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50,
+	0x52, 0x45, 0x46, 0x49, 0x58, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x45, 0x46, 0x49,
+	0x58, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x45, 0x46,
+	0x49, 0x58, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x4f, 0x52, 0x5f, 0x50, 0x52, 0x45, 0x56, 0x10,
+	0x03, 0x32, 0xe7, 0x09, 0x0a, 0x0d, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x12, 0x66, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2d, 0x2e, 0x76,
 	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x11, 0x49, 0x6e, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c,
+	0x74, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x76, 0x61,
+	0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x04, 0x53,
+	0x74, 0x6f, 0x70, 0x12, 0x2c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2d, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4d, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x26, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x4d, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x26,
 	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x64,
+	0x0a, 0x0d, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x28, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x59, 0x0a, 0x11, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x57, 0x0a, 0x10, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12,
+	0x2b, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x64, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x54, 0x6f,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x28, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e,
-	0x64, 0x54, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x29, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0d, 0x52, 0x65,
-	0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x28, 0x2e, 0x76, 0x61,
-	0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46,
-	0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x76, 0x0a, 0x13, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x49, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2e, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x6f, 0x6f,
-	0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x6f, 0x6f,
-	0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x22, 0x2e, 0x76, 0x61, 0x6e,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x5b, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x2d, 0x2e, 0x76, 0x61, 0x6e,
 	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x29,
-	0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x61, 0x6e,
-	0x75, 0x73, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x64, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x28, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x54, 0x6f,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x64, 0x46,
+	0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x28, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a,
+	0x13, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2e, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x22, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x29, 0x5a, 0x27, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2d,
+	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1136,66 +1625,89 @@ func file_vanus_core_segment_segment_proto_rawDescGZIP() []byte {
 	return file_vanus_core_segment_segment_proto_rawDescData
 }
 
-var file_vanus_core_segment_segment_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_vanus_core_segment_segment_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_vanus_core_segment_segment_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_vanus_core_segment_segment_proto_goTypes = []interface{}{
-	(*StartSegmentServerRequest)(nil),   // 0: vanus.core.segment.StartSegmentServerRequest
-	(*StartSegmentServerResponse)(nil),  // 1: vanus.core.segment.StartSegmentServerResponse
-	(*StopSegmentServerRequest)(nil),    // 2: vanus.core.segment.StopSegmentServerRequest
-	(*StopSegmentServerResponse)(nil),   // 3: vanus.core.segment.StopSegmentServerResponse
-	(*CreateBlockRequest)(nil),          // 4: vanus.core.segment.CreateBlockRequest
-	(*RemoveBlockRequest)(nil),          // 5: vanus.core.segment.RemoveBlockRequest
-	(*DescribeBlockRequest)(nil),        // 6: vanus.core.segment.DescribeBlockRequest
-	(*DescribeBlockResponse)(nil),       // 7: vanus.core.segment.DescribeBlockResponse
-	(*ActivateSegmentRequest)(nil),      // 8: vanus.core.segment.ActivateSegmentRequest
-	(*ActivateSegmentResponse)(nil),     // 9: vanus.core.segment.ActivateSegmentResponse
-	(*InactivateSegmentRequest)(nil),    // 10: vanus.core.segment.InactivateSegmentRequest
-	(*InactivateSegmentResponse)(nil),   // 11: vanus.core.segment.InactivateSegmentResponse
-	(*AppendToBlockRequest)(nil),        // 12: vanus.core.segment.AppendToBlockRequest
-	(*AppendToBlockResponse)(nil),       // 13: vanus.core.segment.AppendToBlockResponse
-	(*ReadFromBlockRequest)(nil),        // 14: vanus.core.segment.ReadFromBlockRequest
-	(*ReadFromBlockResponse)(nil),       // 15: vanus.core.segment.ReadFromBlockResponse
-	(*LookupOffsetInBlockRequest)(nil),  // 16: vanus.core.segment.LookupOffsetInBlockRequest
-	(*LookupOffsetInBlockResponse)(nil), // 17: vanus.core.segment.LookupOffsetInBlockResponse
-	(*StatusResponse)(nil),              // 18: vanus.core.segment.StatusResponse
-	nil,                                 // 19: vanus.core.segment.ActivateSegmentRequest.ReplicasEntry
-	(*config.ServerConfig)(nil),         // 20: vanus.core.config.ServerConfig
-	(*meta.SegmentHealthInfo)(nil),      // 21: vanus.core.meta.SegmentHealthInfo
-	(*cloudevents.CloudEventBatch)(nil), // 22: vanus.core.cloudevents.CloudEventBatch
-	(*emptypb.Empty)(nil),               // 23: google.protobuf.Empty
+	(MembershipChangeType)(0),           // 0: vanus.core.segment.MembershipChangeType
+	(BlockIndex)(0),                     // 1: vanus.core.segment.BlockIndex
+	(LookupKeyFlag)(0),                  // 2: vanus.core.segment.LookupKeyFlag
+	(*StartSegmentServerRequest)(nil),   // 3: vanus.core.segment.StartSegmentServerRequest
+	(*StartSegmentServerResponse)(nil),  // 4: vanus.core.segment.StartSegmentServerResponse
+	(*StopSegmentServerRequest)(nil),    // 5: vanus.core.segment.StopSegmentServerRequest
+	(*StopSegmentServerResponse)(nil),   // 6: vanus.core.segment.StopSegmentServerResponse
+	(*CreateBlockRequest)(nil),          // 7: vanus.core.segment.CreateBlockRequest
+	(*RemoveBlockRequest)(nil),          // 8: vanus.core.segment.RemoveBlockRequest
+	(*DescribeBlockRequest)(nil),        // 9: vanus.core.segment.DescribeBlockRequest
+	(*DescribeBlockResponse)(nil),       // 10: vanus.core.segment.DescribeBlockResponse
+	(*ActivateSegmentRequest)(nil),      // 11: vanus.core.segment.ActivateSegmentRequest
+	(*ActivateSegmentResponse)(nil),     // 12: vanus.core.segment.ActivateSegmentResponse
+	(*InactivateSegmentRequest)(nil),    // 13: vanus.core.segment.InactivateSegmentRequest
+	(*InactivateSegmentResponse)(nil),   // 14: vanus.core.segment.InactivateSegmentResponse
+	(*ChangeMembershipRequest)(nil),     // 15: vanus.core.segment.ChangeMembershipRequest
+	(*TransferLeadershipRequest)(nil),   // 16: vanus.core.segment.TransferLeadershipRequest
+	(*AppendToBlockRequest)(nil),        // 17: vanus.core.segment.AppendToBlockRequest
+	(*AppendToBlockResponse)(nil),       // 18: vanus.core.segment.AppendToBlockResponse
+	(*ReadFromBlockRequest)(nil),        // 19: vanus.core.segment.ReadFromBlockRequest
+	(*ReadFromBlockResponse)(nil),       // 20: vanus.core.segment.ReadFromBlockResponse
+	(*AttributeFilter)(nil),             // 21: vanus.core.segment.AttributeFilter
+	(*LookupOffsetInBlockRequest)(nil),  // 22: vanus.core.segment.LookupOffsetInBlockRequest
+	(*LookupOffsetInBlockResponse)(nil), // 23: vanus.core.segment.LookupOffsetInBlockResponse
+	(*StatusResponse)(nil),              // 24: vanus.core.segment.StatusResponse
+	nil,                                 // 25: vanus.core.segment.ActivateSegmentRequest.ReplicasEntry
+	nil,                                 // 26: vanus.core.segment.AttributeFilter.ExactEntry
+	nil,                                 // 27: vanus.core.segment.AttributeFilter.PrefixEntry
+	nil,                                 // 28: vanus.core.segment.AttributeFilter.SuffixEntry
+	(*config.ServerConfig)(nil),         // 29: vanus.core.config.ServerConfig
+	(meta.CompressAlgorithm)(0),         // 30: vanus.core.meta.CompressAlgorithm
+	(*meta.SegmentHealthInfo)(nil),      // 31: vanus.core.meta.SegmentHealthInfo
+	(*cloudevents.CloudEventBatch)(nil), // 32: vanus.core.cloudevents.CloudEventBatch
+	(*emptypb.Empty)(nil),               // 33: google.protobuf.Empty
 }
 var file_vanus_core_segment_segment_proto_depIdxs = []int32{
-	20, // 0: vanus.core.segment.StartSegmentServerRequest.config:type_name -> vanus.core.config.ServerConfig
-	21, // 1: vanus.core.segment.DescribeBlockResponse.info:type_name -> vanus.core.meta.SegmentHealthInfo
-	19, // 2: vanus.core.segment.ActivateSegmentRequest.replicas:type_name -> vanus.core.segment.ActivateSegmentRequest.ReplicasEntry
-	22, // 3: vanus.core.segment.AppendToBlockRequest.events:type_name -> vanus.core.cloudevents.CloudEventBatch
-	22, // 4: vanus.core.segment.ReadFromBlockResponse.events:type_name -> vanus.core.cloudevents.CloudEventBatch
-	0,  // 5: vanus.core.segment.SegmentServer.Start:input_type -> vanus.core.segment.StartSegmentServerRequest
-	2,  // 6: vanus.core.segment.SegmentServer.Stop:input_type -> vanus.core.segment.StopSegmentServerRequest
-	4,  // 7: vanus.core.segment.SegmentServer.CreateBlock:input_type -> vanus.core.segment.CreateBlockRequest
-	5,  // 8: vanus.core.segment.SegmentServer.RemoveBlock:input_type -> vanus.core.segment.RemoveBlockRequest
-	6,  // 9: vanus.core.segment.SegmentServer.DescribeBlock:input_type -> vanus.core.segment.DescribeBlockRequest
-	8,  // 10: vanus.core.segment.SegmentServer.ActivateSegment:input_type -> vanus.core.segment.ActivateSegmentRequest
-	10, // 11: vanus.core.segment.SegmentServer.InactivateSegment:input_type -> vanus.core.segment.InactivateSegmentRequest
-	12, // 12: vanus.core.segment.SegmentServer.AppendToBlock:input_type -> vanus.core.segment.AppendToBlockRequest
-	14, // 13: vanus.core.segment.SegmentServer.ReadFromBlock:input_type -> vanus.core.segment.ReadFromBlockRequest
-	16, // 14: vanus.core.segment.SegmentServer.LookupOffsetInBlock:input_type -> vanus.core.segment.LookupOffsetInBlockRequest
-	23, // 15: vanus.core.segment.SegmentServer.Status:input_type -> google.protobuf.Empty
-	1,  // 16: vanus.core.segment.SegmentServer.Start:output_type -> vanus.core.segment.StartSegmentServerResponse
-	3,  // 17: vanus.core.segment.SegmentServer.Stop:output_type -> vanus.core.segment.StopSegmentServerResponse
-	23, // 18: vanus.core.segment.SegmentServer.CreateBlock:output_type -> google.protobuf.Empty
-	23, // 19: vanus.core.segment.SegmentServer.RemoveBlock:output_type -> google.protobuf.Empty
-	7,  // 20: vanus.core.segment.SegmentServer.DescribeBlock:output_type -> vanus.core.segment.DescribeBlockResponse
-	9,  // 21: vanus.core.segment.SegmentServer.ActivateSegment:output_type -> vanus.core.segment.ActivateSegmentResponse
-	23, // 22: vanus.core.segment.SegmentServer.InactivateSegment:output_type -> google.protobuf.Empty
-	13, // 23: vanus.core.segment.SegmentServer.AppendToBlock:output_type -> vanus.core.segment.AppendToBlockResponse
-	15, // 24: vanus.core.segment.SegmentServer.ReadFromBlock:output_type -> vanus.core.segment.ReadFromBlockResponse
-	17, // 25: vanus.core.segment.SegmentServer.LookupOffsetInBlock:output_type -> vanus.core.segment.LookupOffsetInBlockResponse
-	18, // 26: vanus.core.segment.SegmentServer.Status:output_type -> vanus.core.segment.StatusResponse
-	16, // [16:27] is the sub-list for method output_type
-	5,  // [5:16] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	29, // 0: vanus.core.segment.StartSegmentServerRequest.config:type_name -> vanus.core.config.ServerConfig
+	30, // 1: vanus.core.segment.CreateBlockRequest.compress_algorithm:type_name -> vanus.core.meta.CompressAlgorithm
+	31, // 2: vanus.core.segment.DescribeBlockResponse.info:type_name -> vanus.core.meta.SegmentHealthInfo
+	25, // 3: vanus.core.segment.ActivateSegmentRequest.replicas:type_name -> vanus.core.segment.ActivateSegmentRequest.ReplicasEntry
+	0,  // 4: vanus.core.segment.ChangeMembershipRequest.type:type_name -> vanus.core.segment.MembershipChangeType
+	32, // 5: vanus.core.segment.AppendToBlockRequest.events:type_name -> vanus.core.cloudevents.CloudEventBatch
+	21, // 6: vanus.core.segment.ReadFromBlockRequest.filter:type_name -> vanus.core.segment.AttributeFilter
+	32, // 7: vanus.core.segment.ReadFromBlockResponse.events:type_name -> vanus.core.cloudevents.CloudEventBatch
+	26, // 8: vanus.core.segment.AttributeFilter.exact:type_name -> vanus.core.segment.AttributeFilter.ExactEntry
+	27, // 9: vanus.core.segment.AttributeFilter.prefix:type_name -> vanus.core.segment.AttributeFilter.PrefixEntry
+	28, // 10: vanus.core.segment.AttributeFilter.suffix:type_name -> vanus.core.segment.AttributeFilter.SuffixEntry
+	1,  // 11: vanus.core.segment.LookupOffsetInBlockRequest.index:type_name -> vanus.core.segment.BlockIndex
+	2,  // 12: vanus.core.segment.LookupOffsetInBlockRequest.flag:type_name -> vanus.core.segment.LookupKeyFlag
+	3,  // 13: vanus.core.segment.SegmentServer.Start:input_type -> vanus.core.segment.StartSegmentServerRequest
+	5,  // 14: vanus.core.segment.SegmentServer.Stop:input_type -> vanus.core.segment.StopSegmentServerRequest
+	7,  // 15: vanus.core.segment.SegmentServer.CreateBlock:input_type -> vanus.core.segment.CreateBlockRequest
+	8,  // 16: vanus.core.segment.SegmentServer.RemoveBlock:input_type -> vanus.core.segment.RemoveBlockRequest
+	9,  // 17: vanus.core.segment.SegmentServer.DescribeBlock:input_type -> vanus.core.segment.DescribeBlockRequest
+	11, // 18: vanus.core.segment.SegmentServer.ActivateSegment:input_type -> vanus.core.segment.ActivateSegmentRequest
+	13, // 19: vanus.core.segment.SegmentServer.InactivateSegment:input_type -> vanus.core.segment.InactivateSegmentRequest
+	15, // 20: vanus.core.segment.SegmentServer.ChangeMembership:input_type -> vanus.core.segment.ChangeMembershipRequest
+	16, // 21: vanus.core.segment.SegmentServer.TransferLeadership:input_type -> vanus.core.segment.TransferLeadershipRequest
+	17, // 22: vanus.core.segment.SegmentServer.AppendToBlock:input_type -> vanus.core.segment.AppendToBlockRequest
+	19, // 23: vanus.core.segment.SegmentServer.ReadFromBlock:input_type -> vanus.core.segment.ReadFromBlockRequest
+	22, // 24: vanus.core.segment.SegmentServer.LookupOffsetInBlock:input_type -> vanus.core.segment.LookupOffsetInBlockRequest
+	33, // 25: vanus.core.segment.SegmentServer.Status:input_type -> google.protobuf.Empty
+	4,  // 26: vanus.core.segment.SegmentServer.Start:output_type -> vanus.core.segment.StartSegmentServerResponse
+	6,  // 27: vanus.core.segment.SegmentServer.Stop:output_type -> vanus.core.segment.StopSegmentServerResponse
+	33, // 28: vanus.core.segment.SegmentServer.CreateBlock:output_type -> google.protobuf.Empty
+	33, // 29: vanus.core.segment.SegmentServer.RemoveBlock:output_type -> google.protobuf.Empty
+	10, // 30: vanus.core.segment.SegmentServer.DescribeBlock:output_type -> vanus.core.segment.DescribeBlockResponse
+	12, // 31: vanus.core.segment.SegmentServer.ActivateSegment:output_type -> vanus.core.segment.ActivateSegmentResponse
+	33, // 32: vanus.core.segment.SegmentServer.InactivateSegment:output_type -> google.protobuf.Empty
+	33, // 33: vanus.core.segment.SegmentServer.ChangeMembership:output_type -> google.protobuf.Empty
+	33, // 34: vanus.core.segment.SegmentServer.TransferLeadership:output_type -> google.protobuf.Empty
+	18, // 35: vanus.core.segment.SegmentServer.AppendToBlock:output_type -> vanus.core.segment.AppendToBlockResponse
+	20, // 36: vanus.core.segment.SegmentServer.ReadFromBlock:output_type -> vanus.core.segment.ReadFromBlockResponse
+	23, // 37: vanus.core.segment.SegmentServer.LookupOffsetInBlock:output_type -> vanus.core.segment.LookupOffsetInBlockResponse
+	24, // 38: vanus.core.segment.SegmentServer.Status:output_type -> vanus.core.segment.StatusResponse
+	26, // [26:39] is the sub-list for method output_type
+	13, // [13:26] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_vanus_core_segment_segment_proto_init() }
@@ -1349,7 +1861,7 @@ func file_vanus_core_segment_segment_proto_init() {
 			}
 		}
 		file_vanus_core_segment_segment_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppendToBlockRequest); i {
+			switch v := v.(*ChangeMembershipRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1361,7 +1873,7 @@ func file_vanus_core_segment_segment_proto_init() {
 			}
 		}
 		file_vanus_core_segment_segment_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppendToBlockResponse); i {
+			switch v := v.(*TransferLeadershipRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1373,7 +1885,7 @@ func file_vanus_core_segment_segment_proto_init() {
 			}
 		}
 		file_vanus_core_segment_segment_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadFromBlockRequest); i {
+			switch v := v.(*AppendToBlockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1385,7 +1897,7 @@ func file_vanus_core_segment_segment_proto_init() {
 			}
 		}
 		file_vanus_core_segment_segment_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadFromBlockResponse); i {
+			switch v := v.(*AppendToBlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1397,7 +1909,7 @@ func file_vanus_core_segment_segment_proto_init() {
 			}
 		}
 		file_vanus_core_segment_segment_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LookupOffsetInBlockRequest); i {
+			switch v := v.(*ReadFromBlockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1409,7 +1921,7 @@ func file_vanus_core_segment_segment_proto_init() {
 			}
 		}
 		file_vanus_core_segment_segment_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LookupOffsetInBlockResponse); i {
+			switch v := v.(*ReadFromBlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1421,6 +1933,42 @@ func file_vanus_core_segment_segment_proto_init() {
 			}
 		}
 		file_vanus_core_segment_segment_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttributeFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vanus_core_segment_segment_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupOffsetInBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vanus_core_segment_segment_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupOffsetInBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vanus_core_segment_segment_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StatusResponse); i {
 			case 0:
 				return &v.state
@@ -1438,13 +1986,14 @@ func file_vanus_core_segment_segment_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_vanus_core_segment_segment_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   20,
+			NumEnums:      3,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_vanus_core_segment_segment_proto_goTypes,
 		DependencyIndexes: file_vanus_core_segment_segment_proto_depIdxs,
+		EnumInfos:         file_vanus_core_segment_segment_proto_enumTypes,
 		MessageInfos:      file_vanus_core_segment_segment_proto_msgTypes,
 	}.Build()
 	File_vanus_core_segment_segment_proto = out.File
@@ -41,6 +41,8 @@ const (
 	SegmentServer_DescribeBlock_FullMethodName       = "/vanus.core.segment.SegmentServer/DescribeBlock"
 	SegmentServer_ActivateSegment_FullMethodName     = "/vanus.core.segment.SegmentServer/ActivateSegment"
 	SegmentServer_InactivateSegment_FullMethodName   = "/vanus.core.segment.SegmentServer/InactivateSegment"
+	SegmentServer_ChangeMembership_FullMethodName    = "/vanus.core.segment.SegmentServer/ChangeMembership"
+	SegmentServer_TransferLeadership_FullMethodName  = "/vanus.core.segment.SegmentServer/TransferLeadership"
 	SegmentServer_AppendToBlock_FullMethodName       = "/vanus.core.segment.SegmentServer/AppendToBlock"
 	SegmentServer_ReadFromBlock_FullMethodName       = "/vanus.core.segment.SegmentServer/ReadFromBlock"
 	SegmentServer_LookupOffsetInBlock_FullMethodName = "/vanus.core.segment.SegmentServer/LookupOffsetInBlock"
@@ -58,6 +60,12 @@ type SegmentServerClient interface {
 	DescribeBlock(ctx context.Context, in *DescribeBlockRequest, opts ...grpc.CallOption) (*DescribeBlockResponse, error)
 	ActivateSegment(ctx context.Context, in *ActivateSegmentRequest, opts ...grpc.CallOption) (*ActivateSegmentResponse, error)
 	InactivateSegment(ctx context.Context, in *InactivateSegmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ChangeMembership changes the membership of the replica group of a block, it must be sent to the
+	// server hosting the leader block.
+	ChangeMembership(ctx context.Context, in *ChangeMembershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// TransferLeadership transfers the leadership of the replica group of a block, it must be sent to
+	// the server hosting the leader block.
+	TransferLeadership(ctx context.Context, in *TransferLeadershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AppendToBlock(ctx context.Context, in *AppendToBlockRequest, opts ...grpc.CallOption) (*AppendToBlockResponse, error)
 	ReadFromBlock(ctx context.Context, in *ReadFromBlockRequest, opts ...grpc.CallOption) (*ReadFromBlockResponse, error)
 	LookupOffsetInBlock(ctx context.Context, in *LookupOffsetInBlockRequest, opts ...grpc.CallOption) (*LookupOffsetInBlockResponse, error)
@@ -135,6 +143,24 @@ func (c *segmentServerClient) InactivateSegment(ctx context.Context, in *Inactiv
 	return out, nil
 }
 
+func (c *segmentServerClient) ChangeMembership(ctx context.Context, in *ChangeMembershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SegmentServer_ChangeMembership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentServerClient) TransferLeadership(ctx context.Context, in *TransferLeadershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SegmentServer_TransferLeadership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *segmentServerClient) AppendToBlock(ctx context.Context, in *AppendToBlockRequest, opts ...grpc.CallOption) (*AppendToBlockResponse, error) {
 	out := new(AppendToBlockResponse)
 	err := c.cc.Invoke(ctx, SegmentServer_AppendToBlock_FullMethodName, in, out, opts...)
@@ -182,6 +208,12 @@ type SegmentServerServer interface {
 	DescribeBlock(context.Context, *DescribeBlockRequest) (*DescribeBlockResponse, error)
 	ActivateSegment(context.Context, *ActivateSegmentRequest) (*ActivateSegmentResponse, error)
 	InactivateSegment(context.Context, *InactivateSegmentRequest) (*emptypb.Empty, error)
+	// ChangeMembership changes the membership of the replica group of a block, it must be sent to the
+	// server hosting the leader block.
+	ChangeMembership(context.Context, *ChangeMembershipRequest) (*emptypb.Empty, error)
+	// TransferLeadership transfers the leadership of the replica group of a block, it must be sent to
+	// the server hosting the leader block.
+	TransferLeadership(context.Context, *TransferLeadershipRequest) (*emptypb.Empty, error)
 	AppendToBlock(context.Context, *AppendToBlockRequest) (*AppendToBlockResponse, error)
 	ReadFromBlock(context.Context, *ReadFromBlockRequest) (*ReadFromBlockResponse, error)
 	LookupOffsetInBlock(context.Context, *LookupOffsetInBlockRequest) (*LookupOffsetInBlockResponse, error)
@@ -213,6 +245,12 @@ func (UnimplementedSegmentServerServer) ActivateSegment(context.Context, *Activa
 func (UnimplementedSegmentServerServer) InactivateSegment(context.Context, *InactivateSegmentRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InactivateSegment not implemented")
 }
+func (UnimplementedSegmentServerServer) ChangeMembership(context.Context, *ChangeMembershipRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeMembership not implemented")
+}
+func (UnimplementedSegmentServerServer) TransferLeadership(context.Context, *TransferLeadershipRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLeadership not implemented")
+}
 func (UnimplementedSegmentServerServer) AppendToBlock(context.Context, *AppendToBlockRequest) (*AppendToBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AppendToBlock not implemented")
 }
@@ -363,6 +401,42 @@ func _SegmentServer_InactivateSegment_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SegmentServer_ChangeMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).ChangeMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SegmentServer_ChangeMembership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).ChangeMembership(ctx, req.(*ChangeMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentServer_TransferLeadership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferLeadershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).TransferLeadership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SegmentServer_TransferLeadership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).TransferLeadership(ctx, req.(*TransferLeadershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SegmentServer_AppendToBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AppendToBlockRequest)
 	if err := dec(in); err != nil {
@@ -470,6 +544,14 @@ var SegmentServer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InactivateSegment",
 			Handler:    _SegmentServer_InactivateSegment_Handler,
 		},
+		{
+			MethodName: "ChangeMembership",
+			Handler:    _SegmentServer_ChangeMembership_Handler,
+		},
+		{
+			MethodName: "TransferLeadership",
+			Handler:    _SegmentServer_TransferLeadership_Handler,
+		},
 		{
 			MethodName: "AppendToBlock",
 			Handler:    _SegmentServer_AppendToBlock_Handler,
@@ -75,6 +75,26 @@ func (mr *MockSegmentServerClientMockRecorder) AppendToBlock(ctx, in interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendToBlock", reflect.TypeOf((*MockSegmentServerClient)(nil).AppendToBlock), varargs...)
 }
 
+// ChangeMembership mocks base method.
+func (m *MockSegmentServerClient) ChangeMembership(ctx context.Context, in *ChangeMembershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangeMembership", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeMembership indicates an expected call of ChangeMembership.
+func (mr *MockSegmentServerClientMockRecorder) ChangeMembership(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeMembership", reflect.TypeOf((*MockSegmentServerClient)(nil).ChangeMembership), varargs...)
+}
+
 // CreateBlock mocks base method.
 func (m *MockSegmentServerClient) CreateBlock(ctx context.Context, in *CreateBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -255,6 +275,26 @@ func (mr *MockSegmentServerClientMockRecorder) Stop(ctx, in interface{}, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockSegmentServerClient)(nil).Stop), varargs...)
 }
 
+// TransferLeadership mocks base method.
+func (m *MockSegmentServerClient) TransferLeadership(ctx context.Context, in *TransferLeadershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TransferLeadership", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferLeadership indicates an expected call of TransferLeadership.
+func (mr *MockSegmentServerClientMockRecorder) TransferLeadership(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferLeadership", reflect.TypeOf((*MockSegmentServerClient)(nil).TransferLeadership), varargs...)
+}
+
 // MockSegmentServerServer is a mock of SegmentServerServer interface.
 type MockSegmentServerServer struct {
 	ctrl     *gomock.Controller
@@ -308,6 +348,21 @@ func (mr *MockSegmentServerServerMockRecorder) AppendToBlock(ctx, in interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendToBlock", reflect.TypeOf((*MockSegmentServerServer)(nil).AppendToBlock), ctx, in)
 }
 
+// ChangeMembership mocks base method.
+func (m *MockSegmentServerServer) ChangeMembership(ctx context.Context, in *ChangeMembershipRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeMembership", ctx, in)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeMembership indicates an expected call of ChangeMembership.
+func (mr *MockSegmentServerServerMockRecorder) ChangeMembership(ctx, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeMembership", reflect.TypeOf((*MockSegmentServerServer)(nil).ChangeMembership), ctx, in)
+}
+
 // CreateBlock mocks base method.
 func (m *MockSegmentServerServer) CreateBlock(ctx context.Context, in *CreateBlockRequest) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -442,3 +497,18 @@ func (mr *MockSegmentServerServerMockRecorder) Stop(ctx, in interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockSegmentServerServer)(nil).Stop), ctx, in)
 }
+
+// TransferLeadership mocks base method.
+func (m *MockSegmentServerServer) TransferLeadership(ctx context.Context, in *TransferLeadershipRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferLeadership", ctx, in)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferLeadership indicates an expected call of TransferLeadership.
+func (mr *MockSegmentServerServerMockRecorder) TransferLeadership(ctx, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferLeadership", reflect.TypeOf((*MockSegmentServerServer)(nil).TransferLeadership), ctx, in)
+}
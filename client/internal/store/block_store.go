@@ -65,9 +65,10 @@ func (s *BlockStore) Close() {
 	s.client.Close()
 }
 
+// Read returns the events from offset in block, and the offset next to the last scanned event.
 func (s *BlockStore) Read(
-	ctx context.Context, block uint64, offset int64, size int16, pollingTimeout uint32,
-) (*cloudevents.CloudEventBatch, error) {
+	ctx context.Context, block uint64, offset int64, size int16, pollingTimeout uint32, filter *segpb.AttributeFilter,
+) (*cloudevents.CloudEventBatch, int64, error) {
 	ctx, span := s.tracer.Start(ctx, "Read")
 	defer span.End()
 
@@ -76,18 +77,24 @@ func (s *BlockStore) Read(
 		Offset:         offset,
 		Number:         int64(size),
 		PollingTimeout: pollingTimeout,
+		Filter:         filter,
 	}
 
 	client, err := s.client.Get(ctx)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	resp, err := client.(segpb.SegmentServerClient).ReadFromBlock(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+	next := resp.GetNextOffset()
+	if next <= offset {
+		// the segment server doesn't support filter.
+		next = offset + int64(len(resp.GetEvents().GetEvents()))
 	}
-	return resp.GetEvents(), err
+	return resp.GetEvents(), next, nil
 }
 
 func (s *BlockStore) LookupOffset(ctx context.Context, blockID uint64, t time.Time) (int64, error) {
@@ -111,6 +118,29 @@ func (s *BlockStore) LookupOffset(ctx context.Context, blockID uint64, t time.Ti
 	return res.Offset, nil
 }
 
+// LookupKey returns the offset of the first event in block whose indexed attribute equals key, or -1 if not found.
+func (s *BlockStore) LookupKey(ctx context.Context, blockID uint64, index segpb.BlockIndex, key string) (int64, error) {
+	ctx, span := s.tracer.Start(ctx, "LookupKey")
+	defer span.End()
+
+	req := &segpb.LookupOffsetInBlockRequest{
+		BlockId: blockID,
+		Index:   index,
+		Key:     key,
+	}
+
+	client, err := s.client.Get(ctx)
+	if err != nil {
+		return -1, err
+	}
+
+	res, err := client.(segpb.SegmentServerClient).LookupOffsetInBlock(ctx, req)
+	if err != nil {
+		return -1, err
+	}
+	return res.Offset, nil
+}
+
 func (s *BlockStore) Append(ctx context.Context, block uint64, events *cloudevents.CloudEventBatch) ([]int64, error) {
 	_ctx, span := s.tracer.Start(ctx, "Append")
 	defer span.End()
@@ -24,6 +24,7 @@ import (
 
 	// first-party libraries.
 	cepb "github.com/vanus-labs/vanus/api/cloudevents"
+	segpb "github.com/vanus-labs/vanus/api/segment"
 )
 
 type Eventbus interface {
@@ -42,6 +43,9 @@ type BusWriter interface {
 
 type BusReader interface {
 	Read(ctx context.Context, opts ...ReadOption) (events *cepb.CloudEventBatch, off int64, logid uint64, err error)
+	// Scan reads events like Read, but returns the offset to read next instead of the offset read from.
+	// It is greater than the offset next to the last event if unmatched events are skipped by filter.
+	Scan(ctx context.Context, opts ...ReadOption) (events *cepb.CloudEventBatch, next int64, logid uint64, err error)
 }
 
 type Eventlog interface {
@@ -50,6 +54,8 @@ type Eventlog interface {
 	LatestOffset(ctx context.Context) (int64, error)
 	Length(ctx context.Context) (int64, error)
 	QueryOffsetByTime(ctx context.Context, timestamp int64) (int64, error)
+	// QueryOffsetByKey returns the offset of the first event whose indexed attribute equals key, or -1 if not found.
+	QueryOffsetByKey(ctx context.Context, index segpb.BlockIndex, key string) (int64, error)
 	CheckHealth(ctx context.Context) error
 }
 
@@ -96,3 +102,21 @@ func Read(ctx context.Context, r BusReader, opts ...ReadOption) (events []*ce.Ev
 	}
 	return es, off, logid, nil
 }
+
+func Scan(
+	ctx context.Context, r BusReader, opts ...ReadOption,
+) (events []*ce.Event, next int64, logid uint64, err error) {
+	batch, next, logid, err := r.Scan(ctx, opts...)
+	if err != nil {
+		return nil, next, logid, err
+	}
+	es := make([]*ce.Event, len(batch.Events))
+	for idx := range batch.Events {
+		e, err := cepb.FromProto(batch.Events[idx])
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		es[idx] = e
+	}
+	return es, next, logid, nil
+}
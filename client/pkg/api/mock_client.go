@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	cloudevents "github.com/vanus-labs/vanus/api/cloudevents"
+	segment "github.com/vanus-labs/vanus/api/segment"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -230,6 +231,28 @@ func (mr *MockBusReaderMockRecorder) Read(ctx any, opts ...any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockBusReader)(nil).Read), varargs...)
 }
 
+// Scan mocks base method.
+func (m *MockBusReader) Scan(ctx context.Context, opts ...ReadOption) (*cloudevents.CloudEventBatch, int64, uint64, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Scan", varargs...)
+	ret0, _ := ret[0].(*cloudevents.CloudEventBatch)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(uint64)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockBusReaderMockRecorder) Scan(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockBusReader)(nil).Scan), varargs...)
+}
+
 // MockEventlog is a mock of Eventlog interface.
 type MockEventlog struct {
 	ctrl     *gomock.Controller
@@ -326,6 +349,21 @@ func (mr *MockEventlogMockRecorder) Length(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Length", reflect.TypeOf((*MockEventlog)(nil).Length), ctx)
 }
 
+// QueryOffsetByKey mocks base method.
+func (m *MockEventlog) QueryOffsetByKey(ctx context.Context, index segment.BlockIndex, key string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryOffsetByKey", ctx, index, key)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryOffsetByKey indicates an expected call of QueryOffsetByKey.
+func (mr *MockEventlogMockRecorder) QueryOffsetByKey(ctx, index, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryOffsetByKey", reflect.TypeOf((*MockEventlog)(nil).QueryOffsetByKey), ctx, index, key)
+}
+
 // QueryOffsetByTime mocks base method.
 func (m *MockEventlog) QueryOffsetByTime(ctx context.Context, timestamp int64) (int64, error) {
 	m.ctrl.T.Helper()
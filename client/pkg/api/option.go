@@ -14,6 +14,11 @@
 
 package api
 
+import (
+	// first-party libraries.
+	segpb "github.com/vanus-labs/vanus/api/segment"
+)
+
 const (
 	DefaultPollingTimeout = 3000 // in milliseconds.
 )
@@ -67,6 +72,7 @@ type ReadOptions struct {
 	BatchSize      int
 	PollingTimeout int64
 	Policy         ReadPolicy
+	Filter         *segpb.AttributeFilter
 }
 
 func (ro *ReadOptions) Apply(opts ...ReadOption) {
@@ -80,6 +86,7 @@ func (ro *ReadOptions) Copy() *ReadOptions {
 		BatchSize:      ro.BatchSize,
 		PollingTimeout: ro.PollingTimeout,
 		Policy:         ro.Policy,
+		Filter:         ro.Filter,
 	}
 }
 
@@ -88,4 +95,3 @@ type LogOption func(*LogOptions)
 type LogOptions struct {
 	Policy LogPolicy
 }
-
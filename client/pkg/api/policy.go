@@ -21,11 +21,12 @@ import (
 type PolicyType string
 
 const (
-	RoundRobin = PolicyType("round_robin")
-	Manually   = PolicyType("manually")
-	Weight     = PolicyType("weight")
-	ReadOnly   = PolicyType("readonly")
-	ReadWrite  = PolicyType("readwrite")
+	RoundRobin   = PolicyType("round_robin")
+	Manually     = PolicyType("manually")
+	Weight       = PolicyType("weight")
+	PartitionKey = PolicyType("partition_key")
+	ReadOnly     = PolicyType("readonly")
+	ReadWrite    = PolicyType("readwrite")
 )
 
 type ConsumeFromWhere string
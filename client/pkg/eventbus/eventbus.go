@@ -532,6 +532,33 @@ func (r *busReader) Read(ctx context.Context, opts ...api.ReadOption) (events *c
 	_ctx, span := r.tracer.Start(ctx, "Read")
 	defer span.End()
 
+	events, off, lr, err := r.read(_ctx, opts...)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return events, off, lr.Log().ID(), nil
+}
+
+func (r *busReader) Scan(
+	ctx context.Context, opts ...api.ReadOption,
+) (events *cloudevents.CloudEventBatch, next int64, logid uint64, err error) {
+	_ctx, span := r.tracer.Start(ctx, "Scan")
+	defer span.End()
+
+	events, _, lr, err := r.read(_ctx, opts...)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	next, err = lr.Seek(_ctx, 0, io.SeekCurrent)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return events, next, lr.Log().ID(), nil
+}
+
+func (r *busReader) read(
+	ctx context.Context, opts ...api.ReadOption,
+) (*cloudevents.CloudEventBatch, int64, eventlog.LogReader, error) {
 	var readOpts *api.ReadOptions = r.opts
 	if len(opts) > 0 {
 		readOpts = r.opts.Copy()
@@ -541,29 +568,29 @@ func (r *busReader) Read(ctx context.Context, opts ...api.ReadOption) (events *c
 	}
 
 	// 1. pick a reader of eventlog
-	lr, err := r.pickReadableLog(_ctx, readOpts)
+	lr, err := r.pickReadableLog(ctx, readOpts)
 	if err != nil {
 		log.Error().Err(err).
 			Uint64("eventbus_id", r.ebus.ID()).
 			Msg("pick readable log failed")
-		return nil, 0, 0, err
+		return nil, 0, nil, err
 	}
 
 	// TODO(jiangkai): refactor eventlog interface to avoid seek every time, by jiangkai, 2022.10.24
-	off, err = lr.Seek(_ctx, readOpts.Policy.Offset(), io.SeekStart)
+	off, err := lr.Seek(ctx, readOpts.Policy.Offset(), io.SeekStart)
 	if err != nil {
 		log.Error().Err(err).
 			Uint64("eventbus_id", r.ebus.ID()).
 			Msg("seek offset failed")
-		return nil, 0, 0, err
+		return nil, 0, nil, err
 	}
 
 	// 2. read the event to the eventlog
-	events, err = lr.Read(_ctx, int16(readOpts.BatchSize))
+	events, err := lr.Read(ctx, int16(readOpts.BatchSize))
 	if err != nil {
-		return nil, 0, 0, err
+		return nil, 0, nil, err
 	}
-	return events, off, lr.Log().ID(), nil
+	return events, off, lr, nil
 }
 
 func (r *busReader) Bus() api.Eventbus {
@@ -583,5 +610,5 @@ func (r *busReader) pickReadableLog(ctx context.Context, opts *api.ReadOptions)
 		return nil, stderr.New("can not pick readable log")
 	}
 
-	return lr.Reader(eventlog.ReaderConfig{PollingTimeout: opts.PollingTimeout}), nil
+	return lr.Reader(eventlog.ReaderConfig{PollingTimeout: opts.PollingTimeout, Filter: opts.Filter}), nil
 }
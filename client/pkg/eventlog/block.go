@@ -23,6 +23,7 @@ import (
 	"github.com/vanus-labs/vanus/api/cloudevents"
 	"github.com/vanus-labs/vanus/api/errors"
 	metapb "github.com/vanus-labs/vanus/api/meta"
+	segpb "github.com/vanus-labs/vanus/api/segment"
 
 	// this project.
 	"github.com/vanus-labs/vanus/client/internal/store"
@@ -54,24 +55,30 @@ func (b *block) LookupOffset(ctx context.Context, t time.Time) (int64, error) {
 	return b.store.LookupOffset(ctx, b.id, t)
 }
 
+func (b *block) LookupKey(ctx context.Context, index segpb.BlockIndex, key string) (int64, error) {
+	return b.store.LookupKey(ctx, b.id, index, key)
+}
+
 func (b *block) Append(ctx context.Context, event *cloudevents.CloudEventBatch) ([]int64, error) {
 	return b.store.Append(ctx, b.id, event)
 }
 
-func (b *block) Read(ctx context.Context, offset int64, size int16, pollingTimeout uint32) (*cloudevents.CloudEventBatch, error) {
+func (b *block) Read(
+	ctx context.Context, offset int64, size int16, pollingTimeout uint32, filter *segpb.AttributeFilter,
+) (*cloudevents.CloudEventBatch, int64, error) {
 	if offset < 0 {
-		return nil, errors.ErrOffsetUnderflow
+		return nil, 0, errors.ErrOffsetUnderflow
 	}
 	if size > 0 {
 		// doRead
 	} else if size == 0 {
 		return &cloudevents.CloudEventBatch{
 			Events: []*cloudevents.CloudEvent{},
-		}, nil
+		}, offset, nil
 	} else if size < 0 {
-		return nil, errors.ErrInvalidArgument
+		return nil, 0, errors.ErrInvalidArgument
 	}
-	return b.store.Read(ctx, b.id, offset, size, pollingTimeout)
+	return b.store.Read(ctx, b.id, offset, size, pollingTimeout, filter)
 }
 
 func (b *block) Describe(ctx context.Context) (*metapb.SegmentHealthInfo, error) {
@@ -33,6 +33,8 @@ const (
 
 type ReaderConfig struct {
 	PollingTimeout int64
+	// Filter is evaluated by segment servers, only matching events are read if it is set.
+	Filter *segpb.AttributeFilter
 }
 
 type Eventlog interface {
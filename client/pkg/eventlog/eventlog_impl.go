@@ -24,10 +24,13 @@ import (
 
 	// third-party libraries.
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	// first-party libraries.
 	"github.com/vanus-labs/vanus/api/cloudevents"
 	"github.com/vanus-labs/vanus/api/errors"
+	segpb "github.com/vanus-labs/vanus/api/segment"
 	"github.com/vanus-labs/vanus/pkg/observability/log"
 	"github.com/vanus-labs/vanus/pkg/observability/tracing"
 
@@ -213,6 +216,44 @@ func (l *eventlog) QueryOffsetByTime(ctx context.Context, timestamp int64) (int6
 	return target.StartOffset() + offset, nil
 }
 
+func (l *eventlog) QueryOffsetByKey(ctx context.Context, index segpb.BlockIndex, key string) (int64, error) {
+	// The target event may be in newer segment, refresh immediately.
+	l.refreshReadableSegments(ctx)
+	segs := l.fetchReadableSegments(ctx)
+
+	// A segment is skipped if its server hasn't enabled the index or is too old to have it,
+	// so the lookup only fails for that if all segments are skipped.
+	var lastErr error
+	skipped := 0
+	for _, s := range segs {
+		offset, err := s.LookupKey(ctx, index, key)
+		if err != nil {
+			if !isIndexUnsupported(err) {
+				return -1, err
+			}
+			log.Debug().Err(err).
+				Uint64("segment_id", s.id).
+				Msg("the index isn't supported by segment, skip it")
+			lastErr = errors.ErrBlockNotSupported.WithMessage("the index isn't supported").Wrap(err)
+			skipped++
+			continue
+		}
+		if offset >= 0 {
+			return s.StartOffset() + offset, nil
+		}
+	}
+	if skipped > 0 && skipped == len(segs) {
+		return -1, lastErr
+	}
+	return -1, nil
+}
+
+// isIndexUnsupported returns true if the segment server hasn't enabled the index,
+// or is too old to lookup keys.
+func isIndexUnsupported(err error) bool {
+	return errors.Is(err, errors.ErrBlockNotSupported) || status.Code(err) == codes.Unimplemented
+}
+
 func (l *eventlog) CheckHealth(ctx context.Context) error {
 	seg, err := l.selectWritableSegment(ctx)
 	if err != nil {
@@ -450,7 +491,7 @@ func (r *logReader) Read(ctx context.Context, size int16) (*cloudevents.CloudEve
 		r.cur = segment
 	}
 
-	events, err := r.cur.Read(ctx, r.pos, size, uint32(r.pollingTimeout(ctx)))
+	events, next, err := r.cur.Read(ctx, r.pos, size, uint32(r.pollingTimeout(ctx)), r.cfg.Filter)
 	if err != nil {
 		if errors.Is(err, errors.ErrOffsetOverflow) {
 			r.elog.refreshReadableSegments(ctx)
@@ -461,7 +502,7 @@ func (r *logReader) Read(ctx context.Context, size int16) (*cloudevents.CloudEve
 		return nil, err
 	}
 
-	r.pos += int64(len(events.Events))
+	r.pos = next
 	if r.pos == r.cur.EndOffset() {
 		r.switchSegment(ctx)
 	}
@@ -497,10 +538,17 @@ func (r *logReader) switchSegment(ctx context.Context) bool {
 
 func (r *logReader) Seek(ctx context.Context, offset int64, whence int) (int64, error) {
 	// TODO
-	if whence == io.SeekStart {
+	switch whence {
+	case io.SeekStart:
 		r.pos = offset
 		r.cur = nil
 		return offset, nil
+	case io.SeekCurrent:
+		if offset != 0 {
+			r.pos += offset
+			r.cur = nil
+		}
+		return r.pos, nil
 	}
 	return -1, errors.ErrInvalidArgument
 }
@@ -172,16 +172,19 @@ func (s *segment) Append(ctx context.Context, event *cloudevents.CloudEventBatch
 	return offs, nil
 }
 
-func (s *segment) Read(ctx context.Context, from int64, size int16, pollingTimeout uint32) (*cloudevents.CloudEventBatch, error) {
+// Read returns the events from offset from, and the offset next to the last scanned event.
+func (s *segment) Read(
+	ctx context.Context, from int64, size int16, pollingTimeout uint32, filter *segpb.AttributeFilter,
+) (*cloudevents.CloudEventBatch, int64, error) {
 	if from < s.startOffset {
-		return nil, errors.ErrOffsetUnderflow
+		return nil, 0, errors.ErrOffsetUnderflow
 	}
 	ctx, span := s.tracer.Start(ctx, "Read")
 	defer span.End()
 
 	if eo := s.endOffset.Load(); eo >= 0 {
 		if from > eo {
-			return nil, errors.ErrOffsetOverflow
+			return nil, 0, errors.ErrOffsetOverflow
 		}
 		if int64(size) > eo-from {
 			size = int16(eo - from)
@@ -190,12 +193,13 @@ func (s *segment) Read(ctx context.Context, from int64, size int16, pollingTimeo
 	// TODO: cached read
 	b := s.preferSegmentBlock()
 	if b == nil {
-		return nil, errors.ErrBlockNotFound
+		return nil, 0, errors.ErrBlockNotFound
 	}
-	events, err := b.Read(ctx, from-s.startOffset, size, pollingTimeout)
+	events, next, err := b.Read(ctx, from-s.startOffset, size, pollingTimeout, filter)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
+	next += s.startOffset
 
 	for _, e := range events.Events {
 		v, ok := e.Attributes[segpb.XVanusBlockOffset]
@@ -205,7 +209,7 @@ func (s *segment) Read(ctx context.Context, from int64, size int16, pollingTimeo
 
 		_, ok = v.GetAttr().(*cloudevents.CloudEvent_CloudEventAttributeValue_CeInteger)
 		if !ok {
-			return events, errors.ErrCorruptedEvent
+			return events, next, errors.ErrCorruptedEvent
 		}
 		offset := s.startOffset + int64(v.GetCeInteger())
 		buf := make([]byte, 8)
@@ -216,7 +220,7 @@ func (s *segment) Read(ctx context.Context, from int64, size int16, pollingTimeo
 		delete(e.Attributes, segpb.XVanusBlockOffset)
 	}
 
-	return events, err
+	return events, next, err
 }
 
 func (s *segment) preferSegmentBlock() *block {
@@ -235,6 +239,10 @@ func (s *segment) LookupOffset(ctx context.Context, t time.Time) (int64, error)
 	return s.preferSegmentBlock().LookupOffset(ctx, t)
 }
 
+func (s *segment) LookupKey(ctx context.Context, index segpb.BlockIndex, key string) (int64, error) {
+	return s.preferSegmentBlock().LookupKey(ctx, index, key)
+}
+
 func (s *segment) CheckHealth(ctx context.Context) error {
 	b := s.preferSegmentBlock()
 	if b == nil {
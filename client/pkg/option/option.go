@@ -17,6 +17,8 @@ package option
 import (
 	"time"
 
+	segpb "github.com/vanus-labs/vanus/api/segment"
+
 	"github.com/vanus-labs/vanus/client/pkg/api"
 )
 
@@ -60,6 +62,14 @@ func WithReadPolicy(policy api.ReadPolicy) api.ReadOption {
 	}
 }
 
+// WithReadFilter makes segment servers skip the events not matching filter, use BusReader.Scan to
+// get the offset to read next time.
+func WithReadFilter(filter *segpb.AttributeFilter) api.ReadOption {
+	return func(options *api.ReadOptions) {
+		options.Filter = filter
+	}
+}
+
 func WithLogPolicy(policy api.LogPolicy) api.LogOption {
 	return func(options *api.LogOptions) {
 		options.Policy = policy
@@ -16,8 +16,13 @@ package policy
 
 import (
 	"context"
+	"hash/fnv"
 	"sort"
+	"strconv"
 	"sync/atomic"
+	"time"
+
+	"github.com/vanus-labs/vanus/api/cloudevents"
 
 	"github.com/vanus-labs/vanus/client/pkg/api"
 )
@@ -60,6 +65,94 @@ func (w *roundRobinWritePolicy) NextLog(ctx context.Context) (api.Eventlog, erro
 	}
 }
 
+var _ api.WritePolicy = (*partitionKeyWritePolicy)(nil)
+
+// NewPartitionKeyWritePolicy returns a policy which always picks the same eventlog for the same key,
+// so the relative order of events with the same key is kept. If the key is empty, it falls back to
+// round-robin.
+func NewPartitionKeyWritePolicy(eb api.Eventbus, key string) api.WritePolicy {
+	return &partitionKeyWritePolicy{
+		bus:      eb,
+		key:      key,
+		fallback: NewRoundRobinWritePolicy(eb),
+	}
+}
+
+type partitionKeyWritePolicy struct {
+	bus      api.Eventbus
+	key      string
+	fallback api.WritePolicy
+}
+
+func (w *partitionKeyWritePolicy) Type() api.PolicyType {
+	return api.PartitionKey
+}
+
+func (w *partitionKeyWritePolicy) NextLog(ctx context.Context) (api.Eventlog, error) {
+	if w.key == "" {
+		return w.fallback.NextLog(ctx)
+	}
+	for {
+		logs, err := w.bus.ListLog(ctx)
+		if err != nil {
+			return nil, err
+		}
+		l := len(logs)
+		switch l {
+		case 0:
+			continue
+		case 1:
+		default:
+			// the order of eventlogs must be stable, so that a key is always mapped to the same eventlog.
+			sort.Slice(logs, func(i, j int) bool {
+				return logs[i].ID() < logs[j].ID()
+			})
+		}
+		return logs[hashKey(w.key)%uint64(l)], nil
+	}
+}
+
+func hashKey(key string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	return h.Sum64()
+}
+
+// PartitionKey returns the value of attribute or extension attr of event e as a partition key, an empty
+// string is returned if the event doesn't have it.
+func PartitionKey(e *cloudevents.CloudEvent, attr string) string {
+	switch attr {
+	case "":
+		return ""
+	case "id":
+		return e.GetId()
+	case "source":
+		return e.GetSource()
+	case "specversion":
+		return e.GetSpecVersion()
+	case "type":
+		return e.GetType()
+	}
+	switch val := e.GetAttributes()[attr].GetAttr().(type) {
+	case *cloudevents.CloudEvent_CloudEventAttributeValue_CeString:
+		return val.CeString
+	case *cloudevents.CloudEvent_CloudEventAttributeValue_CeUri:
+		return val.CeUri
+	case *cloudevents.CloudEvent_CloudEventAttributeValue_CeUriRef:
+		return val.CeUriRef
+	case *cloudevents.CloudEvent_CloudEventAttributeValue_CeInteger:
+		return strconv.FormatInt(int64(val.CeInteger), 10)
+	case *cloudevents.CloudEvent_CloudEventAttributeValue_CeBoolean:
+		return strconv.FormatBool(val.CeBoolean)
+	case *cloudevents.CloudEvent_CloudEventAttributeValue_CeBytes:
+		return string(val.CeBytes)
+	case *cloudevents.CloudEvent_CloudEventAttributeValue_CeTimestamp:
+		return val.CeTimestamp.AsTime().Format(time.RFC3339Nano)
+	default:
+		return ""
+	}
+}
+
 var _ api.ReadPolicy = (*roundRobinReadPolicy)(nil)
 
 func NewRoundRobinReadPolicy(eb api.Eventbus, fromWhere api.ConsumeFromWhere) *roundRobinReadPolicy {
@@ -0,0 +1,113 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	// standard libraries.
+	"context"
+	"testing"
+
+	// third-party libraries.
+	"go.uber.org/mock/gomock"
+
+	// first-party libraries.
+	"github.com/vanus-labs/vanus/api/cloudevents"
+
+	// this project.
+	"github.com/vanus-labs/vanus/client/pkg/api"
+)
+
+func mockLogs(ctrl *gomock.Controller, ids ...uint64) []api.Eventlog {
+	logs := make([]api.Eventlog, len(ids))
+	for i, id := range ids {
+		l := api.NewMockEventlog(ctrl)
+		l.EXPECT().ID().AnyTimes().Return(id)
+		logs[i] = l
+	}
+	return logs
+}
+
+func TestPartitionKeyWritePolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	bus := api.NewMockEventbus(ctrl)
+	bus.EXPECT().ListLog(gomock.Any()).AnyTimes().DoAndReturn(
+		func(_ context.Context, _ ...api.LogOption) ([]api.Eventlog, error) {
+			// the order of ListLog is random.
+			return mockLogs(ctrl, 3, 1, 2), nil
+		})
+
+	p := NewPartitionKeyWritePolicy(bus, "order-1")
+	if p.Type() != api.PartitionKey {
+		t.Errorf("p.Type() != %s", api.PartitionKey)
+	}
+	first, err := p.NextLog(ctx)
+	if err != nil {
+		t.Fatalf("p.NextLog() failed: %s", err)
+	}
+	for i := 0; i < 10; i++ {
+		l, err := NewPartitionKeyWritePolicy(bus, "order-1").NextLog(ctx)
+		if err != nil {
+			t.Fatalf("p.NextLog() failed: %s", err)
+		}
+		if l.ID() != first.ID() {
+			t.Errorf("same key is mapped to different eventlogs: %d, %d", first.ID(), l.ID())
+		}
+	}
+
+	picked := make(map[uint64]struct{})
+	for _, key := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"} {
+		l, err := NewPartitionKeyWritePolicy(bus, key).NextLog(ctx)
+		if err != nil {
+			t.Fatalf("p.NextLog() failed: %s", err)
+		}
+		picked[l.ID()] = struct{}{}
+	}
+	if len(picked) < 2 {
+		t.Errorf("keys are not distributed across eventlogs")
+	}
+}
+
+func TestPartitionKey(t *testing.T) {
+	e := &cloudevents.CloudEvent{
+		Id:     "id-1",
+		Source: "source-1",
+		Type:   "type-1",
+		Attributes: map[string]*cloudevents.CloudEvent_CloudEventAttributeValue{
+			"partitionkey": {
+				Attr: &cloudevents.CloudEvent_CloudEventAttributeValue_CeString{CeString: "device-1"},
+			},
+			"tenant": {
+				Attr: &cloudevents.CloudEvent_CloudEventAttributeValue_CeInteger{CeInteger: 42},
+			},
+		},
+	}
+	cases := map[string]string{
+		"":             "",
+		"id":           "id-1",
+		"source":       "source-1",
+		"type":         "type-1",
+		"partitionkey": "device-1",
+		"tenant":       "42",
+		"missing":      "",
+	}
+	for attr, want := range cases {
+		if got := PartitionKey(e, attr); got != want {
+			t.Errorf("PartitionKey(%q) = %q, want %q", attr, got, want)
+		}
+	}
+}
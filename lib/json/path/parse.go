@@ -32,10 +32,16 @@ func Parse(text string) (Path, error) {
 	if bytes.ExpectChar(s, '$') != nil { // root identifier
 		return nil, errInvalidJSONPath
 	}
+	if len(text) == 1 { // root identifier only
+		return &rootPath{}, nil
+	}
 	segments, err := ConsumeSegments(s)
 	if err != nil {
 		return nil, errInvalidJSONPath
 	}
+	if _, err = s.ReadByte(); err == nil { // trailing characters
+		return nil, errInvalidJSONPath
+	}
 	return &rootPath{segments: segments}, nil
 }
 
@@ -14,8 +14,11 @@
 
 package path
 
+// Path is a parsed JSONPath query (RFC 9535).
 type Path interface {
-	Apply()
+	// Get returns the nodes selected by the query from the decoded JSON value root, in which objects
+	// are represented as map[string]interface{} and arrays as []interface{}.
+	Get(root interface{}) []interface{}
 }
 
 type rootPath struct {
@@ -25,4 +28,13 @@ type rootPath struct {
 // Make sure rootPath implements Path.
 var _ Path = (*rootPath)(nil)
 
-func (p *rootPath) Apply() {}
+func (p *rootPath) Get(root interface{}) []interface{} {
+	nodes := []interface{}{root}
+	for _, segment := range p.segments {
+		if len(nodes) == 0 {
+			break
+		}
+		nodes = segment.Apply(nodes)
+	}
+	return nodes
+}
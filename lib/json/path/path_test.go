@@ -0,0 +1,74 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package path
+
+import (
+	// standard libraries.
+	"encoding/json"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPath_Get(t *testing.T) {
+	var root interface{}
+	_ = json.Unmarshal([]byte(`{
+		"name": "vanus",
+		"count": 3,
+		"tags": ["a", "b", "c", "d"],
+		"items": [{"id": 1}, {"id": 2}],
+		"nested": {"flag": true, "empty": null}
+	}`), &root)
+
+	cases := []struct {
+		path   string
+		expect []interface{}
+	}{
+		{"$", []interface{}{root}},
+		{"$.name", []interface{}{"vanus"}},
+		{"$['count']", []interface{}{float64(3)}},
+		{"$.nested.flag", []interface{}{true}},
+		{"$.nested.empty", []interface{}{nil}},
+		{"$.tags[0]", []interface{}{"a"}},
+		{"$.tags[-1]", []interface{}{"d"}},
+		{"$.tags[1:3]", []interface{}{"b", "c"}},
+		{"$.tags[::2]", []interface{}{"a", "c"}},
+		{"$.tags[::-1]", []interface{}{"d", "c", "b", "a"}},
+		{"$.tags[0, 2]", []interface{}{"a", "c"}},
+		{"$.tags.*", []interface{}{"a", "b", "c", "d"}},
+		{"$.items[*].id", []interface{}{float64(1), float64(2)}},
+		{"$.missing", nil},
+		{"$.name.length", nil},
+		{"$.tags[4]", nil},
+		{"$.tags[::0]", nil},
+	}
+
+	for i := range cases {
+		c := &cases[i]
+		Convey("get json path: "+c.path, t, func() {
+			p, err := Parse(c.path)
+			So(err, ShouldBeNil)
+			So(p.Get(root), ShouldResemble, c.expect)
+		})
+	}
+
+	Convey("parse invalid json path", t, func() {
+		for _, text := range []string{"", "name", "$.", "$.name]", "$[0"} {
+			_, err := Parse(text)
+			So(err, ShouldNotBeNil)
+		}
+	})
+}
@@ -14,8 +14,9 @@
 
 package path
 
+// Segment selects children from each node of its input nodelist.
 type Segment interface {
-	Apply()
+	Apply(nodes []interface{}) []interface{}
 }
 
 type bracketedSelection struct {
@@ -25,4 +26,21 @@ type bracketedSelection struct {
 // Make sure bracketedSelection implements Segment.
 var _ Segment = (*bracketedSelection)(nil)
 
-func (bs *bracketedSelection) Apply() {}
+func (bs *bracketedSelection) Apply(nodes []interface{}) []interface{} {
+	var out []interface{}
+	for _, node := range nodes {
+		for _, selector := range bs.selectors {
+			out = selector.Select(node, out)
+		}
+	}
+	return out
+}
+
+// applySelector applies a selector which is used as a shorthand segment, e.g. `.name` or `.*`.
+func applySelector(selector Selector, nodes []interface{}) []interface{} {
+	var out []interface{}
+	for _, node := range nodes {
+		out = selector.Select(node, out)
+	}
+	return out
+}
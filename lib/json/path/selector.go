@@ -14,25 +14,58 @@
 
 package path
 
+// Selector produces a nodelist from a single node.
 type Selector interface {
-	Apply()
+	// Select appends the nodes selected from node to out, and returns the extended slice.
+	Select(node interface{}, out []interface{}) []interface{}
 }
 
 type nameSelector struct {
 	member string
 }
 
-// Make sure nameSelector implements Selector.
-var _ Selector = (*nameSelector)(nil)
+// Make sure nameSelector implements Selector and Segment.
+var (
+	_ Selector = (*nameSelector)(nil)
+	_ Segment  = (*nameSelector)(nil)
+)
 
-func (ns *nameSelector) Apply() {}
+func (ns *nameSelector) Select(node interface{}, out []interface{}) []interface{} {
+	if obj, ok := node.(map[string]interface{}); ok {
+		if v, ok := obj[ns.member]; ok {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func (ns *nameSelector) Apply(nodes []interface{}) []interface{} {
+	return applySelector(ns, nodes)
+}
 
 type wildcardSelector struct{}
 
-// Make sure wildcardSelector implements Selector.
-var _ Selector = (*wildcardSelector)(nil)
+// Make sure wildcardSelector implements Selector and Segment.
+var (
+	_ Selector = (*wildcardSelector)(nil)
+	_ Segment  = (*wildcardSelector)(nil)
+)
 
-func (ws *wildcardSelector) Apply() {}
+func (ws *wildcardSelector) Select(node interface{}, out []interface{}) []interface{} {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for _, v := range n {
+			out = append(out, v)
+		}
+	case []interface{}:
+		out = append(out, n...)
+	}
+	return out
+}
+
+func (ws *wildcardSelector) Apply(nodes []interface{}) []interface{} {
+	return applySelector(ws, nodes)
+}
 
 type indexSelector struct {
 	index int
@@ -41,7 +74,17 @@ type indexSelector struct {
 // Make sure indexSelector implements Selector.
 var _ Selector = (*indexSelector)(nil)
 
-func (is *indexSelector) Apply() {}
+func (is *indexSelector) Select(node interface{}, out []interface{}) []interface{} {
+	arr, ok := node.([]interface{})
+	if !ok {
+		return out
+	}
+	i := normalizeIndex(is.index, len(arr))
+	if i < 0 || i >= len(arr) {
+		return out
+	}
+	return append(out, arr[i])
+}
 
 type arraySliceSelector struct {
 	start *int
@@ -52,4 +95,53 @@ type arraySliceSelector struct {
 // Make sure arraySliceSelector implements Selector.
 var _ Selector = (*arraySliceSelector)(nil)
 
-func (ass *arraySliceSelector) Apply() {}
+func (ass *arraySliceSelector) Select(node interface{}, out []interface{}) []interface{} {
+	arr, ok := node.([]interface{})
+	if !ok || ass.step == 0 {
+		return out
+	}
+
+	length := len(arr)
+	if ass.step > 0 {
+		lower, upper := 0, length
+		if ass.start != nil {
+			lower = clamp(normalizeIndex(*ass.start, length), 0, length)
+		}
+		if ass.end != nil {
+			upper = clamp(normalizeIndex(*ass.end, length), 0, length)
+		}
+		for i := lower; i < upper; i += ass.step {
+			out = append(out, arr[i])
+		}
+		return out
+	}
+
+	upper, lower := length-1, -1
+	if ass.start != nil {
+		upper = clamp(normalizeIndex(*ass.start, length), -1, length-1)
+	}
+	if ass.end != nil {
+		lower = clamp(normalizeIndex(*ass.end, length), -1, length-1)
+	}
+	for i := upper; i > lower; i += ass.step {
+		out = append(out, arr[i])
+	}
+	return out
+}
+
+func normalizeIndex(i, length int) int {
+	if i >= 0 {
+		return i
+	}
+	return length + i
+}
+
+func clamp(i, lower, upper int) int {
+	if i < lower {
+		return lower
+	}
+	if i > upper {
+		return upper
+	}
+	return i
+}
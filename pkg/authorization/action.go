@@ -17,6 +17,9 @@ package authorization
 type Action string
 
 const (
+	ClusterRebalance    Action = "cluster:rebalance"
+	ClusterDecommission Action = "cluster:decommission"
+
 	UserCreate  Action = "user:create"
 	UserDelete  Action = "user:delete"
 	UserGet     Action = "user:get"
@@ -51,6 +54,8 @@ const (
 	SubscriptionDelete Action = "subscription:delete"
 	SubscriptionGrant  Action = "subscription:grant"
 	SubscriptionRevoke Action = "subscription:revoke"
+	// SubscriptionRedirect allows to resend the dead letter events of the subscription to any sink.
+	SubscriptionRedirect Action = "subscription:redirect"
 )
 
 type List []Action
@@ -100,3 +100,12 @@ func TestAuthorization_Authorize(t *testing.T) {
 		})
 	})
 }
+
+func TestRole_SubscriptionRedirect(t *testing.T) {
+	Convey("only admins can redirect dead letter events", t, func() {
+		So(hasAction(ResourceNamespace, RoleAdmin, SubscriptionRedirect), ShouldBeTrue)
+		So(hasAction(ResourceSubscription, RoleAdmin, SubscriptionRedirect), ShouldBeTrue)
+		So(hasAction(ResourceNamespace, RoleEdit, SubscriptionRedirect), ShouldBeFalse)
+		So(hasAction(ResourceNamespace, RoleView, SubscriptionRedirect), ShouldBeFalse)
+	})
+}
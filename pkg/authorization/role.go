@@ -102,6 +102,7 @@ func init() { //nolint:gochecknoinits // ok
 	addActionForRole(SubscriptionDelete, namespaceAdmin, namespaceEdit)
 	addActionForRole(SubscriptionGrant, namespaceAdmin, namespaceEdit, subscriptionAdmin)
 	addActionForRole(SubscriptionRevoke, namespaceAdmin, namespaceEdit, subscriptionAdmin)
+	addActionForRole(SubscriptionRedirect, namespaceAdmin, subscriptionAdmin)
 	addActionForRole(SubscriptionUpdate, namespaceAdmin, namespaceEdit, subscriptionAdmin, subscriptionEdit)
 	addActionForRole(SubscriptionGet, namespaceAdmin, namespaceEdit, namespaceView, subscriptionAdmin, subscriptionEdit, subscriptionView) //nolint:lll // ok
 }
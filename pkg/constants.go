@@ -28,8 +28,10 @@ const (
 	LastDeliveryTime  = XVanus + "lastdltime"
 	LastDeliveryError = XVanus + "lastdlerror"
 	DeadLetterReason  = XVanus + "dlreason"
+	DeadLetterCode    = XVanus + "dlcode"
 
 	MaxRetryAttempts = 32
+	MaxShards        = 64
 
 	DefaultNamespace = "default"
 	SystemNamespace  = "vanus-system"
@@ -17,6 +17,7 @@ package convert
 import (
 	// standard libraries.
 	"errors"
+	"strings"
 
 	// third-party libraries.
 	"google.golang.org/protobuf/types/known/structpb"
@@ -43,10 +44,10 @@ func FromPbSubscriptionRequest(sub *ctrl.SubscriptionRequest) (*metadata.Subscri
 		Types:              sub.Types,
 		Config:             fromPbSubscriptionConfig(sub.Config),
 		Sink:               primitive.URI(sub.Sink),
-		SinkCredential:     fromPbSinkCredential(sub.SinkCredential),
+		SinkCredential:     FromPbSinkCredential(sub.SinkCredential),
 		SinkCredentialType: fromPbSinkCredentialType(sub.SinkCredential),
-		Protocol:           fromPbProtocol(sub.Protocol),
-		ProtocolSetting:    fromPbProtocolSettings(sub.ProtocolSettings),
+		Protocol:           FromPbProtocol(sub.Protocol),
+		ProtocolSetting:    FromPbProtocolSettings(sub.ProtocolSettings),
 		Filters:            fromPbFilters(sub.Filters),
 		Transformer:        t,
 		EventbusID:         vanus.NewIDFromUint64(sub.EventbusId),
@@ -57,37 +58,21 @@ func FromPbSubscriptionRequest(sub *ctrl.SubscriptionRequest) (*metadata.Subscri
 	return to, nil
 }
 
-func fromPbProtocol(from pb.Protocol) primitive.Protocol {
-	var to primitive.Protocol
-	switch from {
-	case pb.Protocol_HTTP:
-		to = primitive.HTTPProtocol
-	case pb.Protocol_AWS_LAMBDA:
-		to = primitive.AwsLambdaProtocol
-	case pb.Protocol_GCLOUD_FUNCTIONS:
-		to = primitive.GCloudFunctions
-	case pb.Protocol_GRPC:
-		to = primitive.GRPC
+// FromPbProtocol converts the protocol by the name, for example, AWS_LAMBDA is aws-lambda.
+func FromPbProtocol(from pb.Protocol) primitive.Protocol {
+	name, exist := pb.Protocol_name[int32(from)]
+	if !exist {
+		return ""
 	}
-	return to
+	return primitive.Protocol(strings.ReplaceAll(strings.ToLower(name), "_", "-"))
 }
 
-func toPbProtocol(from primitive.Protocol) pb.Protocol {
-	var to pb.Protocol
-	switch from {
-	case primitive.HTTPProtocol:
-		to = pb.Protocol_HTTP
-	case primitive.AwsLambdaProtocol:
-		to = pb.Protocol_AWS_LAMBDA
-	case primitive.GCloudFunctions:
-		to = pb.Protocol_GCLOUD_FUNCTIONS
-	case primitive.GRPC:
-		to = pb.Protocol_GRPC
-	}
-	return to
+// ToPbProtocol is the reverse of FromPbProtocol, the unknown protocol is converted to HTTP.
+func ToPbProtocol(from primitive.Protocol) pb.Protocol {
+	return pb.Protocol(pb.Protocol_value[strings.ToUpper(strings.ReplaceAll(string(from), "-", "_"))])
 }
 
-func fromPbProtocolSettings(from *pb.ProtocolSetting) *primitive.ProtocolSetting {
+func FromPbProtocolSettings(from *pb.ProtocolSetting) *primitive.ProtocolSetting {
 	if from == nil {
 		return nil
 	}
@@ -121,11 +106,15 @@ func fromPbSinkCredentialType(from *pb.SinkCredential) *primitive.CredentialType
 		to = primitive.GCloud
 	case pb.SinkCredential_PLAIN:
 		to = primitive.Plain
+	case pb.SinkCredential_HMAC:
+		to = primitive.HMAC
+	case pb.SinkCredential_OAUTH2:
+		to = primitive.OAuth2
 	}
 	return &to
 }
 
-func fromPbSinkCredential(from *pb.SinkCredential) primitive.SinkCredential {
+func FromPbSinkCredential(from *pb.SinkCredential) primitive.SinkCredential {
 	if from == nil {
 		return nil
 	}
@@ -141,6 +130,12 @@ func fromPbSinkCredential(from *pb.SinkCredential) primitive.SinkCredential {
 	case pb.SinkCredential_PLAIN:
 		plain := from.GetPlain()
 		return primitive.NewPlainSinkCredential(plain.GetIdentifier(), plain.GetSecret())
+	case pb.SinkCredential_HMAC:
+		return primitive.NewHMACSinkCredential(from.GetHmac().GetSecret())
+	case pb.SinkCredential_OAUTH2:
+		oauth2 := from.GetOauth2()
+		return primitive.NewOAuth2SinkCredential(oauth2.GetTokenUrl(), oauth2.GetClientId(),
+			oauth2.GetClientSecret(), oauth2.GetScopes())
 	}
 	return nil
 }
@@ -174,6 +169,22 @@ func toPbSinkCredentialByType(credentialType *primitive.CredentialType) *pb.Sink
 				Secret:     primitive.SecretsMask,
 			},
 		}
+	case primitive.HMAC:
+		to.CredentialType = pb.SinkCredential_HMAC
+		to.Credential = &pb.SinkCredential_Hmac{
+			Hmac: &pb.HMACCredential{
+				Secret: primitive.SecretsMask,
+			},
+		}
+	case primitive.OAuth2:
+		to.CredentialType = pb.SinkCredential_OAUTH2
+		to.Credential = &pb.SinkCredential_Oauth2{
+			Oauth2: &pb.OAuth2Credential{
+				TokenUrl:     primitive.SecretsMask,
+				ClientId:     primitive.SecretsMask,
+				ClientSecret: primitive.SecretsMask,
+			},
+		}
 	}
 	return to
 }
@@ -210,6 +221,25 @@ func toPbSinkCredential(from primitive.SinkCredential) *pb.SinkCredential {
 				Secret:     credential.Secret,
 			},
 		}
+	case primitive.HMAC:
+		credential, _ := from.(*primitive.HMACSinkCredential)
+		to.CredentialType = pb.SinkCredential_HMAC
+		to.Credential = &pb.SinkCredential_Hmac{
+			Hmac: &pb.HMACCredential{
+				Secret: credential.Secret,
+			},
+		}
+	case primitive.OAuth2:
+		credential, _ := from.(*primitive.OAuth2SinkCredential)
+		to.CredentialType = pb.SinkCredential_OAUTH2
+		to.Credential = &pb.SinkCredential_Oauth2{
+			Oauth2: &pb.OAuth2Credential{
+				TokenUrl:     credential.TokenURL,
+				ClientId:     credential.ClientID,
+				ClientSecret: credential.ClientSecret,
+				Scopes:       credential.Scopes,
+			},
+		}
 	}
 	return to
 }
@@ -219,11 +249,13 @@ func fromPbSubscriptionConfig(config *pb.SubscriptionConfig) primitive.Subscript
 		return primitive.SubscriptionConfig{}
 	}
 	to := primitive.SubscriptionConfig{
-		RateLimit:         config.RateLimit,
-		MaxRetryAttempts:  config.MaxRetryAttempts,
-		DeliveryTimeout:   config.DeliveryTimeout,
-		DisableDeadLetter: config.DisableDeadLetter,
-		OrderedEvent:      config.OrderedEvent,
+		RateLimit:           config.RateLimit,
+		MaxRetryAttempts:    config.MaxRetryAttempts,
+		DeliveryTimeout:     config.DeliveryTimeout,
+		DisableDeadLetter:   config.DisableDeadLetter,
+		OrderedEvent:        config.OrderedEvent,
+		OrderedKeyAttribute: config.OrderedKeyAttribute,
+		Shards:              config.Shards,
 	}
 	switch config.OffsetType {
 	case pb.SubscriptionConfig_LATEST:
@@ -239,11 +271,13 @@ func fromPbSubscriptionConfig(config *pb.SubscriptionConfig) primitive.Subscript
 
 func toPbSubscriptionConfig(config primitive.SubscriptionConfig) *pb.SubscriptionConfig {
 	to := &pb.SubscriptionConfig{
-		RateLimit:         config.RateLimit,
-		MaxRetryAttempts:  config.MaxRetryAttempts,
-		DeliveryTimeout:   config.DeliveryTimeout,
-		DisableDeadLetter: config.DisableDeadLetter,
-		OrderedEvent:      config.OrderedEvent,
+		RateLimit:           config.RateLimit,
+		MaxRetryAttempts:    config.MaxRetryAttempts,
+		DeliveryTimeout:     config.DeliveryTimeout,
+		DisableDeadLetter:   config.DisableDeadLetter,
+		OrderedEvent:        config.OrderedEvent,
+		OrderedKeyAttribute: config.OrderedKeyAttribute,
+		Shards:              config.Shards,
 	}
 	switch config.OffsetType {
 	case primitive.LatestOffset:
@@ -265,9 +299,9 @@ func FromPbAddSubscription(req *triggerpb.AddSubscriptionRequest) (*primitive.Su
 	sub := &primitive.Subscription{
 		ID:                   vanus.ID(req.Id),
 		Sink:                 primitive.URI(req.Sink),
-		SinkCredential:       fromPbSinkCredential(req.SinkCredential),
-		Protocol:             fromPbProtocol(req.Protocol),
-		ProtocolSetting:      fromPbProtocolSettings(req.ProtocolSettings),
+		SinkCredential:       FromPbSinkCredential(req.SinkCredential),
+		Protocol:             FromPbProtocol(req.Protocol),
+		ProtocolSetting:      FromPbProtocolSettings(req.ProtocolSettings),
 		EventbusID:           vanus.NewIDFromUint64(req.EventbusId),
 		DeadLetterEventbusID: vanus.NewIDFromUint64(req.DeadLetterEventbusId),
 		RetryEventbusID:      vanus.NewIDFromUint64(req.RetryEventbusId),
@@ -276,6 +310,7 @@ func FromPbAddSubscription(req *triggerpb.AddSubscriptionRequest) (*primitive.Su
 		Filters:              fromPbFilters(req.Filters),
 		Transformer:          t,
 		Config:               fromPbSubscriptionConfig(req.Config),
+		Shards:               req.Shards,
 	}
 	return sub, nil
 }
@@ -293,8 +328,9 @@ func ToPbAddSubscription(sub *primitive.Subscription) *triggerpb.AddSubscription
 		Filters:              toPbFilters(sub.Filters),
 		Transformer:          ToPbTransformer(sub.Transformer),
 		Config:               toPbSubscriptionConfig(sub.Config),
-		Protocol:             toPbProtocol(sub.Protocol),
+		Protocol:             ToPbProtocol(sub.Protocol),
 		ProtocolSettings:     toPbProtocolSettings(sub.ProtocolSetting),
+		Shards:               sub.Shards,
 	}
 	return to
 }
@@ -307,7 +343,7 @@ func ToPbSubscription(sub *metadata.Subscription, offsets info.ListOffsetInfo) *
 		Config:           toPbSubscriptionConfig(sub.Config),
 		Sink:             string(sub.Sink),
 		SinkCredential:   toPbSinkCredentialByType(sub.SinkCredentialType),
-		Protocol:         toPbProtocol(sub.Protocol),
+		Protocol:         ToPbProtocol(sub.Protocol),
 		ProtocolSettings: toPbProtocolSettings(sub.ProtocolSetting),
 		EventbusId:       sub.EventbusID.Uint64(),
 		NamespaceId:      sub.NamespaceID.Uint64(),
@@ -365,9 +401,40 @@ func fromPbFilter(filter *pb.Filter) *primitive.SubscriptionFilter {
 	if len(filter.Any) > 0 {
 		return &primitive.SubscriptionFilter{Any: fromPbFilters(filter.Any)}
 	}
+	if len(filter.Range) > 0 {
+		return &primitive.SubscriptionFilter{Range: fromPbRange(filter.Range)}
+	}
+	if len(filter.Exists) > 0 {
+		return &primitive.SubscriptionFilter{Exists: filter.Exists}
+	}
+	if len(filter.In) > 0 {
+		return &primitive.SubscriptionFilter{In: fromPbIn(filter.In)}
+	}
+	if len(filter.Regex) > 0 {
+		return &primitive.SubscriptionFilter{Regex: filter.Regex}
+	}
 	return nil
 }
 
+func fromPbRange(conditions map[string]*pb.RangeCondition) map[string]*primitive.RangeCondition {
+	to := make(map[string]*primitive.RangeCondition, len(conditions))
+	for key, c := range conditions {
+		if c == nil {
+			continue
+		}
+		to[key] = &primitive.RangeCondition{Gt: c.Gt, Gte: c.Gte, Lt: c.Lt, Lte: c.Lte}
+	}
+	return to
+}
+
+func fromPbIn(in map[string]*structpb.ListValue) map[string][]interface{} {
+	to := make(map[string][]interface{}, len(in))
+	for key, values := range in {
+		to[key] = values.AsSlice()
+	}
+	return to
+}
+
 func toPbFilters(filters []*primitive.SubscriptionFilter) []*pb.Filter {
 	to := make([]*pb.Filter, 0, len(filters))
 	for _, filter := range filters {
@@ -401,9 +468,41 @@ func toPbFilter(filter *primitive.SubscriptionFilter) *pb.Filter {
 	if len(filter.Any) > 0 {
 		return &pb.Filter{Any: toPbFilters(filter.Any)}
 	}
+	if len(filter.Range) > 0 {
+		return &pb.Filter{Range: toPbRange(filter.Range)}
+	}
+	if len(filter.Exists) > 0 {
+		return &pb.Filter{Exists: filter.Exists}
+	}
+	if len(filter.In) > 0 {
+		return &pb.Filter{In: toPbIn(filter.In)}
+	}
+	if len(filter.Regex) > 0 {
+		return &pb.Filter{Regex: filter.Regex}
+	}
 	return nil
 }
 
+func toPbRange(conditions map[string]*primitive.RangeCondition) map[string]*pb.RangeCondition {
+	to := make(map[string]*pb.RangeCondition, len(conditions))
+	for key, c := range conditions {
+		if c == nil {
+			continue
+		}
+		to[key] = &pb.RangeCondition{Gt: c.Gt, Gte: c.Gte, Lt: c.Lt, Lte: c.Lte}
+	}
+	return to
+}
+
+func toPbIn(in map[string][]interface{}) map[string]*structpb.ListValue {
+	to := make(map[string]*structpb.ListValue, len(in))
+	for key, values := range in {
+		// values come from the request, which are always convertible.
+		to[key], _ = structpb.NewList(values)
+	}
+	return to
+}
+
 func FromPbOffsetInfos(offsets []*pb.OffsetInfo) info.ListOffsetInfo {
 	var to info.ListOffsetInfo
 	for _, offset := range offsets {
@@ -419,6 +518,37 @@ func fromPbOffsetInfo(offset *pb.OffsetInfo) info.OffsetInfo {
 	}
 }
 
+func FromPbSubscriptionLoads(loads []*ctrl.SubscriptionLoad) map[vanus.ID]metadata.SubscriptionLoad {
+	to := make(map[vanus.ID]metadata.SubscriptionLoad, len(loads))
+	for _, load := range loads {
+		to[vanus.ID(load.SubscriptionId)] = metadata.SubscriptionLoad{
+			Throughput: load.Throughput,
+			Lag:        load.Lag,
+			Goroutines: int(load.Goroutines),
+		}
+	}
+	return to
+}
+
+func ToPbSubscriptionLag(lag *metadata.SubscriptionLag) *ctrl.GetSubscriptionLagResponse {
+	to := &ctrl.GetSubscriptionLagResponse{
+		SubscriptionId: lag.SubscriptionID.Uint64(),
+		Eventlogs:      make([]*ctrl.EventlogLag, 0, len(lag.Eventlogs)),
+		Lag:            lag.Lag,
+		LagTime:        uint64(lag.LagTime.Milliseconds()),
+	}
+	for _, elLag := range lag.Eventlogs {
+		to.Eventlogs = append(to.Eventlogs, &ctrl.EventlogLag{
+			EventlogId:      elLag.EventlogID.Uint64(),
+			CommittedOffset: elLag.CommittedOffset,
+			LatestOffset:    elLag.LatestOffset,
+			Lag:             elLag.Lag,
+			LagTime:         uint64(elLag.LagTime.Milliseconds()),
+		})
+	}
+	return to
+}
+
 func ToPbSubscriptionInfo(sub info.SubscriptionInfo) *pb.SubscriptionInfo {
 	to := &pb.SubscriptionInfo{
 		SubscriptionId: uint64(sub.SubscriptionID),
@@ -113,4 +113,18 @@ var (
 		Name:      "trigger_number",
 		Help:      "The number of trigger",
 	}, []string{LabelTriggerWorker})
+
+	SubscriptionLagGaugeVec = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfController,
+		Name:      "subscription_lag",
+		Help:      "The number of events which the subscription hasn't consumed",
+	}, []string{LabelTrigger, LabelEventbus, LabelEventlog})
+
+	SubscriptionLagTimeGaugeVec = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfController,
+		Name:      "subscription_lag_seconds",
+		Help:      "The seconds since the first event which the subscription hasn't consumed was written",
+	}, []string{LabelTrigger, LabelEventbus, LabelEventlog})
 )
@@ -43,6 +43,7 @@ const (
 	LabelSegmentDeletedBecauseExpired      = "segment_expired"
 	LabelSegmentDeletedBecauseCreateFailed = "segment_create_failed"
 	LabelSegmentDeletedBecauseDeleted      = "segment_deleted"
+	LabelSegmentDeletedBecauseOversize     = "segment_oversize"
 	LabelValueProtocolHTTP                 = "http"
 	LabelValueProtocolGRPC                 = "grpc"
 )
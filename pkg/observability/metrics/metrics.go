@@ -79,6 +79,8 @@ func GetControllerMetrics() []prometheus.Collector {
 		SubscriptionGauge,
 		SubscriptionTransformerGauge,
 		CtrlTriggerGauge,
+		SubscriptionLagGaugeVec,
+		SubscriptionLagTimeGaugeVec,
 	}
 	return append(coll, getGoRuntimeMetrics()...)
 }
@@ -106,6 +108,8 @@ func GetTriggerMetrics() []prometheus.Collector {
 		TriggerDeadLetterEventAppendSecond,
 		TriggerPushEventCounter,
 		TriggerPushEventTime,
+		TriggerOrderedStuckEventGauge,
+		TriggerOrderedRetryCounter,
 	}
 	return append(coll, getGoRuntimeMetrics()...)
 }
@@ -129,6 +133,7 @@ func GetSegmentServerMetrics() []prometheus.Collector {
 		WriteThroughputCounterVec,
 		WriteTPSCounterVec,
 		ReadTPSCounterVec,
+		ReadFilteredCounterVec,
 		ReadThroughputCounterVec,
 	}
 	return append(coll, getGoRuntimeMetrics()...)
@@ -33,6 +33,13 @@ var (
 		Help:      "Total events for reading",
 	}, []string{LabelVolume, LabelBlock})
 
+	ReadFilteredCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "read_filtered_event_count",
+		Help:      "Total events skipped by the filter of reading",
+	}, []string{LabelVolume, LabelBlock})
+
 	WriteThroughputCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Namespace: namespace,
 		Subsystem: moduleOfSegmentServer,
@@ -102,4 +102,18 @@ var (
 		Name:      "push_event_rt",
 		Help:      "The rt of trigger push event",
 	}, []string{LabelTrigger})
+
+	TriggerOrderedStuckEventGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTriggerWorker,
+		Name:      "ordered_stuck_event_number",
+		Help:      "The number of ordered event which is retrying in place",
+	}, []string{LabelTrigger})
+
+	TriggerOrderedRetryCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTriggerWorker,
+		Name:      "ordered_retry_number",
+		Help:      "The attempt number of ordered event retrying in place",
+	}, []string{LabelTrigger})
 )
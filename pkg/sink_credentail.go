@@ -20,6 +20,8 @@ const (
 	Plain  CredentialType = "plain"
 	AWS    CredentialType = "aws"
 	GCloud CredentialType = "gcloud"
+	HMAC   CredentialType = "hmac"
+	OAuth2 CredentialType = "oauth2"
 
 	SecretsMask = "******"
 )
@@ -60,6 +62,24 @@ func FillSinkCredential(dst, src SinkCredential) {
 		if _dst.CredentialJSON == SecretsMask {
 			_dst.CredentialJSON = _src.CredentialJSON
 		}
+	case HMAC:
+		_dst, _ := dst.(*HMACSinkCredential)
+		_src, _ := src.(*HMACSinkCredential)
+		if _dst.Secret == SecretsMask {
+			_dst.Secret = _src.Secret
+		}
+	case OAuth2:
+		_dst, _ := dst.(*OAuth2SinkCredential)
+		_src, _ := src.(*OAuth2SinkCredential)
+		if _dst.TokenURL == SecretsMask {
+			_dst.TokenURL = _src.TokenURL
+		}
+		if _dst.ClientID == SecretsMask {
+			_dst.ClientID = _src.ClientID
+		}
+		if _dst.ClientSecret == SecretsMask {
+			_dst.ClientSecret = _src.ClientSecret
+		}
 	}
 }
 
@@ -108,3 +128,39 @@ func NewGCloudSinkCredential(credentialJSON string) SinkCredential {
 func (c *GCloudSinkCredential) GetType() CredentialType {
 	return GCloud
 }
+
+// HMACSinkCredential signs the webhook requests with HMAC-SHA256.
+type HMACSinkCredential struct {
+	Secret string `json:"secret"`
+}
+
+func NewHMACSinkCredential(secret string) SinkCredential {
+	return &HMACSinkCredential{
+		Secret: secret,
+	}
+}
+
+func (c *HMACSinkCredential) GetType() CredentialType {
+	return HMAC
+}
+
+// OAuth2SinkCredential authorizes the webhook requests by the OAuth2 client credentials grant.
+type OAuth2SinkCredential struct {
+	TokenURL     string   `json:"token_url"`
+	ClientID     string   `json:"client_id"`
+	ClientSecret string   `json:"client_secret"`
+	Scopes       []string `json:"scopes,omitempty"`
+}
+
+func NewOAuth2SinkCredential(tokenURL, clientID, clientSecret string, scopes []string) SinkCredential {
+	return &OAuth2SinkCredential{
+		TokenURL:     tokenURL,
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Scopes:       scopes,
+	}
+}
+
+func (c *OAuth2SinkCredential) GetType() CredentialType {
+	return OAuth2
+}
@@ -42,6 +42,8 @@ type Subscription struct {
 	Protocol             Protocol               `json:"protocol,omitempty"`
 	ProtocolSetting      *ProtocolSetting       `json:"protocol_setting,omitempty"`
 	SinkCredential       SinkCredential         `json:"sink_credential,omitempty"`
+	// Shards are the shards run by the trigger worker, all shards if it is empty.
+	Shards []uint32 `json:"shards,omitempty"`
 }
 
 func (sub *Subscription) String() string {
@@ -57,6 +59,7 @@ const (
 	AwsLambdaProtocol Protocol = "aws-lambda"
 	GCloudFunctions   Protocol = "gcloud-functions"
 	GRPC              Protocol = "grpc"
+	KafkaProtocol     Protocol = "kafka"
 )
 
 type ProtocolSetting struct {
@@ -81,6 +84,28 @@ type SubscriptionConfig struct {
 	DisableDeadLetter bool       `json:"disable_dead_letter,omitempty"`
 	// send event with ordered
 	OrderedEvent bool `json:"ordered_event"`
+	// OrderedKeyAttribute is the attribute of event used as ordering key, events are ordered per key
+	// rather than the whole subscription if it is set.
+	OrderedKeyAttribute string `json:"ordered_key_attribute,omitempty"`
+	// Shards is the number of shards the eventlogs of eventbus are split into.
+	Shards uint32 `json:"shards,omitempty"`
+}
+
+// GetShards return the number of shards, it is 1 at least.
+func (c *SubscriptionConfig) GetShards() uint32 {
+	if c != nil && c.Shards > 1 {
+		return c.Shards
+	}
+	return 1
+}
+
+// EventlogShard returns the shard of the eventlog at index idx among the eventlogs
+// of eventbus sorted by ID.
+func EventlogShard(idx int, shards uint32) uint32 {
+	if shards <= 1 {
+		return 0
+	}
+	return uint32(idx) % shards
 }
 
 // GetMaxRetryAttempts return MaxRetryAttempts if nil return -1.
@@ -100,14 +125,26 @@ func (c *SubscriptionConfig) String() string {
 }
 
 type SubscriptionFilter struct {
-	Exact  map[string]string      `json:"exact,omitempty"`
-	Prefix map[string]string      `json:"prefix,omitempty"`
-	Suffix map[string]string      `json:"suffix,omitempty"`
-	CeSQL  string                 `json:"ce_sql,omitempty"`
-	Not    *SubscriptionFilter    `json:"not,omitempty"`
-	All    SubscriptionFilterList `json:"all,omitempty"`
-	Any    SubscriptionFilterList `json:"any,omitempty"`
-	CEL    string                 `json:"cel,omitempty"`
+	Exact  map[string]string          `json:"exact,omitempty"`
+	Prefix map[string]string          `json:"prefix,omitempty"`
+	Suffix map[string]string          `json:"suffix,omitempty"`
+	CeSQL  string                     `json:"ce_sql,omitempty"`
+	Not    *SubscriptionFilter        `json:"not,omitempty"`
+	All    SubscriptionFilterList     `json:"all,omitempty"`
+	Any    SubscriptionFilterList     `json:"any,omitempty"`
+	CEL    string                     `json:"cel,omitempty"`
+	Range  map[string]*RangeCondition `json:"range,omitempty"`
+	Exists []string                   `json:"exists,omitempty"`
+	In     map[string][]interface{}   `json:"in,omitempty"`
+	Regex  map[string]string          `json:"regex,omitempty"`
+}
+
+// RangeCondition bounds a numeric value, a nil bound is not checked.
+type RangeCondition struct {
+	Gt  *float64 `json:"gt,omitempty"`
+	Gte *float64 `json:"gte,omitempty"`
+	Lt  *float64 `json:"lt,omitempty"`
+	Lte *float64 `json:"lte,omitempty"`
 }
 
 type SubscriptionFilterList []*SubscriptionFilter
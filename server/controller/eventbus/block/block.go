@@ -25,6 +25,7 @@ import (
 	"github.com/huandu/skiplist"
 
 	"github.com/vanus-labs/vanus/api/errors"
+	metapb "github.com/vanus-labs/vanus/api/meta"
 	vanus "github.com/vanus-labs/vanus/api/vsr"
 	"github.com/vanus-labs/vanus/pkg/observability/log"
 
@@ -41,8 +42,13 @@ const (
 
 type Allocator interface {
 	Run(ctx context.Context, kvCli kv.Client, dynamicAllocate bool) error
-	Pick(ctx context.Context, num int) ([]*metadata.Block, error)
-	PickByVolumes(ctx context.Context, volumes []vanus.ID) ([]*metadata.Block, error)
+	Pick(ctx context.Context, num int, compress metapb.CompressAlgorithm) ([]*metadata.Block, error)
+	// PickByVolumes picks Blocks on the specified volumes, it behaves like Pick if any of them is draining.
+	PickByVolumes(
+		ctx context.Context, volumes []vanus.ID, compress metapb.CompressAlgorithm,
+	) ([]*metadata.Block, error)
+	// PickExcept picks a Block on a volume which isn't any one of excluded.
+	PickExcept(ctx context.Context, excluded []vanus.ID, compress metapb.CompressAlgorithm) (*metadata.Block, error)
 	Stop()
 }
 
@@ -69,16 +75,22 @@ type allocator struct {
 	blockCapacity     int64
 }
 
-func (al *allocator) PickByVolumes(ctx context.Context, volumes []vanus.ID) ([]*metadata.Block, error) {
+func (al *allocator) PickByVolumes(
+	ctx context.Context, volumes []vanus.ID, compress metapb.CompressAlgorithm,
+) ([]*metadata.Block, error) {
 	instances := make([]server.Instance, len(volumes))
 	for idx := range volumes {
 		i := al.selector.SelectByID(volumes[idx])
 		if i == nil {
 			return nil, errors.ErrVolumeInstanceNoServer
 		}
+		if i.GetMeta().Draining {
+			// The volume is being decommissioned, place Blocks on others instead.
+			return al.Pick(ctx, len(volumes), compress)
+		}
 		instances[idx] = i
 	}
-	return al.pick(ctx, instances)
+	return al.pick(ctx, instances, compress)
 }
 
 func (al *allocator) Run(ctx context.Context, kvCli kv.Client, startDynamicAllocate bool) error { //nolint:revive // ok
@@ -112,7 +124,9 @@ func (al *allocator) Run(ctx context.Context, kvCli kv.Client, startDynamicAlloc
 	return nil
 }
 
-func (al *allocator) Pick(ctx context.Context, num int) ([]*metadata.Block, error) {
+func (al *allocator) Pick(
+	ctx context.Context, num int, compress metapb.CompressAlgorithm,
+) ([]*metadata.Block, error) {
 	al.mutex.Lock()
 	defer al.mutex.Unlock()
 	instances := al.selector.Select(num, al.blockCapacity)
@@ -120,10 +134,29 @@ func (al *allocator) Pick(ctx context.Context, num int) ([]*metadata.Block, erro
 		return nil, errors.ErrVolumeInstanceNotFound
 	}
 
-	return al.pick(ctx, instances)
+	return al.pick(ctx, instances, compress)
+}
+
+func (al *allocator) PickExcept(
+	ctx context.Context, excluded []vanus.ID, compress metapb.CompressAlgorithm,
+) (*metadata.Block, error) {
+	al.mutex.Lock()
+	defer al.mutex.Unlock()
+	ins := al.selector.SelectExcept(al.blockCapacity, excluded)
+	if ins == nil {
+		return nil, errors.ErrVolumeInstanceNotFound
+	}
+
+	blocks, err := al.pick(ctx, []server.Instance{ins}, compress)
+	if err != nil {
+		return nil, err
+	}
+	return blocks[0], nil
 }
 
-func (al *allocator) pick(ctx context.Context, volumes []server.Instance) ([]*metadata.Block, error) {
+func (al *allocator) pick(
+	ctx context.Context, volumes []server.Instance, compress metapb.CompressAlgorithm,
+) ([]*metadata.Block, error) {
 	blockArr := make([]*metadata.Block, len(volumes))
 	for idx := range volumes {
 		var skipList *skiplist.SkipList
@@ -135,8 +168,10 @@ func (al *allocator) pick(ctx context.Context, volumes []server.Instance) ([]*me
 			skipList, _ = v.(*skiplist.SkipList)
 		}
 
-		if !exist || skipList.Len() == 0 {
-			block, err = ins.CreateBlock(ctx, al.blockCapacity)
+		// the compress algorithm is fixed when a block is created, so blocks in buffer can be
+		// reused only if they use the same algorithm.
+		if !exist || skipList.Len() == 0 || frontBlock(skipList).Compress != compress {
+			block, err = ins.CreateBlock(ctx, al.blockCapacity, compress)
 			if err != nil {
 				return nil, err
 			}
@@ -155,6 +190,11 @@ func (al *allocator) pick(ctx context.Context, volumes []server.Instance) ([]*me
 	return blockArr, nil
 }
 
+func frontBlock(l *skiplist.SkipList) *metadata.Block {
+	block, _ := l.Front().Value.(*metadata.Block)
+	return block
+}
+
 func (al *allocator) Stop() {}
 
 func (al *allocator) dynamicAllocateBlockTask(ctx context.Context) { //nolint:unused // ok
@@ -174,7 +214,7 @@ func (al *allocator) dynamicAllocateBlockTask(ctx context.Context) { //nolint:un
 				}
 				skipList, _ = v.(*skiplist.SkipList)
 				for skipList.Len() < defaultBlockBufferSizePerVolume {
-					block, err := instance.CreateBlock(ctx, al.blockCapacity)
+					block, err := instance.CreateBlock(ctx, al.blockCapacity, metapb.CompressAlgorithm_NONE)
 					if err != nil {
 						log.Warn(ctx).
 							Interface("volume_id", instance.GetMeta().ID).
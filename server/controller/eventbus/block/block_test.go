@@ -25,6 +25,8 @@ import (
 	. "github.com/smartystreets/goconvey/convey"
 	"go.uber.org/mock/gomock"
 
+	"github.com/vanus-labs/vanus/api/errors"
+	metapb "github.com/vanus-labs/vanus/api/meta"
 	vanus "github.com/vanus-labs/vanus/api/vsr"
 
 	"github.com/vanus-labs/vanus/pkg/kv"
@@ -41,19 +43,90 @@ func TestAllocator_Pick(t *testing.T) {
 		alloc.kvClient = kvMock
 		kvMock.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
 		Convey("get 1 block", func() {
-			blocks, err := alloc.Pick(stdCtx.Background(), 1)
+			blocks, err := alloc.Pick(stdCtx.Background(), 1, metapb.CompressAlgorithm_NONE)
 			So(err, ShouldBeNil)
 			So(blocks, ShouldHaveLength, 1)
 		})
 
 		Convey("get 3 blocks", func() {
-			blocks, err := alloc.Pick(stdCtx.Background(), 3)
+			blocks, err := alloc.Pick(stdCtx.Background(), 3, metapb.CompressAlgorithm_NONE)
 			So(err, ShouldBeNil)
 			So(blocks, ShouldHaveLength, 3)
 		})
 	})
 }
 
+func TestAllocator_PickExcept(t *testing.T) {
+	Convey("test pick method of allocator.PickExcept", t, func() {
+		ctrl := gomock.NewController(t)
+		alloc := getAllocator(ctrl)
+		kvMock := kv.NewMockClient(ctrl)
+		alloc.kvClient = kvMock
+		kvMock.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+
+		blk, err := alloc.PickExcept(stdCtx.Background(), []vanus.ID{
+			vanus.NewIDFromUint64(1), vanus.NewIDFromUint64(3),
+		}, metapb.CompressAlgorithm_NONE)
+		So(err, ShouldBeNil)
+		So(blk.VolumeID, ShouldEqual, vanus.NewIDFromUint64(2))
+
+		_, err = alloc.PickExcept(stdCtx.Background(), []vanus.ID{
+			vanus.NewIDFromUint64(1), vanus.NewIDFromUint64(2), vanus.NewIDFromUint64(3),
+		}, metapb.CompressAlgorithm_NONE)
+		So(err, ShouldEqual, errors.ErrVolumeInstanceNotFound)
+	})
+}
+
+func TestAllocator_PickByVolumes(t *testing.T) {
+	Convey("test pick method of allocator.PickByVolumes", t, func() {
+		ctrl := gomock.NewController(t)
+		var volumes []server.Instance
+		alloc := NewAllocator(0, NewVolumeRoundRobin(func() []server.Instance {
+			return volumes
+		})).(*allocator)
+		kvMock := kv.NewMockClient(ctrl)
+		alloc.kvClient = kvMock
+		kvMock.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+
+		newInstance := func(id uint64, draining bool) *server.MockInstance {
+			ins := server.NewMockInstance(ctrl)
+			ins.EXPECT().GetMeta().AnyTimes().Return(&metadata.VolumeMetadata{
+				ID:       vanus.NewIDFromUint64(id),
+				Draining: draining,
+			})
+			ins.EXPECT().ID().AnyTimes().Return(vanus.NewIDFromUint64(id))
+			ins.EXPECT().CreateBlock(gomock.Any(), defaultBlockSize, gomock.Any()).AnyTimes().DoAndReturn(func(
+				ctx stdCtx.Context, size int64, compress metapb.CompressAlgorithm,
+			) (*metadata.Block, error) {
+				return &metadata.Block{
+					ID:       snowflake.NewTestID(),
+					Capacity: size,
+					VolumeID: vanus.NewIDFromUint64(id),
+					Compress: compress,
+				}, nil
+			})
+			return ins
+		}
+		volumes = []server.Instance{newInstance(1, false), newInstance(2, true), newInstance(3, false)}
+
+		blocks, err := alloc.PickByVolumes(stdCtx.Background(), []vanus.ID{
+			vanus.NewIDFromUint64(1), vanus.NewIDFromUint64(3),
+		}, metapb.CompressAlgorithm_NONE)
+		So(err, ShouldBeNil)
+		So(blocks, ShouldHaveLength, 2)
+		So(blocks[0].VolumeID, ShouldEqual, vanus.NewIDFromUint64(1))
+		So(blocks[1].VolumeID, ShouldEqual, vanus.NewIDFromUint64(3))
+
+		blocks, err = alloc.PickByVolumes(stdCtx.Background(), []vanus.ID{
+			vanus.NewIDFromUint64(1), vanus.NewIDFromUint64(2),
+		}, metapb.CompressAlgorithm_NONE)
+		So(err, ShouldBeNil)
+		So(blocks, ShouldHaveLength, 2)
+		So(blocks[0].VolumeID, ShouldEqual, vanus.NewIDFromUint64(1))
+		So(blocks[1].VolumeID, ShouldEqual, vanus.NewIDFromUint64(3))
+	})
+}
+
 func TestAllocator_RunWithoutDynamic(t *testing.T) {
 	Convey("test run without dynamic allocate block", t, func() {
 		ctrl := gomock.NewController(t)
@@ -168,6 +241,25 @@ func TestAllocator_RunWithoutDynamic(t *testing.T) {
 			So(list2.Get(block5.ID.String()).Value, ShouldResemble, &block5)
 			So(list3.Get(block6.ID.String()).Value, ShouldResemble, &block6)
 		})
+
+		Convey("test pick buffered blocks with compress algorithm", func() {
+			err := alloc.Run(ctx, kvMock, false)
+			So(err, ShouldBeNil)
+			kvMock.EXPECT().Set(ctx, gomock.Any(), gomock.Any()).Times(1).Return(nil)
+
+			// the first volume is the one which block4 belongs to.
+			volumes := alloc.selector.GetAllVolume()[:1]
+			blocks, err := alloc.pick(ctx, volumes, metapb.CompressAlgorithm_ZSTD)
+			So(err, ShouldBeNil)
+			So(blocks, ShouldHaveLength, 1)
+			So(blocks[0].ID, ShouldNotEqual, block4.ID)
+			So(blocks[0].Compress, ShouldEqual, metapb.CompressAlgorithm_ZSTD)
+
+			blocks, err = alloc.pick(ctx, volumes, metapb.CompressAlgorithm_NONE)
+			So(err, ShouldBeNil)
+			So(blocks, ShouldHaveLength, 1)
+			So(blocks[0], ShouldResemble, &block4)
+		})
 	})
 }
 
@@ -293,13 +385,14 @@ func getAllocator(ctrl *gomock.Controller) *allocator {
 		Capacity: 64 * 1024 * 1024,
 	})
 	srv1.EXPECT().ID().AnyTimes().Return(vanus.NewIDFromUint64(uint64(time.Now().UnixNano())))
-	srv1.EXPECT().CreateBlock(gomock.Any(), defaultBlockSize).AnyTimes().DoAndReturn(func(ctx stdCtx.Context,
-		size int64,
+	srv1.EXPECT().CreateBlock(gomock.Any(), defaultBlockSize, gomock.Any()).AnyTimes().DoAndReturn(func(
+		ctx stdCtx.Context, size int64, compress metapb.CompressAlgorithm,
 	) (*metadata.Block, error) {
 		return &metadata.Block{
 			ID:       snowflake.NewTestID(),
 			Capacity: size,
 			VolumeID: vanus.NewIDFromUint64(1),
+			Compress: compress,
 		}, nil
 	})
 
@@ -309,13 +402,14 @@ func getAllocator(ctrl *gomock.Controller) *allocator {
 		Capacity: 64 * 1024 * 1024,
 	})
 	srv2.EXPECT().ID().AnyTimes().Return(vanus.NewIDFromUint64(uint64(time.Now().UnixNano())))
-	srv2.EXPECT().CreateBlock(gomock.Any(), defaultBlockSize).AnyTimes().DoAndReturn(func(ctx stdCtx.Context,
-		size int64,
+	srv2.EXPECT().CreateBlock(gomock.Any(), defaultBlockSize, gomock.Any()).AnyTimes().DoAndReturn(func(
+		ctx stdCtx.Context, size int64, compress metapb.CompressAlgorithm,
 	) (*metadata.Block, error) {
 		return &metadata.Block{
 			ID:       snowflake.NewTestID(),
 			Capacity: size,
 			VolumeID: vanus.NewIDFromUint64(2),
+			Compress: compress,
 		}, nil
 	})
 
@@ -325,13 +419,14 @@ func getAllocator(ctrl *gomock.Controller) *allocator {
 		Capacity: 64 * 1024 * 1024,
 	})
 	srv3.EXPECT().ID().AnyTimes().Return(vanus.NewIDFromUint64(uint64(time.Now().UnixNano())))
-	srv3.EXPECT().CreateBlock(gomock.Any(), defaultBlockSize).AnyTimes().DoAndReturn(func(ctx stdCtx.Context,
-		size int64,
+	srv3.EXPECT().CreateBlock(gomock.Any(), defaultBlockSize, gomock.Any()).AnyTimes().DoAndReturn(func(
+		ctx stdCtx.Context, size int64, compress metapb.CompressAlgorithm,
 	) (*metadata.Block, error) {
 		return &metadata.Block{
 			ID:       snowflake.NewTestID(),
 			Capacity: size,
 			VolumeID: vanus.NewIDFromUint64(3),
+			Compress: compress,
 		}, nil
 	})
 
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	meta "github.com/vanus-labs/vanus/api/meta"
 	vsr "github.com/vanus-labs/vanus/api/vsr"
 	kv "github.com/vanus-labs/vanus/pkg/kv"
 	metadata "github.com/vanus-labs/vanus/server/controller/eventbus/metadata"
@@ -43,33 +44,48 @@ func (m *MockAllocator) EXPECT() *MockAllocatorMockRecorder {
 }
 
 // Pick mocks base method.
-func (m *MockAllocator) Pick(ctx context.Context, num int) ([]*metadata.Block, error) {
+func (m *MockAllocator) Pick(ctx context.Context, num int, compress meta.CompressAlgorithm) ([]*metadata.Block, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Pick", ctx, num)
+	ret := m.ctrl.Call(m, "Pick", ctx, num, compress)
 	ret0, _ := ret[0].([]*metadata.Block)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Pick indicates an expected call of Pick.
-func (mr *MockAllocatorMockRecorder) Pick(ctx, num any) *gomock.Call {
+func (mr *MockAllocatorMockRecorder) Pick(ctx, num, compress any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pick", reflect.TypeOf((*MockAllocator)(nil).Pick), ctx, num)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pick", reflect.TypeOf((*MockAllocator)(nil).Pick), ctx, num, compress)
 }
 
 // PickByVolumes mocks base method.
-func (m *MockAllocator) PickByVolumes(ctx context.Context, volumes []vsr.ID) ([]*metadata.Block, error) {
+func (m *MockAllocator) PickByVolumes(ctx context.Context, volumes []vsr.ID, compress meta.CompressAlgorithm) ([]*metadata.Block, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PickByVolumes", ctx, volumes)
+	ret := m.ctrl.Call(m, "PickByVolumes", ctx, volumes, compress)
 	ret0, _ := ret[0].([]*metadata.Block)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PickByVolumes indicates an expected call of PickByVolumes.
-func (mr *MockAllocatorMockRecorder) PickByVolumes(ctx, volumes any) *gomock.Call {
+func (mr *MockAllocatorMockRecorder) PickByVolumes(ctx, volumes, compress any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PickByVolumes", reflect.TypeOf((*MockAllocator)(nil).PickByVolumes), ctx, volumes)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PickByVolumes", reflect.TypeOf((*MockAllocator)(nil).PickByVolumes), ctx, volumes, compress)
+}
+
+// PickExcept mocks base method.
+func (m *MockAllocator) PickExcept(ctx context.Context, excluded []vsr.ID, compress meta.CompressAlgorithm) (*metadata.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PickExcept", ctx, excluded, compress)
+	ret0, _ := ret[0].(*metadata.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PickExcept indicates an expected call of PickExcept.
+func (mr *MockAllocatorMockRecorder) PickExcept(ctx, excluded, compress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PickExcept", reflect.TypeOf((*MockAllocator)(nil).PickExcept), ctx, excluded, compress)
 }
 
 // Run mocks base method.
@@ -29,9 +29,15 @@ import (
 type VolumeSelector interface {
 	// Select return #{num} server.Instance as an array, #{size} tell selector how large Block
 	// will be created. The same server.Instance maybe placed in different index of returned array
-	// in order to make sure that length of returned array equals with #{num}
+	// in order to make sure that length of returned array equals with #{num}. Draining
+	// server.Instance is never selected.
 	Select(num int, size int64) []server.Instance
 
+	// SelectExcept return a server.Instance which isn't any one of #{excluded} for a Block of #{size},
+	// it's used to find a new home for a replica of an existing Segment. nil will be returned if there
+	// is no available server.Instance.
+	SelectExcept(size int64, excluded []vanus.ID) server.Instance
+
 	// SelectByID return a specified server.Instance with ServerID
 	SelectByID(id vanus.ID) server.Instance
 
@@ -76,9 +82,15 @@ func (s *volumeRoundRobinSelector) Select(num int, size int64) []server.Instance
 	keys := make([]string, 0)
 	m := make(map[string]server.Instance)
 	for _, v := range volumes {
+		if v.GetMeta().Draining {
+			continue
+		}
 		keys = append(keys, v.GetMeta().ID.Key())
 		m[v.GetMeta().ID.Key()] = v
 	}
+	if len(keys) == 0 {
+		return instances
+	}
 	sort.Slice(keys, func(i, j int) bool {
 		return keys[i] < keys[j]
 	})
@@ -91,6 +103,43 @@ func (s *volumeRoundRobinSelector) Select(num int, size int64) []server.Instance
 	return instances
 }
 
+func (s *volumeRoundRobinSelector) SelectExcept(size int64, excluded []vanus.ID) server.Instance {
+	if size == 0 {
+		return nil
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	volumes := s.getVolumes()
+	candidates := make([]server.Instance, 0, len(volumes))
+	for _, v := range volumes {
+		if !v.GetMeta().Draining && !containsID(excluded, v.GetMeta().ID) {
+			candidates = append(candidates, v)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].GetMeta().ID.Key() < candidates[j].GetMeta().ID.Key()
+	})
+	ins := candidates[s.count%int64(len(candidates))]
+	log.Info().Stringer("instance", ins.GetMeta().ID).
+		Interface("excluded", excluded).
+		Msg("picked instance")
+	s.count++
+	return ins
+}
+
+func containsID(ids []vanus.ID, id vanus.ID) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *volumeRoundRobinSelector) SelectByID(id vanus.ID) server.Instance {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
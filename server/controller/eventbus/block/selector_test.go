@@ -85,6 +85,25 @@ func TestNewVolumeRoundRobin(t *testing.T) {
 			So(ins, ShouldBeNil)
 		})
 
+		Convey("test select instance except some ones", func() {
+			ins := selector.SelectExcept(64*1024*1024, []vanus.ID{vanus.NewIDFromUint64(1)})
+			So(ins, ShouldNotBeNil)
+			So(ins.GetMeta().ID.Uint64(), ShouldEqual, uint64(2))
+
+			ins = selector.SelectExcept(64*1024*1024, nil)
+			So(ins, ShouldNotBeNil)
+			So(ins.GetMeta().ID.Uint64(), ShouldEqual, uint64(2))
+			So(selector.(*volumeRoundRobinSelector).count, ShouldEqual, 2)
+
+			ins = selector.SelectExcept(64*1024*1024, []vanus.ID{
+				vanus.NewIDFromUint64(1), vanus.NewIDFromUint64(2),
+			})
+			So(ins, ShouldBeNil)
+
+			ins = selector.SelectExcept(0, nil)
+			So(ins, ShouldBeNil)
+		})
+
 		Convey("test invalid arguments", func() {
 			instances := selector.Select(0, 64*1024*1024)
 			So(instances, ShouldNotBeNil)
@@ -106,4 +125,28 @@ func TestNewVolumeRoundRobinAbnormal(t *testing.T) {
 		So(instances, ShouldNotBeNil)
 		So(instances, ShouldHaveLength, 0)
 	})
+
+	Convey("test skip draining volumes", t, func() {
+		ctrl := gomock.NewController(t)
+		srv1 := server.NewMockInstance(ctrl)
+		srv2 := server.NewMockInstance(ctrl)
+		srv1.EXPECT().GetMeta().Return(&metadata.VolumeMetadata{
+			ID:       vanus.NewIDFromUint64(1),
+			Draining: true,
+		}).AnyTimes()
+		srv2.EXPECT().GetMeta().Return(&metadata.VolumeMetadata{
+			ID: vanus.NewIDFromUint64(2),
+		}).AnyTimes()
+
+		selector := NewVolumeRoundRobin(func() []server.Instance {
+			return []server.Instance{srv1, srv2}
+		})
+		instances := selector.Select(2, 64*1024*1024)
+		So(instances, ShouldHaveLength, 2)
+		So(instances[0].GetMeta().ID.Uint64(), ShouldEqual, uint64(2))
+		So(instances[1].GetMeta().ID.Uint64(), ShouldEqual, uint64(2))
+
+		So(selector.SelectExcept(64*1024*1024, nil).GetMeta().ID.Uint64(), ShouldEqual, uint64(2))
+		So(selector.SelectExcept(64*1024*1024, []vanus.ID{vanus.NewIDFromUint64(2)}), ShouldBeNil)
+	})
 }
@@ -0,0 +1,221 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package block
+
+import (
+	// standard libraries.
+	"math"
+	"sync"
+
+	// first-party libraries.
+	vanus "github.com/vanus-labs/vanus/api/vsr"
+	"github.com/vanus-labs/vanus/pkg/observability/log"
+
+	// this project.
+	"github.com/vanus-labs/vanus/server/controller/eventbus/metadata"
+	"github.com/vanus-labs/vanus/server/controller/eventbus/server"
+)
+
+// NewVolumeTopologyAware an implementation of VolumeSelector which spreads replicas of a Segment across
+// failure domains (zone, rack and host) as far as possible. Volumes in the same situation are ordered by
+// the number of leader Blocks they hosted, and then their free capacity. #{leaders} returns the number
+// of leader Blocks of each volume, keyed by the ID of volume.
+func NewVolumeTopologyAware(f func() []server.Instance, leaders func() map[uint64]int) VolumeSelector {
+	return &volumeTopologyAwareSelector{
+		getVolumes: f,
+		getLeaders: leaders,
+	}
+}
+
+type volumeTopologyAwareSelector struct {
+	getVolumes func() []server.Instance
+	getLeaders func() map[uint64]int
+	mutex      sync.Mutex
+}
+
+// Select get #{num} instances which have #{size} free capacity, an instance may be selected more than
+// once if there are no enough instances.
+func (s *volumeTopologyAwareSelector) Select(num int, size int64) []server.Instance {
+	instances := make([]server.Instance, 0, num)
+	if num == 0 || size == 0 {
+		return instances
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	available := availableVolumes(s.getVolumes(), size, nil)
+	if len(available) == 0 {
+		return instances
+	}
+	if len(available) < num {
+		log.Warn().
+			Int("num", num).
+			Int("available", len(available)).
+			Int64("size", size).
+			Msg("no enough volumes, some replicas will be placed on the same volume")
+	}
+
+	leaders := s.leaders()
+	candidates := make([]server.Instance, 0, len(available))
+	for len(instances) < num {
+		if len(candidates) == 0 {
+			candidates = append(candidates, available...)
+		}
+		idx := bestPlacement(candidates, instances, leaders)
+		instances = append(instances, candidates[idx])
+		leaders[candidates[idx].GetMeta().ID.Uint64()]++
+		candidates = append(candidates[:idx], candidates[idx+1:]...)
+	}
+	log.Info().Interface("instances", volumeIDs(instances)).
+		Msg("picked instances")
+	return instances
+}
+
+func (s *volumeTopologyAwareSelector) SelectExcept(size int64, excluded []vanus.ID) server.Instance {
+	if size == 0 {
+		return nil
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	volumes := s.getVolumes()
+	candidates := availableVolumes(volumes, size, excluded)
+	if len(candidates) == 0 {
+		return nil
+	}
+	placed := make([]server.Instance, 0, len(excluded))
+	for _, v := range volumes {
+		if containsID(excluded, v.GetMeta().ID) {
+			placed = append(placed, v)
+		}
+	}
+
+	ins := candidates[bestPlacement(candidates, placed, s.leaders())]
+	log.Info().Stringer("instance", ins.GetMeta().ID).
+		Interface("excluded", excluded).
+		Msg("picked instance")
+	return ins
+}
+
+func (s *volumeTopologyAwareSelector) SelectByID(id vanus.ID) server.Instance {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	volumes := s.getVolumes()
+	for idx := range volumes {
+		if volumes[idx].ID() == id {
+			return volumes[idx]
+		}
+	}
+	return nil
+}
+
+func (s *volumeTopologyAwareSelector) GetAllVolume() []server.Instance {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.getVolumes()
+}
+
+func (s *volumeTopologyAwareSelector) leaders() map[uint64]int {
+	leaders := make(map[uint64]int)
+	if s.getLeaders != nil {
+		for id, n := range s.getLeaders() {
+			leaders[id] = n
+		}
+	}
+	return leaders
+}
+
+func availableVolumes(volumes []server.Instance, size int64, excluded []vanus.ID) []server.Instance {
+	candidates := make([]server.Instance, 0, len(volumes))
+	for _, v := range volumes {
+		md := v.GetMeta()
+		if md.Draining || containsID(excluded, md.ID) || freeCapacity(md) < size {
+			continue
+		}
+		candidates = append(candidates, v)
+	}
+	return candidates
+}
+
+// freeCapacity returns the free capacity of volume, the capacity is unlimited if it's unknown.
+func freeCapacity(md *metadata.VolumeMetadata) int64 {
+	if md.Capacity <= 0 {
+		return math.MaxInt64
+	}
+	return md.Capacity - md.Used
+}
+
+// placement is the cost to place a replica on a volume, the lower the better.
+type placement struct {
+	zone, rack, host int
+	leaders          int
+	free             int64
+	id               uint64
+}
+
+func (p placement) less(o placement) bool {
+	switch {
+	case p.zone != o.zone:
+		return p.zone < o.zone
+	case p.rack != o.rack:
+		return p.rack < o.rack
+	case p.host != o.host:
+		return p.host < o.host
+	case p.leaders != o.leaders:
+		return p.leaders < o.leaders
+	case p.free != o.free:
+		return p.free > o.free
+	default:
+		return p.id < o.id
+	}
+}
+
+// bestPlacement returns index of the candidate which shares the fewest failure domains with placed.
+func bestPlacement(candidates, placed []server.Instance, leaders map[uint64]int) int {
+	best, bestIdx := placement{}, -1
+	for idx, c := range candidates {
+		md := c.GetMeta()
+		p := placement{
+			leaders: leaders[md.ID.Uint64()],
+			free:    freeCapacity(md),
+			id:      md.ID.Uint64(),
+		}
+		for _, v := range placed {
+			t := v.GetMeta().Topology
+			if md.Topology.Zone != "" && md.Topology.Zone == t.Zone {
+				p.zone++
+				if md.Topology.Rack != "" && md.Topology.Rack == t.Rack {
+					p.rack++
+				}
+			}
+			if md.Topology.Host != "" && md.Topology.Host == t.Host {
+				p.host++
+			}
+		}
+		if bestIdx < 0 || p.less(best) {
+			best, bestIdx = p, idx
+		}
+	}
+	return bestIdx
+}
+
+func volumeIDs(instances []server.Instance) []vanus.ID {
+	ids := make([]vanus.ID, len(instances))
+	for idx, ins := range instances {
+		ids[idx] = ins.GetMeta().ID
+	}
+	return ids
+}
@@ -0,0 +1,120 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package block
+
+import (
+	// standard libraries.
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+
+	// first-party libraries.
+	vanus "github.com/vanus-labs/vanus/api/vsr"
+
+	// this project.
+	"github.com/vanus-labs/vanus/server/controller/eventbus/metadata"
+	"github.com/vanus-labs/vanus/server/controller/eventbus/server"
+)
+
+func TestNewVolumeTopologyAware(t *testing.T) {
+	Convey("test topology-aware selector", t, func() {
+		ctrl := gomock.NewController(t)
+		newInstance := func(id uint64, zone, rack, host string, capacity int64) server.Instance {
+			ins := server.NewMockInstance(ctrl)
+			ins.EXPECT().GetMeta().Return(&metadata.VolumeMetadata{
+				ID:       vanus.NewIDFromUint64(id),
+				Capacity: capacity,
+				Topology: metadata.Topology{Zone: zone, Rack: rack, Host: host},
+			}).AnyTimes()
+			ins.EXPECT().ID().Return(vanus.NewIDFromUint64(id)).AnyTimes()
+			return ins
+		}
+		idsOf := func(instances []server.Instance) []uint64 {
+			ids := make([]uint64, len(instances))
+			for idx := range instances {
+				ids[idx] = instances[idx].GetMeta().ID.Uint64()
+			}
+			return ids
+		}
+
+		leaders := map[uint64]int{}
+		srvs := []server.Instance{
+			newInstance(1, "z1", "r1", "h1", 0),
+			newInstance(2, "z1", "r1", "h2", 0),
+			newInstance(3, "z1", "r2", "h3", 0),
+			newInstance(4, "z2", "r3", "h4", 0),
+			newInstance(5, "z3", "r4", "h5", 1024),
+		}
+		selector := NewVolumeTopologyAware(func() []server.Instance {
+			return srvs
+		}, func() map[uint64]int {
+			return leaders
+		})
+
+		Convey("test spread replicas across zones", func() {
+			instances := selector.Select(3, 64)
+			So(idsOf(instances), ShouldResemble, []uint64{1, 4, 5})
+
+			leaders[1] = 2
+			instances = selector.Select(3, 64)
+			So(idsOf(instances), ShouldResemble, []uint64{2, 4, 5})
+			So(leaders[2], ShouldEqual, 0)
+		})
+
+		Convey("test skip volumes without enough capacity", func() {
+			instances := selector.Select(3, 2048)
+			So(idsOf(instances), ShouldResemble, []uint64{1, 4, 3})
+
+			instances = selector.Select(5, 2048)
+			So(idsOf(instances), ShouldResemble, []uint64{1, 4, 3, 2, 4})
+		})
+
+		Convey("test select except", func() {
+			ins := selector.SelectExcept(64, []vanus.ID{vanus.NewIDFromUint64(4), vanus.NewIDFromUint64(5)})
+			So(ins.GetMeta().ID.Uint64(), ShouldEqual, uint64(1))
+
+			ins = selector.SelectExcept(64, []vanus.ID{vanus.NewIDFromUint64(1), vanus.NewIDFromUint64(4)})
+			So(ins.GetMeta().ID.Uint64(), ShouldEqual, uint64(5))
+
+			ins = selector.SelectExcept(2048, []vanus.ID{vanus.NewIDFromUint64(1), vanus.NewIDFromUint64(4)})
+			So(ins.GetMeta().ID.Uint64(), ShouldEqual, uint64(3))
+
+			ins = selector.SelectExcept(2048, []vanus.ID{
+				vanus.NewIDFromUint64(1), vanus.NewIDFromUint64(2),
+				vanus.NewIDFromUint64(3), vanus.NewIDFromUint64(4),
+			})
+			So(ins, ShouldBeNil)
+		})
+
+		Convey("test skip draining volumes", func() {
+			srvs[3].GetMeta().Draining = true
+			defer func() {
+				srvs[3].GetMeta().Draining = false
+			}()
+			instances := selector.Select(3, 64)
+			So(idsOf(instances), ShouldResemble, []uint64{1, 5, 3})
+			ins := selector.SelectExcept(64, []vanus.ID{vanus.NewIDFromUint64(1), vanus.NewIDFromUint64(5)})
+			So(ins.GetMeta().ID.Uint64(), ShouldEqual, uint64(3))
+		})
+
+		Convey("test select instance by id", func() {
+			So(selector.SelectByID(vanus.NewIDFromUint64(3)).GetMeta().ID.Uint64(), ShouldEqual, uint64(3))
+			So(selector.SelectByID(vanus.NewIDFromUint64(6)), ShouldBeNil)
+			So(selector.GetAllVolume(), ShouldHaveLength, 5)
+		})
+	})
+}
@@ -21,6 +21,7 @@ import (
 	stdErr "errors"
 	"fmt"
 	"io"
+	"net"
 	"strconv"
 	"strings"
 	"sync"
@@ -271,6 +272,12 @@ func (ctrl *controller) createEventbus(
 		return nil, errors.ErrInvalidRequest.WithMessage(fmt.Sprintf("the number of eventlog exceeded,"+
 			" maximum is %d", maximumEventlogNum))
 	}
+	if err := validateRetention(req.Retention); err != nil {
+		return nil, err
+	}
+	if err := validateCompressAlgorithm(req.CompressAlgorithm); err != nil {
+		return nil, err
+	}
 	ebExist, err := ctrl.isEventbusExist(ctx, req)
 	if err != nil {
 		return nil, err
@@ -287,14 +294,16 @@ func (ctrl *controller) createEventbus(
 		}
 	}
 	eb := &metadata.Eventbus{
-		ID:          id,
-		Name:        req.Name,
-		LogNumber:   int(logNum),
-		Eventlogs:   make([]*metadata.Eventlog, int(logNum)),
-		Description: req.Description,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
-		NamespaceID: req.NamespaceId,
+		ID:                id,
+		Name:              req.Name,
+		LogNumber:         int(logNum),
+		Eventlogs:         make([]*metadata.Eventlog, int(logNum)),
+		Description:       req.Description,
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+		NamespaceID:       req.NamespaceId,
+		Retention:         metadata.NewRetention(req.Retention),
+		CompressAlgorithm: req.CompressAlgorithm,
 	}
 	exist, err := ctrl.kvStore.Exists(ctx, metadata.GetEventbusMetadataKey(id))
 	if err != nil {
@@ -320,10 +329,32 @@ func (ctrl *controller) createEventbus(
 
 	ctrl.eventbusMap[eb.ID] = eb
 	ctrl.eventbusNamespaceMapping.Store(GetMappingKey(eb.NamespaceID, eb.Name), eb)
+	ctrl.eventlogMgr.SetRetention(eb.ID, eb.Retention)
+	ctrl.eventlogMgr.SetCompressAlgorithm(eb.ID, eb.CompressAlgorithm)
 
 	return ctrl.getEventbus(eb.ID)
 }
 
+func validateRetention(retention *metapb.RetentionPolicy) error {
+	if retention == nil {
+		return nil
+	}
+	if retention.MaxAgeSeconds < 0 {
+		return errors.ErrInvalidRequest.WithMessage("the max age of retention can't be negative")
+	}
+	if retention.MaxBytes < 0 {
+		return errors.ErrInvalidRequest.WithMessage("the max bytes of retention can't be negative")
+	}
+	return nil
+}
+
+func validateCompressAlgorithm(algo metapb.CompressAlgorithm) error {
+	if _, ok := metapb.CompressAlgorithm_name[int32(algo)]; !ok {
+		return errors.ErrInvalidRequest.WithMessage(fmt.Sprintf("unknown compress algorithm %d", algo))
+	}
+	return nil
+}
+
 func (ctrl *controller) getDeadLetterEventbusID(_ context.Context, id vanus.ID) vanus.ID {
 	deadLetterEventbusName := primitive.GetDeadLetterEventbusName(id)
 	for _id, eb := range ctrl.eventbusMap {
@@ -368,6 +399,8 @@ func (ctrl *controller) deleteEventbus(ctx context.Context, id vanus.ID) error {
 	// TODO(wenfeng.wang) notify gateway to cut flow
 	delete(ctrl.eventbusMap, id)
 	ctrl.eventbusNamespaceMapping.Delete(GetMappingKey(bus.NamespaceID, bus.Name))
+	ctrl.eventlogMgr.SetRetention(id, nil)
+	ctrl.eventlogMgr.SetCompressAlgorithm(id, metapb.CompressAlgorithm_NONE)
 	wg := sync.WaitGroup{}
 
 	for _, v := range bus.Eventlogs {
@@ -422,10 +455,89 @@ func (ctrl *controller) ListEventbus(_ context.Context,
 }
 
 func (ctrl *controller) UpdateEventbus(
-	_ context.Context, _ *ctrlpb.UpdateEventbusRequest,
+	ctx context.Context, req *ctrlpb.UpdateEventbusRequest,
 ) (*metapb.Eventbus, error) {
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+	if !ctrl.isReady(ctx) {
+		return nil, errors.ErrResourceCanNotOp.WithMessage(
+			"the cluster isn't ready for update eventbus")
+	}
+	id := vanus.NewIDFromUint64(req.Id)
+	eb, exist := ctrl.eventbusMap[id]
+	if !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("eventbus not found")
+	}
+	if err := validateUpdateEventbus(eb, req); err != nil {
+		return nil, err
+	}
+
+	updated := *eb
+	if req.Retention != nil {
+		updated.Retention = metadata.NewRetention(req.Retention)
+	}
+	if req.Description != nil {
+		updated.Description = req.GetDescription()
+	}
+	if req.CompressAlgorithm != nil {
+		updated.CompressAlgorithm = req.GetCompressAlgorithm()
+	}
+	if logNum := int(req.LogNumber); logNum > eb.LogNumber {
+		logs := make([]*metadata.Eventlog, eb.LogNumber, logNum)
+		copy(logs, eb.Eventlogs)
+		for idx := eb.LogNumber; idx < logNum; idx++ {
+			el, err := ctrl.eventlogMgr.AcquireEventlog(ctx, eb.ID, eb.Name)
+			if err != nil {
+				ctrl.releaseEventlogs(ctx, logs[eb.LogNumber:])
+				return nil, err
+			}
+			logs = append(logs, el)
+		}
+		updated.Eventlogs = logs
+		updated.LogNumber = logNum
+	}
+	updated.UpdatedAt = time.Now()
+	data, _ := json.Marshal(&updated)
+	if err := ctrl.kvStore.Set(ctx, metadata.GetEventbusMetadataKey(id), data); err != nil {
+		ctrl.releaseEventlogs(ctx, updated.Eventlogs[eb.LogNumber:])
+		return nil, errors.ErrInternal.WithMessage("update eventbus metadata in kv failed").Wrap(err)
+	}
+	// eventbusNamespaceMapping holds the same pointer, so update it in place.
+	*eb = updated
+	ctrl.eventlogMgr.SetRetention(id, eb.Retention)
+	ctrl.eventlogMgr.SetCompressAlgorithm(id, eb.CompressAlgorithm)
 	atomic.AddInt64(&ctrl.eventbusUpdatedCount, 1)
-	return &metapb.Eventbus{}, nil
+	return ctrl.getEventbus(id)
+}
+
+func validateUpdateEventbus(eb *metadata.Eventbus, req *ctrlpb.UpdateEventbusRequest) error {
+	if err := validateRetention(req.Retention); err != nil {
+		return err
+	}
+	if req.CompressAlgorithm != nil {
+		if err := validateCompressAlgorithm(req.GetCompressAlgorithm()); err != nil {
+			return err
+		}
+	}
+	if req.LogNumber == 0 {
+		return nil
+	}
+	if int(req.LogNumber) < eb.LogNumber {
+		return errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("the number of eventlog can't be decreased, current is %d", eb.LogNumber))
+	}
+	if req.LogNumber > maximumEventlogNum {
+		return errors.ErrInvalidRequest.WithMessage(fmt.Sprintf("the number of eventlog exceeded,"+
+			" maximum is %d", maximumEventlogNum))
+	}
+	return nil
+}
+
+// releaseEventlogs deletes eventlogs acquired by an unfinished update.
+func (ctrl *controller) releaseEventlogs(ctx context.Context, logs []*metadata.Eventlog) {
+	for _, el := range logs {
+		ctrl.eventlogMgr.DeleteEventlog(ctx, el.ID)
+	}
 }
 
 func (ctrl *controller) ListSegment(
@@ -454,19 +566,23 @@ func (ctrl *controller) RegisterSegmentServer(
 		return nil, err
 	}
 
+	topology := volumeTopology(req)
 	var volInstance server.Instance
-	// need to compare metadata if existed?
 	volInstance = ctrl.volumeMgr.GetVolumeInstanceByID(vanus.NewIDFromUint64(req.VolumeId))
 	if volInstance == nil {
 		volMD := &metadata.VolumeMetadata{
-			ID:     vanus.NewIDFromUint64(req.VolumeId),
-			Blocks: map[uint64]*metadata.Block{},
+			ID:       vanus.NewIDFromUint64(req.VolumeId),
+			Capacity: int64(req.Capacity),
+			Blocks:   map[uint64]*metadata.Block{},
+			Topology: topology,
 		}
 		_volInstance, err := ctrl.volumeMgr.RegisterVolume(ctx, volMD)
 		if err != nil {
 			return nil, err
 		}
 		volInstance = _volInstance
+	} else if err = ctrl.volumeMgr.UpdateVolume(ctx, volInstance.ID(), int64(req.Capacity), topology); err != nil {
+		return nil, err
 	}
 
 	segments := make(map[uint64]*metapb.Segment)
@@ -497,6 +613,23 @@ func (ctrl *controller) RegisterSegmentServer(
 	}, nil
 }
 
+// volumeTopology returns failure domains of the volume, the host of its address is used if the host
+// label isn't specified.
+func volumeTopology(req *ctrlpb.RegisterSegmentServerRequest) metadata.Topology {
+	var topology metadata.Topology
+	if t := req.GetTopology(); t != nil {
+		topology.Zone = t.Zone
+		topology.Rack = t.Rack
+		topology.Host = t.Host
+	}
+	if topology.Host == "" {
+		if host, _, err := net.SplitHostPort(req.Address); err == nil {
+			topology.Host = host
+		}
+	}
+	return topology
+}
+
 func (ctrl *controller) UnregisterSegmentServer(ctx context.Context,
 	req *ctrlpb.UnregisterSegmentServerRequest,
 ) (*ctrlpb.UnregisterSegmentServerResponse, error) {
@@ -581,6 +714,9 @@ func (ctrl *controller) processHeartbeat(ctx context.Context, req *ctrlpb.Segmen
 	} else {
 		srv.Polish()
 	}
+	if ins := ctrl.volumeMgr.GetVolumeInstanceByID(vanus.NewIDFromUint64(req.VolumeId)); ins != nil {
+		ins.Polish()
+	}
 	segments := make(map[string][]eventlog.Segment)
 	for _, info := range req.HealthInfo {
 		blockID := vanus.NewIDFromUint64(info.Id)
@@ -588,6 +724,22 @@ func (ctrl *controller) processHeartbeat(ctx context.Context, req *ctrlpb.Segmen
 		if block == nil {
 			continue
 		}
+		if info.Leader == info.Id {
+			// Leader reports may be lost, keep the leader distribution up to date with heartbeats.
+			if err = ctrl.eventlogMgr.UpdateSegmentReplicas(ctx, blockID, info.Term); err != nil {
+				log.Debug(ctx).Err(err).
+					Stringer("block_id", blockID).
+					Uint64("term", info.Term).
+					Msg("update leader of segment failed")
+			}
+		}
+		if info.Offloaded && !block.Offloaded {
+			if err = ctrl.volumeMgr.MarkBlockOffloaded(ctx, block); err != nil {
+				log.Warn(ctx).Err(err).
+					Stringer("block_id", blockID).
+					Msg("mark block offloaded failed")
+			}
+		}
 		if info.Size == 0 {
 			continue
 		}
@@ -677,6 +829,57 @@ func (ctrl *controller) ReportSegmentLeader(
 	return &emptypb.Empty{}, nil
 }
 
+func (ctrl *controller) RebalanceLeaders(
+	ctx context.Context, _ *emptypb.Empty,
+) (*ctrlpb.RebalanceLeadersResponse, error) {
+	if !ctrl.member.IsLeader() {
+		return nil, errors.ErrNotLeader
+	}
+
+	transfers, err := ctrl.eventlogMgr.RebalanceLeaders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ctrlpb.RebalanceLeadersResponse{
+		Transfers: make([]*ctrlpb.LeaderTransfer, 0, len(transfers)),
+	}
+	for _, t := range transfers {
+		resp.Transfers = append(resp.Transfers, &ctrlpb.LeaderTransfer{
+			SegmentId:    t.SegmentID.Uint64(),
+			FromBlockId:  t.From.Uint64(),
+			ToBlockId:    t.To.Uint64(),
+			FromVolumeId: t.FromVolume.Uint64(),
+			ToVolumeId:   t.ToVolume.Uint64(),
+		})
+	}
+	return resp, nil
+}
+
+func (ctrl *controller) DecommissionVolume(
+	ctx context.Context, req *ctrlpb.DecommissionVolumeRequest,
+) (*ctrlpb.DecommissionVolumeResponse, error) {
+	if !ctrl.member.IsLeader() {
+		return nil, errors.ErrNotLeader
+	}
+
+	id := vanus.NewIDFromUint64(req.VolumeId)
+	if err := ctrl.volumeMgr.SetDraining(ctx, id, !req.Cancel); err != nil {
+		return nil, err
+	}
+	remaining := ctrl.eventlogMgr.CountBlocksOnVolume(id)
+	log.Info(ctx).
+		Uint64("volume_id", req.VolumeId).
+		Bool("draining", !req.Cancel).
+		Int("remaining_blocks", remaining).
+		Msg("decommission volume")
+	return &ctrlpb.DecommissionVolumeResponse{
+		VolumeId:        req.VolumeId,
+		Draining:        !req.Cancel,
+		RemainingBlocks: uint32(remaining),
+		Completed:       !req.Cancel && remaining == 0,
+	}, nil
+}
+
 func (ctrl *controller) recordMetrics() {
 	t := time.NewTicker(time.Second)
 	defer t.Stop()
@@ -760,6 +963,8 @@ func (ctrl *controller) loadEventbus(ctx context.Context) error {
 		}
 		ctrl.eventbusMap[busInfo.ID] = busInfo
 		ctrl.eventbusNamespaceMapping.Store(GetMappingKey(busInfo.NamespaceID, busInfo.Name), busInfo)
+		ctrl.eventlogMgr.SetRetention(busInfo.ID, busInfo.Retention)
+		ctrl.eventlogMgr.SetCompressAlgorithm(busInfo.ID, busInfo.CompressAlgorithm)
 	}
 	return nil
 }
@@ -19,9 +19,11 @@ import (
 	"fmt"
 	"sort"
 	"testing"
+	"time"
 
 	. "github.com/smartystreets/goconvey/convey"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/vanus-labs/vanus/api/cluster"
@@ -34,6 +36,7 @@ import (
 	"github.com/vanus-labs/vanus/pkg/snowflake"
 	"github.com/vanus-labs/vanus/server/controller/eventbus/eventlog"
 	"github.com/vanus-labs/vanus/server/controller/eventbus/metadata"
+	"github.com/vanus-labs/vanus/server/controller/eventbus/volume"
 	"github.com/vanus-labs/vanus/server/controller/member"
 )
 
@@ -89,6 +92,8 @@ func TestController_CreateEventbus(t *testing.T) {
 				el.SegmentNumber = 2
 				return el, nil
 			})
+			elMgr.EXPECT().SetRetention(gomock.Any(), gomock.Any()).Times(2)
+			elMgr.EXPECT().SetCompressAlgorithm(gomock.Any(), metapb.CompressAlgorithm_NONE).Times(2)
 
 			snowflake.InitializeFake()
 			res, err := ctrl.CreateEventbus(ctx, &ctrlpb.CreateEventbusRequest{
@@ -110,6 +115,83 @@ func TestController_CreateEventbus(t *testing.T) {
 			So(exist, ShouldBeTrue)
 		})
 
+		Convey("test create a eventbus with retention", func() {
+			mockNS.EXPECT().GetNamespace(ctx, defaultNS.Uint64()).Times(2).Return(&metapb.Namespace{
+				Id:   defaultNS.Uint64(),
+				Name: "default",
+			}, nil)
+			res, err := ctrl.CreateEventbus(ctx, &ctrlpb.CreateEventbusRequest{
+				Name:        "test-1",
+				NamespaceId: defaultNS.Uint64(),
+				Retention:   &metapb.RetentionPolicy{MaxAgeSeconds: -1},
+			})
+			So(err, ShouldNotBeNil)
+			So(res, ShouldBeNil)
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			mockNS.EXPECT().GetSystemNamespace(ctx).Times(1).Return(&metapb.Namespace{
+				Id:   systemNS.Uint64(),
+				Name: "vanus-system",
+			}, nil)
+			kvCli.EXPECT().Exists(ctx, gomock.Any()).Times(2).Return(false, nil)
+			kvCli.EXPECT().Set(ctx, gomock.Any(), gomock.Any()).Times(2).Return(nil)
+			elMgr.EXPECT().AcquireEventlog(ctx, gomock.Any(), gomock.Any()).Times(2).Return(&metadata.Eventlog{
+				ID: snowflake.NewTestID(),
+			}, nil)
+			retention := &metadata.Retention{MaxAge: time.Hour, MaxBytes: 1024}
+			elMgr.EXPECT().SetRetention(gomock.Any(), retention).Times(1)
+			elMgr.EXPECT().SetRetention(gomock.Any(), nil).Times(1)
+			elMgr.EXPECT().SetCompressAlgorithm(gomock.Any(), metapb.CompressAlgorithm_NONE).Times(2)
+
+			snowflake.InitializeFake()
+			res, err = ctrl.CreateEventbus(ctx, &ctrlpb.CreateEventbusRequest{
+				Name:        "test-1",
+				NamespaceId: defaultNS.Uint64(),
+				Retention:   &metapb.RetentionPolicy{MaxAgeSeconds: 3600, MaxBytes: 1024},
+			})
+			So(err, ShouldBeNil)
+			So(res.Retention.MaxAgeSeconds, ShouldEqual, 3600)
+			So(res.Retention.MaxBytes, ShouldEqual, 1024)
+			So(ctrl.eventbusMap[vanus.NewIDFromUint64(res.Id)].Retention, ShouldResemble, retention)
+		})
+
+		Convey("test create a eventbus with compress algorithm", func() {
+			mockNS.EXPECT().GetNamespace(ctx, defaultNS.Uint64()).Times(2).Return(&metapb.Namespace{
+				Id:   defaultNS.Uint64(),
+				Name: "default",
+			}, nil)
+			res, err := ctrl.CreateEventbus(ctx, &ctrlpb.CreateEventbusRequest{
+				Name:              "test-1",
+				NamespaceId:       defaultNS.Uint64(),
+				CompressAlgorithm: metapb.CompressAlgorithm(100),
+			})
+			So(err, ShouldNotBeNil)
+			So(res, ShouldBeNil)
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			mockNS.EXPECT().GetSystemNamespace(ctx).Times(1).Return(&metapb.Namespace{
+				Id:   systemNS.Uint64(),
+				Name: "vanus-system",
+			}, nil)
+			kvCli.EXPECT().Exists(ctx, gomock.Any()).Times(2).Return(false, nil)
+			kvCli.EXPECT().Set(ctx, gomock.Any(), gomock.Any()).Times(2).Return(nil)
+			elMgr.EXPECT().AcquireEventlog(ctx, gomock.Any(), gomock.Any()).Times(2).Return(&metadata.Eventlog{
+				ID: snowflake.NewTestID(),
+			}, nil)
+			elMgr.EXPECT().SetRetention(gomock.Any(), nil).Times(2)
+			elMgr.EXPECT().SetCompressAlgorithm(gomock.Any(), metapb.CompressAlgorithm_ZSTD).Times(1)
+			elMgr.EXPECT().SetCompressAlgorithm(gomock.Any(), metapb.CompressAlgorithm_NONE).Times(1)
+
+			snowflake.InitializeFake()
+			res, err = ctrl.CreateEventbus(ctx, &ctrlpb.CreateEventbusRequest{
+				Name:              "test-1",
+				NamespaceId:       defaultNS.Uint64(),
+				CompressAlgorithm: metapb.CompressAlgorithm_ZSTD,
+			})
+			So(err, ShouldBeNil)
+			So(res.CompressAlgorithm, ShouldEqual, metapb.CompressAlgorithm_ZSTD)
+		})
+
 		Convey("test create a eventbus but exist", func() {
 			mockNS.EXPECT().GetNamespace(ctx, defaultNS.Uint64()).Times(1).Return(&metapb.Namespace{
 				Id:   defaultNS.Uint64(),
@@ -193,6 +275,8 @@ func TestController_DeleteEventbus(t *testing.T) {
 
 			elMgr.EXPECT().DeleteEventlog(ctx, md.Eventlogs[0].ID).Times(1)
 			elMgr.EXPECT().DeleteEventlog(ctx, md.Eventlogs[1].ID).Times(1)
+			elMgr.EXPECT().SetRetention(md.ID, nil).Times(1)
+			elMgr.EXPECT().SetCompressAlgorithm(md.ID, metapb.CompressAlgorithm_NONE).Times(1)
 
 			ctrl.eventbusMap[md.ID] = md
 			_, err := ctrl.DeleteEventbus(stdCtx.Background(), &wrapperspb.UInt64Value{
@@ -205,3 +289,243 @@ func TestController_DeleteEventbus(t *testing.T) {
 		})
 	})
 }
+
+func TestController_UpdateEventbus(t *testing.T) {
+	Convey("test update a eventbus", t, func() {
+		cfg := Config{}
+		ctrl := NewController(cfg, nil)
+		mockCtrl := gomock.NewController(t)
+		kvCli := kv.NewMockClient(mockCtrl)
+		ctrl.kvStore = kvCli
+		elMgr := eventlog.NewMockManager(mockCtrl)
+		ctrl.eventlogMgr = elMgr
+		ctx := stdCtx.Background()
+
+		mockMember := member.NewMockMember(mockCtrl)
+		ctrl.member = mockMember
+		mockMember.EXPECT().IsLeader().AnyTimes().Return(true)
+		mockMember.EXPECT().IsReady().AnyTimes().Return(true)
+		mockMember.EXPECT().GetLeaderAddr().AnyTimes().Return("test")
+
+		md := &metadata.Eventbus{
+			ID:        snowflake.NewTestID(),
+			Name:      "test-1",
+			LogNumber: 1,
+			Eventlogs: []*metadata.Eventlog{{ID: snowflake.NewTestID()}},
+		}
+		ctrl.eventbusMap[md.ID] = md
+
+		Convey("updating a doesn't exist eventbus", func() {
+			res, err := ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{
+				Id: snowflake.NewTestID().Uint64(),
+			})
+			So(err, ShouldNotBeNil)
+			So(res, ShouldBeNil)
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+		})
+
+		Convey("updating with invalid retention", func() {
+			res, err := ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{
+				Id:        md.ID.Uint64(),
+				Retention: &metapb.RetentionPolicy{MaxBytes: -1},
+			})
+			So(err, ShouldNotBeNil)
+			So(res, ShouldBeNil)
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+
+		Convey("updating retention, but kv error", func() {
+			kvCli.EXPECT().Set(ctx, metadata.GetEventbusMetadataKey(md.ID), gomock.Any()).Times(1).
+				Return(fmt.Errorf("test"))
+			res, err := ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{
+				Id:        md.ID.Uint64(),
+				Retention: &metapb.RetentionPolicy{},
+			})
+			So(err, ShouldNotBeNil)
+			So(res, ShouldBeNil)
+			So(md.Retention, ShouldBeNil)
+		})
+
+		Convey("updating retention success", func() {
+			kvCli.EXPECT().Set(ctx, metadata.GetEventbusMetadataKey(md.ID), gomock.Any()).Times(1).Return(nil)
+			elMgr.EXPECT().SetRetention(md.ID, &metadata.Retention{}).Times(1)
+			elMgr.EXPECT().SetCompressAlgorithm(md.ID, metapb.CompressAlgorithm_NONE).Times(1)
+			res, err := ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{
+				Id:        md.ID.Uint64(),
+				Retention: &metapb.RetentionPolicy{},
+			})
+			So(err, ShouldBeNil)
+			So(res.Retention, ShouldNotBeNil)
+			So(md.Retention.IsInfinite(), ShouldBeTrue)
+		})
+
+		Convey("updating compress algorithm", func() {
+			invalid := metapb.CompressAlgorithm(100)
+			_, err := ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{
+				Id:                md.ID.Uint64(),
+				CompressAlgorithm: &invalid,
+			})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			kvCli.EXPECT().Set(ctx, metadata.GetEventbusMetadataKey(md.ID), gomock.Any()).Times(1).Return(nil)
+			elMgr.EXPECT().SetRetention(md.ID, nil).Times(1)
+			elMgr.EXPECT().SetCompressAlgorithm(md.ID, metapb.CompressAlgorithm_LZ4).Times(1)
+			algo := metapb.CompressAlgorithm_LZ4
+			res, err := ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{
+				Id:                md.ID.Uint64(),
+				CompressAlgorithm: &algo,
+			})
+			So(err, ShouldBeNil)
+			So(res.CompressAlgorithm, ShouldEqual, metapb.CompressAlgorithm_LZ4)
+			So(md.CompressAlgorithm, ShouldEqual, metapb.CompressAlgorithm_LZ4)
+		})
+
+		Convey("updating description", func() {
+			kvCli.EXPECT().Set(ctx, metadata.GetEventbusMetadataKey(md.ID), gomock.Any()).Times(1).Return(nil)
+			elMgr.EXPECT().SetRetention(md.ID, nil).Times(1)
+			elMgr.EXPECT().SetCompressAlgorithm(md.ID, metapb.CompressAlgorithm_NONE).Times(1)
+			desc := "updated"
+			res, err := ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{
+				Id:          md.ID.Uint64(),
+				Description: &desc,
+			})
+			So(err, ShouldBeNil)
+			So(res.Description, ShouldEqual, desc)
+			So(md.Description, ShouldEqual, desc)
+		})
+
+		Convey("updating log number", func() {
+			Convey("decrease or exceed the maximum", func() {
+				md.LogNumber = 2
+				md.Eventlogs = append(md.Eventlogs, &metadata.Eventlog{ID: snowflake.NewTestID()})
+				_, err := ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{
+					Id:        md.ID.Uint64(),
+					LogNumber: 1,
+				})
+				So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+				_, err = ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{
+					Id:        md.ID.Uint64(),
+					LogNumber: maximumEventlogNum + 1,
+				})
+				So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+				So(md.Eventlogs, ShouldHaveLength, 2)
+			})
+
+			Convey("increase success", func() {
+				el1 := &metadata.Eventlog{ID: snowflake.NewTestID()}
+				el2 := &metadata.Eventlog{ID: snowflake.NewTestID()}
+				elMgr.EXPECT().AcquireEventlog(ctx, md.ID, md.Name).Times(1).Return(el1, nil)
+				elMgr.EXPECT().AcquireEventlog(ctx, md.ID, md.Name).Times(1).Return(el2, nil)
+				kvCli.EXPECT().Set(ctx, metadata.GetEventbusMetadataKey(md.ID), gomock.Any()).Times(1).Return(nil)
+				elMgr.EXPECT().SetRetention(md.ID, nil).Times(1)
+				elMgr.EXPECT().SetCompressAlgorithm(md.ID, metapb.CompressAlgorithm_NONE).Times(1)
+				res, err := ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{
+					Id:        md.ID.Uint64(),
+					LogNumber: 3,
+				})
+				So(err, ShouldBeNil)
+				So(res.LogNumber, ShouldEqual, 3)
+				So(res.Logs, ShouldHaveLength, 3)
+				So(md.Eventlogs[1], ShouldEqual, el1)
+				So(md.Eventlogs[2], ShouldEqual, el2)
+			})
+
+			Convey("increase, but acquire eventlog failed", func() {
+				el1 := &metadata.Eventlog{ID: snowflake.NewTestID()}
+				elMgr.EXPECT().AcquireEventlog(ctx, md.ID, md.Name).Times(1).Return(el1, nil)
+				elMgr.EXPECT().AcquireEventlog(ctx, md.ID, md.Name).Times(1).Return(nil, fmt.Errorf("test"))
+				elMgr.EXPECT().DeleteEventlog(ctx, el1.ID).Times(1)
+				_, err := ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{
+					Id:        md.ID.Uint64(),
+					LogNumber: 3,
+				})
+				So(err, ShouldNotBeNil)
+				So(md.LogNumber, ShouldEqual, 1)
+				So(md.Eventlogs, ShouldHaveLength, 1)
+			})
+		})
+	})
+}
+
+func TestController_DecommissionVolume(t *testing.T) {
+	Convey("test decommission a volume", t, func() {
+		ctrl := NewController(Config{}, nil)
+		mockCtrl := gomock.NewController(t)
+		elMgr := eventlog.NewMockManager(mockCtrl)
+		ctrl.eventlogMgr = elMgr
+		volMgr := volume.NewMockManager(mockCtrl)
+		ctrl.volumeMgr = volMgr
+		ctx := stdCtx.Background()
+
+		mockMember := member.NewMockMember(mockCtrl)
+		ctrl.member = mockMember
+		mockMember.EXPECT().IsLeader().AnyTimes().Return(true)
+
+		id := vanus.NewIDFromUint64(1)
+		Convey("test volume not found", func() {
+			volMgr.EXPECT().SetDraining(ctx, id, true).Return(errors.ErrVolumeInstanceNotFound)
+			_, err := ctrl.DecommissionVolume(ctx, &ctrlpb.DecommissionVolumeRequest{VolumeId: 1})
+			So(errors.Is(err, errors.ErrVolumeInstanceNotFound), ShouldBeTrue)
+		})
+
+		Convey("test report progress", func() {
+			volMgr.EXPECT().SetDraining(ctx, id, true).Times(2).Return(nil)
+			elMgr.EXPECT().CountBlocksOnVolume(id).Return(3)
+			res, err := ctrl.DecommissionVolume(ctx, &ctrlpb.DecommissionVolumeRequest{VolumeId: 1})
+			So(err, ShouldBeNil)
+			So(res.Draining, ShouldBeTrue)
+			So(res.RemainingBlocks, ShouldEqual, 3)
+			So(res.Completed, ShouldBeFalse)
+
+			elMgr.EXPECT().CountBlocksOnVolume(id).Return(0)
+			res, err = ctrl.DecommissionVolume(ctx, &ctrlpb.DecommissionVolumeRequest{VolumeId: 1})
+			So(err, ShouldBeNil)
+			So(res.Completed, ShouldBeTrue)
+		})
+
+		Convey("test cancel", func() {
+			volMgr.EXPECT().SetDraining(ctx, id, false).Return(nil)
+			elMgr.EXPECT().CountBlocksOnVolume(id).Return(0)
+			res, err := ctrl.DecommissionVolume(ctx, &ctrlpb.DecommissionVolumeRequest{VolumeId: 1, Cancel: true})
+			So(err, ShouldBeNil)
+			So(res.Draining, ShouldBeFalse)
+			So(res.Completed, ShouldBeFalse)
+		})
+	})
+}
+
+func TestController_RebalanceLeaders(t *testing.T) {
+	Convey("test rebalance leaders", t, func() {
+		ctrl := NewController(Config{}, nil)
+		mockCtrl := gomock.NewController(t)
+		elMgr := eventlog.NewMockManager(mockCtrl)
+		ctrl.eventlogMgr = elMgr
+		ctx := stdCtx.Background()
+
+		mockMember := member.NewMockMember(mockCtrl)
+		ctrl.member = mockMember
+		mockMember.EXPECT().IsLeader().AnyTimes().Return(true)
+
+		elMgr.EXPECT().RebalanceLeaders(ctx).Return([]eventlog.LeaderTransfer{{
+			SegmentID:  vanus.NewIDFromUint64(1),
+			From:       vanus.NewIDFromUint64(2),
+			To:         vanus.NewIDFromUint64(3),
+			FromVolume: vanus.NewIDFromUint64(4),
+			ToVolume:   vanus.NewIDFromUint64(5),
+		}}, nil)
+		res, err := ctrl.RebalanceLeaders(ctx, &emptypb.Empty{})
+		So(err, ShouldBeNil)
+		So(res.Transfers, ShouldHaveLength, 1)
+		So(res.Transfers[0], ShouldResemble, &ctrlpb.LeaderTransfer{
+			SegmentId:    1,
+			FromBlockId:  2,
+			ToBlockId:    3,
+			FromVolumeId: 4,
+			ToVolumeId:   5,
+		})
+
+		elMgr.EXPECT().RebalanceLeaders(ctx).Return(nil, errors.ErrResourceCanNotOp)
+		_, err = ctrl.RebalanceLeaders(ctx, &emptypb.Empty{})
+		So(err, ShouldNotBeNil)
+	})
+}
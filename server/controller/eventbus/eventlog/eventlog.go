@@ -31,6 +31,7 @@ import (
 
 	// first-party libraries.
 	"github.com/vanus-labs/vanus/api/errors"
+	metapb "github.com/vanus-labs/vanus/api/meta"
 	"github.com/vanus-labs/vanus/api/segment"
 	vanus "github.com/vanus-labs/vanus/api/vsr"
 	"github.com/vanus-labs/vanus/pkg/observability/log"
@@ -65,6 +66,18 @@ type Manager interface {
 	GetAppendableSegment(ctx context.Context, eli *metadata.Eventlog, num int) ([]Segment, error)
 	UpdateSegment(ctx context.Context, m map[string][]Segment)
 	GetSegmentByBlockID(block *metadata.Block) (Segment, error)
+	// SetRetention sets the retention policy of eventlogs which belong to the eventbus,
+	// the default policy will be applied if retention is nil.
+	SetRetention(eventbusID vanus.ID, retention *metadata.Retention)
+	// SetCompressAlgorithm sets the algorithm used to compress events of segments which will be
+	// created for the eventbus, segments already created are not affected.
+	SetCompressAlgorithm(eventbusID vanus.ID, algo metapb.CompressAlgorithm)
+	// RebalanceLeaders transfers leaderships of working segments to even out leaders across volumes,
+	// and returns the transfers which have been done.
+	RebalanceLeaders(ctx context.Context) ([]LeaderTransfer, error)
+	// CountBlocksOnVolume returns the number of replicas hosted by the volume, a draining volume can be
+	// removed safely once it becomes zero.
+	CountBlocksOnVolume(volumeID vanus.ID) int
 }
 
 var mgr = &eventlogManager{
@@ -73,6 +86,10 @@ var mgr = &eventlogManager{
 	cleanInterval:               defaultCleanInterval,
 	checkSegmentExpiredInterval: defaultCheckExpiredSegmentInterval,
 	segmentExpiredTime:          defaultSegmentExpiredTime,
+	replicationInterval:         defaultReplicationInterval,
+	volumeLostTimeout:           defaultVolumeLostTimeout,
+	learnerCatchUpTimeout:       defaultLearnerCatchUpTimeout,
+	rebalanceInterval:           defaultRebalanceInterval,
 }
 
 type eventlogManager struct {
@@ -99,6 +116,20 @@ type eventlogManager struct {
 	checkSegmentExpiredInterval time.Duration
 	segmentExpiredTime          time.Duration
 	createSegmentMutex          sync.Mutex
+	// eventbusID, *metadata.Retention
+	retentionMap sync.Map
+	// eventbusID, metapb.CompressAlgorithm
+	compressMap sync.Map
+
+	replicationInterval   time.Duration
+	volumeLostTimeout     time.Duration
+	learnerCatchUpTimeout time.Duration
+	// segmentID, *metadata.Block which is being replaced
+	replicatingSegment    sync.Map
+	replicatingSegmentNum int64
+
+	rebalanceInterval time.Duration
+	rebalanceMutex    sync.Mutex
 }
 
 // Make sure eventlogManager implements Manager.
@@ -110,7 +141,7 @@ func NewManager(volMgr volume.Manager, replicaNum uint, defaultBlockSize int64)
 		mgr.segmentReplicaNum = replicaNum
 	}
 	mgr.allocator = block.NewAllocator(defaultBlockSize,
-		block.NewVolumeRoundRobin(mgr.volMgr.GetAllActiveVolumes))
+		block.NewVolumeTopologyAware(mgr.volMgr.GetAllActiveVolumes, mgr.leaderNumberOfVolumes))
 	return mgr
 }
 
@@ -124,6 +155,18 @@ func (mgr *eventlogManager) Run(ctx context.Context, kvClient kv.Client, startTa
 	if mgr.cleanInterval == 0 {
 		mgr.cleanInterval = defaultCleanInterval
 	}
+	if mgr.replicationInterval == 0 {
+		mgr.replicationInterval = defaultReplicationInterval
+	}
+	if mgr.volumeLostTimeout == 0 {
+		mgr.volumeLostTimeout = defaultVolumeLostTimeout
+	}
+	if mgr.learnerCatchUpTimeout == 0 {
+		mgr.learnerCatchUpTimeout = defaultLearnerCatchUpTimeout
+	}
+	if mgr.rebalanceInterval == 0 {
+		mgr.rebalanceInterval = defaultRebalanceInterval
+	}
 	mgr.kvClient = kvClient
 	if err := mgr.allocator.Run(ctx, mgr.kvClient, true); err != nil {
 		return err
@@ -159,6 +202,8 @@ func (mgr *eventlogManager) Run(ctx context.Context, kvClient kv.Client, startTa
 		go mgr.dynamicScaleUpEventlog(cancelCtx)
 		go mgr.cleanAbnormalSegment(cancelCtx)
 		go mgr.checkSegmentExpired(cancelCtx)
+		go mgr.replicateLostBlocks(cancelCtx)
+		go mgr.rebalanceLeaders(cancelCtx)
 	}
 	go mgr.recordMetrics(cancelCtx)
 	return nil
@@ -418,6 +463,37 @@ func (mgr *eventlogManager) GetSegmentByBlockID(block *metadata.Block) (Segment,
 	return el.get(block.SegmentID).Copy(), nil
 }
 
+func (mgr *eventlogManager) SetRetention(eventbusID vanus.ID, retention *metadata.Retention) {
+	if retention == nil {
+		mgr.retentionMap.Delete(eventbusID.Key())
+		return
+	}
+	r := *retention
+	mgr.retentionMap.Store(eventbusID.Key(), &r)
+}
+
+func (mgr *eventlogManager) getRetention(eventbusID vanus.ID) *metadata.Retention {
+	if v, ok := mgr.retentionMap.Load(eventbusID.Key()); ok {
+		return v.(*metadata.Retention)
+	}
+	return &metadata.Retention{MaxAge: mgr.segmentExpiredTime}
+}
+
+func (mgr *eventlogManager) SetCompressAlgorithm(eventbusID vanus.ID, algo metapb.CompressAlgorithm) {
+	if algo == metapb.CompressAlgorithm_NONE {
+		mgr.compressMap.Delete(eventbusID.Key())
+		return
+	}
+	mgr.compressMap.Store(eventbusID.Key(), algo)
+}
+
+func (mgr *eventlogManager) getCompressAlgorithm(eventbusID vanus.ID) metapb.CompressAlgorithm {
+	if v, ok := mgr.compressMap.Load(eventbusID.Key()); ok {
+		return v.(metapb.CompressAlgorithm)
+	}
+	return metapb.CompressAlgorithm_NONE
+}
+
 func (mgr *eventlogManager) stop() {
 	mgr.cancel()
 }
@@ -597,7 +673,18 @@ func (mgr *eventlogManager) checkSegmentExpired(ctx context.Context) {
 			executionID := uuid.NewString()
 			mgr.eventlogMap.Range(func(key, value interface{}) bool {
 				elog, _ := value.(*eventlog)
+				retention := mgr.getRetention(elog.md.EventbusID)
+				if retention.IsInfinite() {
+					return true
+				}
+				var totalSize int64
+				if retention.MaxBytes > 0 {
+					for _, seg := range elog.getAllSegments() {
+						totalSize += seg.Size
+					}
+				}
 				for head, next := elog.headAndNext(); head != nil; head, next = elog.headAndNext() {
+					var reason string
 					switch {
 					case !head.isFull() || next == nil:
 						return true
@@ -607,35 +694,41 @@ func (mgr *eventlogManager) checkSegmentExpired(ctx context.Context) {
 					case head.LastEventBornTime.IsZero():
 						// LastEventBornTime must be set when mark the segment full.
 						panic("full segment has not LastEventBornTime") // unreachable
-					case time.Since(head.LastEventBornTime) > mgr.segmentExpiredTime:
-						err := elog.deleteHead(ctx)
-						if err != nil {
-							log.Warn(ctx).
-								Err(err).
-								Str("execution_id", executionID).
-								Time("last_event_time", head.LastEventBornTime).
-								Time("first_event_time", head.FirstEventBornTime).
-								Time("now", time.Now()).
-								Msg("delete segment error")
-							return true
-						}
-						count++
-						log.Info(ctx).
+					case retention.MaxAge > 0 && time.Since(head.LastEventBornTime) > retention.MaxAge:
+						reason = metrics.LabelSegmentDeletedBecauseExpired
+					case retention.MaxBytes > 0 && totalSize-head.Size >= retention.MaxBytes:
+						// the eventlog still exceeds the limitation without head.
+						reason = metrics.LabelSegmentDeletedBecauseOversize
+					default:
+						return true
+					}
+					err := elog.deleteHead(ctx)
+					if err != nil {
+						log.Warn(ctx).
+							Err(err).
 							Str("execution_id", executionID).
-							Stringer("log_id", elog.md.ID).
 							Time("last_event_time", head.LastEventBornTime).
 							Time("first_event_time", head.FirstEventBornTime).
 							Time("now", time.Now()).
-							Int32("number", head.Number).
-							Msg("delete segment success")
-						_, ok := mgr.segmentNeedBeClean.LoadOrStore(head.ID.Key(), head)
-						if !ok {
-							metrics.SegmentDeletedCounterVec.WithLabelValues(
-								metrics.LabelSegmentDeletedBecauseExpired).Inc()
-						}
-					default:
+							Msg("delete segment error")
 						return true
 					}
+					count++
+					totalSize -= head.Size
+					log.Info(ctx).
+						Str("execution_id", executionID).
+						Stringer("log_id", elog.md.ID).
+						Time("last_event_time", head.LastEventBornTime).
+						Time("first_event_time", head.FirstEventBornTime).
+						Time("now", time.Now()).
+						Int32("number", head.Number).
+						Int64("size", head.Size).
+						Str("reason", reason).
+						Msg("delete segment success")
+					_, ok := mgr.segmentNeedBeClean.LoadOrStore(head.ID.Key(), head)
+					if !ok {
+						metrics.SegmentDeletedCounterVec.WithLabelValues(reason).Inc()
+					}
 				}
 				return true
 			})
@@ -791,15 +884,16 @@ func (mgr *eventlogManager) generateSegment(ctx context.Context, el *eventlog) (
 	cur := el.currentAppendableSegment()
 	var blocks []*metadata.Block
 	var err error
+	compress := mgr.getCompressAlgorithm(el.md.EventbusID)
 	if cur == nil {
-		blocks, err = mgr.allocator.Pick(ctx, int(mgr.segmentReplicaNum))
+		blocks, err = mgr.allocator.Pick(ctx, int(mgr.segmentReplicaNum), compress)
 	} else {
 		// make sure segments of one eventlog located in one SegmentServer
 		volumes := make([]vanus.ID, 0)
 		for _, peer := range cur.Replicas.Peers {
 			volumes = append(volumes, peer.VolumeID)
 		}
-		blocks, err = mgr.allocator.PickByVolumes(ctx, volumes)
+		blocks, err = mgr.allocator.PickByVolumes(ctx, volumes, compress)
 	}
 
 	if err != nil {
@@ -829,7 +923,8 @@ func (mgr *eventlogManager) generateSegment(ctx context.Context, el *eventlog) (
 			CreateAt:  time.Now(),
 			DestroyAt: time.Now(),
 		},
-		State: StateCreated,
+		State:    StateCreated,
+		Compress: compress,
 	}
 
 	data, _ := json.Marshal(seg)
@@ -850,16 +945,7 @@ func (mgr *eventlogManager) generateSegment(ctx context.Context, el *eventlog) (
 }
 
 func (mgr *eventlogManager) whichIsLeader(raftGroup map[uint64]*metadata.Block) (*metadata.Block, error) {
-	countMap := map[uint64]int{}
-	mgr.globalSegmentMap.Range(func(key, value any) bool {
-		seg, _ := value.(*Segment)
-		if seg.State == StateWorking {
-			vID := seg.GetLeaderBlock().VolumeID.Uint64()
-			count := countMap[vID]
-			countMap[vID] = count + 1
-		}
-		return true
-	})
+	countMap := mgr.leaderNumberOfVolumes()
 
 	volumeMap := make(map[uint64]*metadata.Block, len(raftGroup))
 	orderArr := make([]uint64, 0)
@@ -875,6 +961,46 @@ func (mgr *eventlogManager) whichIsLeader(raftGroup map[uint64]*metadata.Block)
 	return volumeMap[orderArr[0]], nil
 }
 
+// CountBlocksOnVolume returns the number of Blocks on the volume which are replicas of segments.
+func (mgr *eventlogManager) CountBlocksOnVolume(volumeID vanus.ID) int {
+	count := 0
+	mgr.globalSegmentMap.Range(func(key, value any) bool {
+		seg, _ := value.(*Segment)
+		el := mgr.getEventlog(seg.EventlogID)
+		if el == nil {
+			return true
+		}
+		el.rLock()
+		defer el.rUnlock()
+		if seg.Replicas == nil {
+			return true
+		}
+		for _, blk := range seg.Replicas.Peers {
+			if blk.VolumeID == volumeID {
+				count++
+			}
+		}
+		return true
+	})
+	return count
+}
+
+// leaderNumberOfVolumes returns the number of leader Blocks of working Segments on each volume.
+func (mgr *eventlogManager) leaderNumberOfVolumes() map[uint64]int {
+	countMap := map[uint64]int{}
+	mgr.globalSegmentMap.Range(func(key, value any) bool {
+		seg, _ := value.(*Segment)
+		if seg.State != StateWorking {
+			return true
+		}
+		if leader := seg.GetLeaderBlock(); leader != nil {
+			countMap[leader.VolumeID.Uint64()]++
+		}
+		return true
+	})
+	return countMap
+}
+
 type eventlog struct {
 	// uint64, *Segment
 	segmentList *skiplist.SkipList
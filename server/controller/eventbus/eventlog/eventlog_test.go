@@ -28,6 +28,7 @@ import (
 
 	// first-party libraries.
 	"github.com/vanus-labs/vanus/api/errors"
+	metapb "github.com/vanus-labs/vanus/api/meta"
 	segpb "github.com/vanus-labs/vanus/api/segment"
 	vanus "github.com/vanus-labs/vanus/api/vsr"
 	"github.com/vanus-labs/vanus/lib/sync"
@@ -196,8 +197,8 @@ func TestEventlogManager_ScaleSegmentTask(t *testing.T) {
 		}
 		snowflake.InitializeFake()
 		alloc.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil)
-		alloc.EXPECT().Pick(gomock.Any(), 3).AnyTimes().DoAndReturn(func(
-			ctx context.Context, num int,
+		alloc.EXPECT().Pick(gomock.Any(), 3, gomock.Any()).AnyTimes().DoAndReturn(func(
+			ctx context.Context, num int, _ metapb.CompressAlgorithm,
 		) ([]*metadata.Block, error) {
 			return []*metadata.Block{
 				{
@@ -218,8 +219,8 @@ func TestEventlogManager_ScaleSegmentTask(t *testing.T) {
 			}, nil
 		})
 
-		alloc.EXPECT().PickByVolumes(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(
-			ctx context.Context, volumes []vanus.ID,
+		alloc.EXPECT().PickByVolumes(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(
+			ctx context.Context, volumes []vanus.ID, _ metapb.CompressAlgorithm,
 		) ([]*metadata.Block, error) {
 			return []*metadata.Block{
 				{
@@ -334,8 +335,8 @@ func TestEventlogManager_CleanSegmentTask(t *testing.T) {
 			Capacity: 64 * 1024 * 1024 * 1024,
 		}
 		alloc.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil)
-		alloc.EXPECT().Pick(gomock.Any(), 3).AnyTimes().DoAndReturn(func(
-			ctx context.Context, num int,
+		alloc.EXPECT().Pick(gomock.Any(), 3, gomock.Any()).AnyTimes().DoAndReturn(func(
+			ctx context.Context, num int, _ metapb.CompressAlgorithm,
 		) ([]*metadata.Block, error) {
 			return []*metadata.Block{
 				{
@@ -355,8 +356,8 @@ func TestEventlogManager_CleanSegmentTask(t *testing.T) {
 				},
 			}, nil
 		})
-		alloc.EXPECT().PickByVolumes(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(
-			ctx context.Context, volumes []vanus.ID,
+		alloc.EXPECT().PickByVolumes(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(
+			ctx context.Context, volumes []vanus.ID, _ metapb.CompressAlgorithm,
 		) ([]*metadata.Block, error) {
 			return []*metadata.Block{
 				{
@@ -452,7 +453,9 @@ func TestEventlogManager_CreateAndGetEventlog(t *testing.T) {
 			ID:       snowflake.NewTestID(),
 			Capacity: 64 * 1024 * 1024 * 1024,
 		}
-		alloc.EXPECT().Pick(ctx, 3).Times(1).DoAndReturn(func(ctx context.Context, num int) ([]*metadata.Block, error) {
+		alloc.EXPECT().Pick(ctx, 3, gomock.Any()).Times(1).DoAndReturn(func(
+			ctx context.Context, num int, _ metapb.CompressAlgorithm,
+		) ([]*metadata.Block, error) {
 			return []*metadata.Block{
 				{
 					ID:       snowflake.NewTestID(),
@@ -471,8 +474,8 @@ func TestEventlogManager_CreateAndGetEventlog(t *testing.T) {
 				},
 			}, nil
 		})
-		alloc.EXPECT().PickByVolumes(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(
-			ctx context.Context, volumes []vanus.ID,
+		alloc.EXPECT().PickByVolumes(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(
+			ctx context.Context, volumes []vanus.ID, _ metapb.CompressAlgorithm,
 		) ([]*metadata.Block, error) {
 			return []*metadata.Block{
 				{
@@ -620,7 +623,9 @@ func TestEventlogManager_GetAppendableSegment(t *testing.T) {
 			ID:       snowflake.NewTestID(),
 			Capacity: 64 * 1024 * 1024 * 1024,
 		}
-		alloc.EXPECT().Pick(ctx, 3).Times(1).DoAndReturn(func(ctx context.Context, num int) ([]*metadata.Block, error) {
+		alloc.EXPECT().Pick(ctx, 3, gomock.Any()).Times(1).DoAndReturn(func(
+			ctx context.Context, num int, _ metapb.CompressAlgorithm,
+		) ([]*metadata.Block, error) {
 			return []*metadata.Block{
 				{
 					ID:       snowflake.NewTestID(),
@@ -986,6 +991,96 @@ func Test_ExpiredSegmentDeleting(t *testing.T) {
 			So(sync.MapLen(&utMgr.segmentNeedBeClean), ShouldEqual, 3)
 		})
 
+		Convey("test retention of eventbus", func() {
+			kvCli.EXPECT().Delete(gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+			kvCli.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+
+			// limited by size
+			utMgr.SetRetention(el1.md.EventbusID, &metadata.Retention{MaxBytes: 200})
+			s11 := &Segment{
+				ID:                 snowflake.NewTestID(),
+				State:              StateFrozen,
+				Size:               100,
+				FirstEventBornTime: time.Now().Add(-3 * time.Minute),
+				LastEventBornTime:  time.Now().Add(-2 * time.Minute),
+			}
+			s12 := &Segment{
+				ID:                 snowflake.NewTestID(),
+				StartOffsetInLog:   1,
+				State:              StateFrozen,
+				Size:               100,
+				FirstEventBornTime: time.Now().Add(-2 * time.Minute),
+				LastEventBornTime:  time.Now().Add(-1 * time.Minute),
+			}
+			s13 := &Segment{
+				ID:                 snowflake.NewTestID(),
+				StartOffsetInLog:   2,
+				State:              StateWorking,
+				Size:               150,
+				FirstEventBornTime: time.Now().Add(-1 * time.Minute),
+			}
+			el1.segmentList.Set(s11.ID.Uint64(), s11)
+			el1.segmentList.Set(s12.ID.Uint64(), s12)
+			el1.segmentList.Set(s13.ID.Uint64(), s13)
+			el1.segments = []vanus.ID{s11.ID, s12.ID, s13.ID}
+
+			// never expired
+			utMgr.SetRetention(el2.md.EventbusID, &metadata.Retention{})
+			s21 := &Segment{
+				ID:                 snowflake.NewTestID(),
+				State:              StateFrozen,
+				FirstEventBornTime: time.Now().Add(-6 * time.Hour),
+				LastEventBornTime:  time.Now().Add(-3 * time.Hour),
+			}
+			s22 := &Segment{
+				ID:                 snowflake.NewTestID(),
+				StartOffsetInLog:   1,
+				State:              StateWorking,
+				FirstEventBornTime: time.Now().Add(-3 * time.Hour),
+			}
+			el2.segmentList.Set(s21.ID.Uint64(), s21)
+			el2.segmentList.Set(s22.ID.Uint64(), s22)
+			el2.segments = []vanus.ID{s21.ID, s22.ID}
+
+			// limited by age of eventbus instead of default
+			utMgr.SetRetention(el3.md.EventbusID, &metadata.Retention{MaxAge: time.Minute})
+			s31 := &Segment{
+				ID:                 snowflake.NewTestID(),
+				State:              StateFrozen,
+				FirstEventBornTime: time.Now().Add(-3 * time.Minute),
+				LastEventBornTime:  time.Now().Add(-2 * time.Minute),
+			}
+			s32 := &Segment{
+				ID:                 snowflake.NewTestID(),
+				StartOffsetInLog:   1,
+				State:              StateWorking,
+				FirstEventBornTime: time.Now().Add(-2 * time.Minute),
+			}
+			el3.segmentList.Set(s31.ID.Uint64(), s31)
+			el3.segmentList.Set(s32.ID.Uint64(), s32)
+			el3.segments = []vanus.ID{s31.ID, s32.ID}
+
+			cCtx, cancel := context.WithCancel(ctx)
+			ch := make(chan struct{})
+			go func() {
+				utMgr.checkSegmentExpired(cCtx)
+				ch <- struct{}{}
+			}()
+			time.Sleep(time.Second)
+			cancel()
+			<-ch
+
+			So(el1.segments, ShouldHaveLength, 2)
+			So(el1.segments[0], ShouldEqual, s12.ID)
+			So(el2.segments, ShouldHaveLength, 2)
+			So(el3.segments, ShouldHaveLength, 1)
+			So(el3.segments[0], ShouldEqual, s32.ID)
+			So(sync.MapLen(&utMgr.segmentNeedBeClean), ShouldEqual, 2)
+
+			utMgr.SetRetention(el1.md.EventbusID, nil)
+			So(utMgr.getRetention(el1.md.EventbusID), ShouldResemble, &metadata.Retention{MaxAge: time.Hour})
+		})
+
 		Convey("test kv error", func() {
 			kvCli.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(kv.ErrUnknown)
 			kvCli.EXPECT().Delete(gomock.Any(), gomock.Any()).AnyTimes().Return(kv.ErrUnknown)
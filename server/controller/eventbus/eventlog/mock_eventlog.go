@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	meta "github.com/vanus-labs/vanus/api/meta"
 	vsr "github.com/vanus-labs/vanus/api/vsr"
 	kv "github.com/vanus-labs/vanus/pkg/kv"
 	metadata "github.com/vanus-labs/vanus/server/controller/eventbus/metadata"
@@ -57,6 +58,20 @@ func (mr *MockManagerMockRecorder) AcquireEventlog(ctx, eventbusID, eventbusName
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireEventlog", reflect.TypeOf((*MockManager)(nil).AcquireEventlog), ctx, eventbusID, eventbusName)
 }
 
+// CountBlocksOnVolume mocks base method.
+func (m *MockManager) CountBlocksOnVolume(volumeID vsr.ID) int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountBlocksOnVolume", volumeID)
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// CountBlocksOnVolume indicates an expected call of CountBlocksOnVolume.
+func (mr *MockManagerMockRecorder) CountBlocksOnVolume(volumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountBlocksOnVolume", reflect.TypeOf((*MockManager)(nil).CountBlocksOnVolume), volumeID)
+}
+
 // DeleteEventlog mocks base method.
 func (m *MockManager) DeleteEventlog(ctx context.Context, id vsr.ID) {
 	m.ctrl.T.Helper()
@@ -141,6 +156,21 @@ func (mr *MockManagerMockRecorder) GetSegmentByBlockID(block any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSegmentByBlockID", reflect.TypeOf((*MockManager)(nil).GetSegmentByBlockID), block)
 }
 
+// RebalanceLeaders mocks base method.
+func (m *MockManager) RebalanceLeaders(ctx context.Context) ([]LeaderTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebalanceLeaders", ctx)
+	ret0, _ := ret[0].([]LeaderTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebalanceLeaders indicates an expected call of RebalanceLeaders.
+func (mr *MockManagerMockRecorder) RebalanceLeaders(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebalanceLeaders", reflect.TypeOf((*MockManager)(nil).RebalanceLeaders), ctx)
+}
+
 // Run mocks base method.
 func (m *MockManager) Run(ctx context.Context, kvClient kv.Client, startTask bool) error {
 	m.ctrl.T.Helper()
@@ -155,6 +185,30 @@ func (mr *MockManagerMockRecorder) Run(ctx, kvClient, startTask any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockManager)(nil).Run), ctx, kvClient, startTask)
 }
 
+// SetCompressAlgorithm mocks base method.
+func (m *MockManager) SetCompressAlgorithm(eventbusID vsr.ID, algo meta.CompressAlgorithm) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetCompressAlgorithm", eventbusID, algo)
+}
+
+// SetCompressAlgorithm indicates an expected call of SetCompressAlgorithm.
+func (mr *MockManagerMockRecorder) SetCompressAlgorithm(eventbusID, algo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCompressAlgorithm", reflect.TypeOf((*MockManager)(nil).SetCompressAlgorithm), eventbusID, algo)
+}
+
+// SetRetention mocks base method.
+func (m *MockManager) SetRetention(eventbusID vsr.ID, retention *metadata.Retention) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetRetention", eventbusID, retention)
+}
+
+// SetRetention indicates an expected call of SetRetention.
+func (mr *MockManagerMockRecorder) SetRetention(eventbusID, retention any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRetention", reflect.TypeOf((*MockManager)(nil).SetRetention), eventbusID, retention)
+}
+
 // Stop mocks base method.
 func (m *MockManager) Stop() {
 	m.ctrl.T.Helper()
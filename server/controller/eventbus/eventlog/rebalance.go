@@ -0,0 +1,223 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	// standard libraries.
+	"context"
+	"sort"
+	"time"
+
+	// first-party libraries.
+	"github.com/vanus-labs/vanus/api/errors"
+	"github.com/vanus-labs/vanus/api/segment"
+	vanus "github.com/vanus-labs/vanus/api/vsr"
+	"github.com/vanus-labs/vanus/pkg/observability/log"
+
+	// this project.
+	"github.com/vanus-labs/vanus/server/controller/eventbus/metadata"
+)
+
+const (
+	defaultRebalanceInterval        = 5 * time.Minute
+	defaultLeaderTransferTimeout    = 10 * time.Second
+	defaultMaximumLeaderTransfers   = 16
+	defaultLeaderImbalanceThreshold = 1
+)
+
+// LeaderTransfer describes moving the leadership of a working segment from one replica to another.
+type LeaderTransfer struct {
+	SegmentID  vanus.ID
+	From       vanus.ID
+	To         vanus.ID
+	FromVolume vanus.ID
+	ToVolume   vanus.ID
+}
+
+// rebalanceLeaders periodically evens out leaders of working segments across active volumes, since
+// all writes of a segment go through its leader, a volume hosting too many leaders becomes hot.
+func (mgr *eventlogManager) rebalanceLeaders(ctx context.Context) {
+	ticker := time.NewTicker(mgr.rebalanceInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info(ctx).Msg("the task of rebalance-leaders stopped")
+			return
+		case <-ticker.C:
+			if _, err := mgr.RebalanceLeaders(ctx); err != nil {
+				log.Warn(ctx).Err(err).Msg("rebalance leaders failed")
+			}
+		}
+	}
+}
+
+// RebalanceLeaders transfers leaderships of working segments from volumes hosting the most leaders
+// to the least ones, at most defaultMaximumLeaderTransfers segments are moved for each call.
+func (mgr *eventlogManager) RebalanceLeaders(ctx context.Context) ([]LeaderTransfer, error) {
+	if !mgr.rebalanceMutex.TryLock() {
+		return nil, errors.ErrResourceCanNotOp.WithMessage("leaders are being rebalanced")
+	}
+	defer mgr.rebalanceMutex.Unlock()
+
+	transfers := mgr.planLeaderTransfers()
+	done := make([]LeaderTransfer, 0, len(transfers))
+	for _, t := range transfers {
+		if err := mgr.transferLeadership(ctx, t); err != nil {
+			log.Warn(ctx).Err(err).
+				Stringer("segment_id", t.SegmentID).
+				Stringer("from", t.FromVolume).
+				Stringer("to", t.ToVolume).
+				Msg("transfer leadership of segment failed")
+			continue
+		}
+		done = append(done, t)
+	}
+	if len(transfers) > 0 {
+		log.Info(ctx).
+			Int("planned", len(transfers)).
+			Int("transferred", len(done)).
+			Msg("leaders have been rebalanced")
+	}
+	return done, nil
+}
+
+func (mgr *eventlogManager) planLeaderTransfers() []LeaderTransfer {
+	counts := make(map[uint64]int)
+	for _, ins := range mgr.volMgr.GetAllActiveVolumes() {
+		// Leaders on draining volumes are moved out by replication.
+		if md := ins.GetMeta(); !md.Draining {
+			counts[md.ID.Uint64()] = 0
+		}
+	}
+	if len(counts) < 2 {
+		return nil
+	}
+
+	// leader volume => segments, followers are sorted by volume id.
+	type candidate struct {
+		segment   Segment
+		leader    *metadata.Block
+		followers []*metadata.Block
+	}
+	candidates := make(map[uint64][]candidate)
+	mgr.globalSegmentMap.Range(func(key, value any) bool {
+		seg, _ := value.(*Segment)
+		if _, ok := mgr.segmentNeedBeClean.Load(key); ok {
+			return true
+		}
+		if _, ok := mgr.replicatingSegment.Load(key); ok {
+			return true
+		}
+		el := mgr.getEventlog(seg.EventlogID)
+		if el == nil {
+			return true
+		}
+		el.rLock()
+		defer el.rUnlock()
+
+		leader := seg.GetLeaderBlock()
+		if seg.State != StateWorking || leader == nil {
+			return true
+		}
+		lv := leader.VolumeID.Uint64()
+		if _, ok := counts[lv]; !ok {
+			return true
+		}
+		counts[lv]++
+		c := candidate{segment: seg.Copy(), leader: leader}
+		for _, blk := range seg.Replicas.Peers {
+			if blk.ID != leader.ID {
+				c.followers = append(c.followers, blk)
+			}
+		}
+		sort.Slice(c.followers, func(i, j int) bool { return c.followers[i].VolumeID < c.followers[j].VolumeID })
+		candidates[lv] = append(candidates[lv], c)
+		return true
+	})
+	for _, cs := range candidates {
+		sort.Slice(cs, func(i, j int) bool { return cs[i].segment.ID < cs[j].segment.ID })
+	}
+
+	volumes := make([]uint64, 0, len(counts))
+	for id := range counts {
+		volumes = append(volumes, id)
+	}
+	transfers := make([]LeaderTransfer, 0)
+	for len(transfers) < defaultMaximumLeaderTransfers && len(volumes) > 1 {
+		sort.Slice(volumes, func(i, j int) bool {
+			if counts[volumes[i]] != counts[volumes[j]] {
+				return counts[volumes[i]] > counts[volumes[j]]
+			}
+			return volumes[i] < volumes[j]
+		})
+		hot := volumes[0]
+		if counts[hot]-counts[volumes[len(volumes)-1]] <= defaultLeaderImbalanceThreshold {
+			break
+		}
+
+		// Move a leader to the least loaded follower, which must be lighter than hot by at least 2,
+		// otherwise the transfer just swaps the imbalance.
+		segIdx, target := -1, (*metadata.Block)(nil)
+		for idx, c := range candidates[hot] {
+			for _, blk := range c.followers {
+				n, active := counts[blk.VolumeID.Uint64()]
+				if !active || counts[hot]-n <= defaultLeaderImbalanceThreshold {
+					continue
+				}
+				if segIdx < 0 || n < counts[target.VolumeID.Uint64()] {
+					segIdx, target = idx, blk
+				}
+			}
+		}
+		if segIdx < 0 {
+			// No leader on hot volume can be moved, try the next one.
+			volumes = volumes[1:]
+			continue
+		}
+
+		c := candidates[hot][segIdx]
+		transfers = append(transfers, LeaderTransfer{
+			SegmentID:  c.segment.ID,
+			From:       c.leader.ID,
+			To:         target.ID,
+			FromVolume: c.leader.VolumeID,
+			ToVolume:   target.VolumeID,
+		})
+		counts[hot]--
+		counts[target.VolumeID.Uint64()]++
+		candidates[hot] = append(candidates[hot][:segIdx], candidates[hot][segIdx+1:]...)
+	}
+	return transfers
+}
+
+func (mgr *eventlogManager) transferLeadership(ctx context.Context, t LeaderTransfer) error {
+	ins := mgr.volMgr.GetVolumeInstanceByID(t.FromVolume)
+	if ins == nil {
+		return errors.ErrVolumeInstanceNotFound
+	}
+	srv := ins.GetServer()
+	if srv == nil {
+		return errors.ErrVolumeInstanceNoServer
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultLeaderTransferTimeout)
+	defer cancel()
+	_, err := srv.GetClient().TransferLeadership(ctx, &segment.TransferLeadershipRequest{
+		BlockId:      t.From.Uint64(),
+		TransfereeId: t.To.Uint64(),
+	})
+	return err
+}
@@ -0,0 +1,144 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	// standard libraries.
+	"context"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+
+	// first-party libraries.
+	"github.com/vanus-labs/vanus/api/errors"
+	segpb "github.com/vanus-labs/vanus/api/segment"
+	vanus "github.com/vanus-labs/vanus/api/vsr"
+
+	// this project.
+	"github.com/vanus-labs/vanus/pkg/kv"
+	"github.com/vanus-labs/vanus/pkg/snowflake"
+	"github.com/vanus-labs/vanus/server/controller/eventbus/metadata"
+	"github.com/vanus-labs/vanus/server/controller/eventbus/server"
+	"github.com/vanus-labs/vanus/server/controller/eventbus/volume"
+)
+
+func TestEventlogManager_RebalanceLeaders(t *testing.T) {
+	Convey("test RebalanceLeaders", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		volMgr := volume.NewMockManager(ctrl)
+		kvCli := kv.NewMockClient(ctrl)
+		utMgr := &eventlogManager{volMgr: volMgr, kvClient: kvCli}
+
+		el, err := newEventlog(ctx, &metadata.Eventlog{
+			ID:           snowflake.NewTestID(),
+			EventbusID:   snowflake.NewTestID(),
+			EventbusName: "ut",
+		}, kvCli, false)
+		So(err, ShouldBeNil)
+		utMgr.eventlogMap.Store(el.md.ID.Key(), el)
+
+		vol1, vol2, vol3, vol4 := vanus.NewIDFromUint64(1), vanus.NewIDFromUint64(2), vanus.NewIDFromUint64(3),
+			vanus.NewIDFromUint64(4)
+		instances := make([]server.Instance, 0)
+		for _, id := range []vanus.ID{vol1, vol2, vol3} {
+			ins := server.NewMockInstance(ctrl)
+			ins.EXPECT().GetMeta().AnyTimes().Return(&metadata.VolumeMetadata{ID: id})
+			instances = append(instances, ins)
+		}
+		volMgr.EXPECT().GetAllActiveVolumes().AnyTimes().Return(instances)
+
+		newSegment := func(id uint64, state SegmentState, volumes ...vanus.ID) *Segment {
+			seg := &Segment{
+				ID:         vanus.NewIDFromUint64(id),
+				EventlogID: el.md.ID,
+				Replicas: &ReplicaGroup{
+					ID:    snowflake.NewTestID(),
+					Peers: map[uint64]*metadata.Block{},
+				},
+				State: state,
+			}
+			for idx, v := range volumes {
+				blk := &metadata.Block{ID: vanus.NewIDFromUint64(id*10 + uint64(idx)), VolumeID: v, SegmentID: seg.ID}
+				if idx == 0 {
+					seg.Replicas.Leader = blk.ID.Uint64()
+				}
+				seg.Replicas.Peers[blk.ID.Uint64()] = blk
+			}
+			utMgr.globalSegmentMap.Store(seg.ID.Key(), seg)
+			return seg
+		}
+		seg1 := newSegment(101, StateWorking, vol1, vol2, vol3)
+		seg2 := newSegment(102, StateWorking, vol1, vol2, vol3)
+		newSegment(103, StateWorking, vol1, vol2, vol3)
+		newSegment(104, StateWorking, vol1, vol3, vol2)
+		// Frozen segments and leaders on inactive volumes are ignored.
+		newSegment(105, StateFrozen, vol1, vol2, vol3)
+		newSegment(106, StateWorking, vol4, vol2, vol3)
+
+		Convey("test plan", func() {
+			transfers := utMgr.planLeaderTransfers()
+			So(transfers, ShouldResemble, []LeaderTransfer{{
+				SegmentID:  seg1.ID,
+				From:       vanus.NewIDFromUint64(1010),
+				To:         vanus.NewIDFromUint64(1011),
+				FromVolume: vol1,
+				ToVolume:   vol2,
+			}, {
+				SegmentID:  seg2.ID,
+				From:       vanus.NewIDFromUint64(1020),
+				To:         vanus.NewIDFromUint64(1022),
+				FromVolume: vol1,
+				ToVolume:   vol3,
+			}})
+
+			seg1.Replicas.Leader = 1011
+			seg2.Replicas.Leader = 1022
+			So(utMgr.planLeaderTransfers(), ShouldBeEmpty)
+		})
+
+		Convey("test transfer", func() {
+			ins := server.NewMockInstance(ctrl)
+			volMgr.EXPECT().GetVolumeInstanceByID(vol1).AnyTimes().Return(ins)
+			srv := server.NewMockServer(ctrl)
+			ins.EXPECT().GetServer().AnyTimes().Return(srv)
+			cli := segpb.NewMockSegmentServerClient(ctrl)
+			srv.EXPECT().GetClient().AnyTimes().Return(cli)
+
+			cli.EXPECT().TransferLeadership(gomock.Any(), &segpb.TransferLeadershipRequest{
+				BlockId:      1010,
+				TransfereeId: 1011,
+			}).Return(nil, nil)
+			cli.EXPECT().TransferLeadership(gomock.Any(), &segpb.TransferLeadershipRequest{
+				BlockId:      1020,
+				TransfereeId: 1022,
+			}).Return(nil, errors.ErrNotRaftLeader)
+
+			transfers, err := utMgr.RebalanceLeaders(ctx)
+			So(err, ShouldBeNil)
+			So(transfers, ShouldHaveLength, 1)
+			So(transfers[0].SegmentID, ShouldEqual, seg1.ID)
+
+			utMgr.rebalanceMutex.Lock()
+			_, err = utMgr.RebalanceLeaders(ctx)
+			utMgr.rebalanceMutex.Unlock()
+			So(errors.Is(err, errors.ErrResourceCanNotOp), ShouldBeTrue)
+		})
+	})
+}
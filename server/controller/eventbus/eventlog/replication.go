@@ -0,0 +1,370 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	// standard libraries.
+	"context"
+	"encoding/json"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	// first-party libraries.
+	"github.com/vanus-labs/vanus/api/errors"
+	"github.com/vanus-labs/vanus/api/segment"
+	vanus "github.com/vanus-labs/vanus/api/vsr"
+	"github.com/vanus-labs/vanus/pkg/observability/log"
+
+	// this project.
+	"github.com/vanus-labs/vanus/server/controller/eventbus/metadata"
+)
+
+const (
+	defaultReplicationInterval       = 10 * time.Second
+	defaultVolumeLostTimeout         = 5 * time.Minute
+	defaultMembershipChangeTimeout   = 30 * time.Second
+	defaultLearnerCatchUpTimeout     = 30 * time.Minute
+	defaultLearnerCatchUpInterval    = time.Second
+	defaultMaximumReplicatingSegment = 8
+)
+
+// replicateLostBlocks restores the replica number of segments hosted by volumes which have missed
+// heartbeats for longer than volumeLostTimeout, and migrates replicas out of draining volumes. Blocks
+// on these volumes are replaced one by one through membership changes of raft: add a learner on
+// another volume, promote it after it has caught up with the leader, then remove the old block.
+func (mgr *eventlogManager) replicateLostBlocks(ctx context.Context) {
+	ticker := time.NewTicker(mgr.replicationInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info(ctx).Msg("the task of replicate-lost-blocks stopped")
+			return
+		case <-ticker.C:
+			lost := mgr.lostVolumes()
+			for id := range mgr.drainingVolumes() {
+				lost[id] = struct{}{}
+			}
+			if len(lost) == 0 {
+				continue
+			}
+			mgr.globalSegmentMap.Range(func(key, value any) bool {
+				if atomic.LoadInt64(&mgr.replicatingSegmentNum) >= defaultMaximumReplicatingSegment {
+					return false
+				}
+				seg, _ := value.(*Segment)
+				if _, ok := mgr.segmentNeedBeClean.Load(key); ok {
+					return true
+				}
+				if blk := mgr.lostBlockOf(seg, lost); blk != nil {
+					mgr.startReplication(ctx, seg, blk)
+				}
+				return true
+			})
+		}
+	}
+}
+
+func (mgr *eventlogManager) lostVolumes() map[uint64]struct{} {
+	lost := make(map[uint64]struct{})
+	for _, ins := range mgr.volMgr.GetAllVolumes() {
+		if time.Since(ins.GetHeartbeatTime()) > mgr.volumeLostTimeout {
+			lost[ins.ID().Uint64()] = struct{}{}
+		}
+	}
+	return lost
+}
+
+func (mgr *eventlogManager) drainingVolumes() map[uint64]struct{} {
+	draining := make(map[uint64]struct{})
+	for _, ins := range mgr.volMgr.GetAllVolumes() {
+		if ins.GetMeta().Draining {
+			draining[ins.ID().Uint64()] = struct{}{}
+		}
+	}
+	return draining
+}
+
+func (mgr *eventlogManager) lostBlockOf(seg *Segment, lost map[uint64]struct{}) *metadata.Block {
+	el := mgr.getEventlog(seg.EventlogID)
+	if el == nil {
+		return nil
+	}
+	el.rLock()
+	defer el.rUnlock()
+
+	if !seg.isReady() {
+		return nil
+	}
+	for _, blk := range seg.Replicas.Peers {
+		if _, ok := lost[blk.VolumeID.Uint64()]; ok {
+			return blk
+		}
+	}
+	return nil
+}
+
+func (mgr *eventlogManager) startReplication(ctx context.Context, seg *Segment, lost *metadata.Block) {
+	if _, loaded := mgr.replicatingSegment.LoadOrStore(seg.ID.Key(), lost); loaded {
+		return
+	}
+	atomic.AddInt64(&mgr.replicatingSegmentNum, 1)
+
+	go func() {
+		defer func() {
+			mgr.replicatingSegment.Delete(seg.ID.Key())
+			atomic.AddInt64(&mgr.replicatingSegmentNum, -1)
+		}()
+
+		if err := mgr.replaceBlock(ctx, seg, lost); err != nil {
+			log.Warn(ctx).Err(err).
+				Stringer("segment_id", seg.ID).
+				Stringer("block_id", lost.ID).
+				Stringer("volume_id", lost.VolumeID).
+				Msg("failed to replace the block on lost volume")
+			return
+		}
+		log.Info(ctx).
+			Stringer("segment_id", seg.ID).
+			Stringer("block_id", lost.ID).
+			Stringer("volume_id", lost.VolumeID).
+			Msg("the block on lost volume has been replaced")
+	}()
+}
+
+func (mgr *eventlogManager) replaceBlock(ctx context.Context, seg *Segment, lost *metadata.Block) error {
+	el := mgr.getEventlog(seg.EventlogID)
+	if el == nil {
+		return errors.ErrEventlogNotFound
+	}
+
+	el.rLock()
+	leader := seg.GetLeaderBlock()
+	volumes := make([]vanus.ID, 0, len(seg.Replicas.Peers))
+	followers := make([]*metadata.Block, 0, len(seg.Replicas.Peers))
+	for _, blk := range seg.Replicas.Peers {
+		volumes = append(volumes, blk.VolumeID)
+		if blk.ID.Uint64() != seg.Replicas.Leader && blk.ID != lost.ID {
+			followers = append(followers, blk)
+		}
+	}
+	// The replacement has been promoted if there are more peers than expected, but the lost block
+	// hasn't been removed, which is usually caused by a failure of the last attempt.
+	replaced := uint(len(seg.Replicas.Peers)) > mgr.segmentReplicaNum
+	el.rUnlock()
+	sort.Slice(followers, func(i, j int) bool { return followers[i].ID < followers[j].ID })
+
+	if leader != nil && leader.ID == lost.ID {
+		if ins := mgr.volMgr.GetVolumeInstanceByID(lost.VolumeID); ins != nil && ins.GetMeta().Draining {
+			// The draining volume is still alive, hand over the leadership before removing it.
+			return mgr.transferLeadershipOut(ctx, seg, leader, followers)
+		}
+	}
+	if leader == nil || leader.ID == lost.ID {
+		// Wait for the replica group to elect a new leader.
+		return errors.ErrNotRaftLeader.WithMessage("the leader of segment is unavailable")
+	}
+	ins := mgr.volMgr.GetVolumeInstanceByID(leader.VolumeID)
+	if ins == nil {
+		return errors.ErrVolumeInstanceNotFound
+	}
+	srv := ins.GetServer()
+	if srv == nil {
+		return errors.ErrVolumeInstanceNoServer
+	}
+	cli := srv.GetClient()
+
+	if !replaced {
+		blk, err := mgr.allocator.PickExcept(ctx, volumes, seg.Compress)
+		if err != nil {
+			return err
+		}
+		if err = mgr.addReplica(ctx, el, seg, cli, leader, blk); err != nil {
+			mgr.releaseBlock(ctx, blk)
+			return err
+		}
+	}
+
+	if err := mgr.changeMembership(ctx, cli, &segment.ChangeMembershipRequest{
+		BlockId: leader.ID.Uint64(),
+		Type:    segment.MembershipChangeType_REMOVE_PEER,
+		PeerId:  lost.ID.Uint64(),
+	}); err != nil {
+		return err
+	}
+	return mgr.removeBlockOfSegment(ctx, el, seg, lost)
+}
+
+// transferLeadershipOut transfers the leadership of seg to a follower which isn't on a draining volume,
+// the block is replaced in the next round after the new leader has been reported.
+func (mgr *eventlogManager) transferLeadershipOut(
+	ctx context.Context, seg *Segment, leader *metadata.Block, followers []*metadata.Block,
+) error {
+	for _, blk := range followers {
+		ins := mgr.volMgr.GetVolumeInstanceByID(blk.VolumeID)
+		if ins == nil || ins.GetMeta().Draining {
+			continue
+		}
+		if err := mgr.transferLeadership(ctx, LeaderTransfer{
+			SegmentID:  seg.ID,
+			From:       leader.ID,
+			To:         blk.ID,
+			FromVolume: leader.VolumeID,
+			ToVolume:   blk.VolumeID,
+		}); err != nil {
+			return err
+		}
+		return errors.ErrNotRaftLeader.WithMessage("the leadership of segment has been transferred")
+	}
+	return errors.ErrNotRaftLeader.WithMessage("no follower can take over the leadership of segment")
+}
+
+// addReplica adds blk to the replica group of seg as a voter.
+func (mgr *eventlogManager) addReplica(
+	ctx context.Context, el *eventlog, seg *Segment, cli segment.SegmentServerClient, leader, blk *metadata.Block,
+) error {
+	ins := mgr.volMgr.GetVolumeInstanceByID(blk.VolumeID)
+	if ins == nil {
+		return errors.ErrVolumeInstanceNotFound
+	}
+
+	// Bind the block to the segment before it joins the replica group, otherwise it may be reused by
+	// allocator after the controller restarted.
+	blk.SegmentID = seg.ID
+	blk.EventlogID = seg.EventlogID
+	if err := mgr.saveBlock(ctx, blk); err != nil {
+		return err
+	}
+
+	req := &segment.ChangeMembershipRequest{
+		BlockId:  leader.ID.Uint64(),
+		Type:     segment.MembershipChangeType_ADD_LEARNER,
+		PeerId:   blk.ID.Uint64(),
+		Endpoint: ins.Address(),
+	}
+	if err := mgr.changeMembership(ctx, cli, req); err != nil {
+		return err
+	}
+
+	req.Type = segment.MembershipChangeType_PROMOTE_LEARNER
+	if err := mgr.promoteLearner(ctx, cli, req); err != nil {
+		// Try to revert, the learner doesn't affect availability of the replica group even if failed.
+		req.Type = segment.MembershipChangeType_REMOVE_PEER
+		_ = mgr.changeMembership(ctx, cli, req)
+		return err
+	}
+
+	el.lock()
+	defer el.unlock()
+
+	peers := make(map[uint64]*metadata.Block, len(seg.Replicas.Peers)+1)
+	for id, v := range seg.Replicas.Peers {
+		peers[id] = v
+	}
+	peers[blk.ID.Uint64()] = blk
+	seg.Replicas.Peers = peers
+	if err := mgr.saveSegment(ctx, seg); err != nil {
+		return err
+	}
+	mgr.globalBlockMap.Store(blk.ID.Key(), blk)
+	return nil
+}
+
+// promoteLearner retries to promote the learner until it has caught up with the leader.
+func (mgr *eventlogManager) promoteLearner(
+	ctx context.Context, cli segment.SegmentServerClient, req *segment.ChangeMembershipRequest,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, mgr.learnerCatchUpTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(defaultLearnerCatchUpInterval)
+	defer ticker.Stop()
+	for {
+		err := mgr.changeMembership(ctx, cli, req)
+		if err == nil || !errors.Is(err, errors.ErrNotReady) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (mgr *eventlogManager) changeMembership(
+	ctx context.Context, cli segment.SegmentServerClient, req *segment.ChangeMembershipRequest,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultMembershipChangeTimeout)
+	defer cancel()
+	_, err := cli.ChangeMembership(ctx, req)
+	return err
+}
+
+func (mgr *eventlogManager) removeBlockOfSegment(
+	ctx context.Context, el *eventlog, seg *Segment, blk *metadata.Block,
+) error {
+	el.lock()
+	defer el.unlock()
+
+	peers := make(map[uint64]*metadata.Block, len(seg.Replicas.Peers))
+	for id, v := range seg.Replicas.Peers {
+		if id != blk.ID.Uint64() {
+			peers[id] = v
+		}
+	}
+	seg.Replicas.Peers = peers
+	if err := mgr.saveSegment(ctx, seg); err != nil {
+		return err
+	}
+
+	// The block left on the lost volume is orphaned, it isn't a member of replica group anymore.
+	mgr.globalBlockMap.Delete(blk.ID.Key())
+	if err := mgr.kvClient.Delete(ctx, metadata.GetBlockMetadataKey(blk.VolumeID, blk.ID)); err != nil {
+		log.Warn(ctx).Err(err).
+			Stringer("block_id", blk.ID).
+			Msg("delete block metadata in kv failed")
+	}
+	return nil
+}
+
+// releaseBlock deletes the block which failed to join a replica group.
+func (mgr *eventlogManager) releaseBlock(ctx context.Context, blk *metadata.Block) {
+	if ins := mgr.volMgr.GetVolumeInstanceByID(blk.VolumeID); ins != nil {
+		if err := ins.DeleteBlock(ctx, blk.ID); err != nil {
+			log.Warn(ctx).Err(err).
+				Stringer("block_id", blk.ID).
+				Msg("delete block failed")
+			return
+		}
+	}
+	if err := mgr.kvClient.Delete(ctx, metadata.GetBlockMetadataKey(blk.VolumeID, blk.ID)); err != nil {
+		log.Warn(ctx).Err(err).
+			Stringer("block_id", blk.ID).
+			Msg("delete block metadata in kv failed")
+	}
+}
+
+func (mgr *eventlogManager) saveBlock(ctx context.Context, blk *metadata.Block) error {
+	data, _ := json.Marshal(blk)
+	return mgr.kvClient.Set(ctx, metadata.GetBlockMetadataKey(blk.VolumeID, blk.ID), data)
+}
+
+func (mgr *eventlogManager) saveSegment(ctx context.Context, seg *Segment) error {
+	data, _ := json.Marshal(seg)
+	return mgr.kvClient.Set(ctx, metadata.GetSegmentMetadataKey(seg.ID), data)
+}
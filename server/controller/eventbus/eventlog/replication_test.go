@@ -0,0 +1,281 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	// standard libraries.
+	"context"
+	"testing"
+	"time"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+
+	// first-party libraries.
+	"github.com/vanus-labs/vanus/api/errors"
+	metapb "github.com/vanus-labs/vanus/api/meta"
+	segpb "github.com/vanus-labs/vanus/api/segment"
+	vanus "github.com/vanus-labs/vanus/api/vsr"
+
+	// this project.
+	"github.com/vanus-labs/vanus/pkg/kv"
+	"github.com/vanus-labs/vanus/pkg/snowflake"
+	"github.com/vanus-labs/vanus/server/controller/eventbus/block"
+	"github.com/vanus-labs/vanus/server/controller/eventbus/metadata"
+	"github.com/vanus-labs/vanus/server/controller/eventbus/server"
+	"github.com/vanus-labs/vanus/server/controller/eventbus/volume"
+)
+
+func TestEventlogManager_LostVolumes(t *testing.T) {
+	Convey("test lostVolumes and drainingVolumes", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		volMgr := volume.NewMockManager(ctrl)
+		utMgr := &eventlogManager{volMgr: volMgr, volumeLostTimeout: time.Minute}
+
+		alive := server.NewMockInstance(ctrl)
+		alive.EXPECT().GetHeartbeatTime().AnyTimes().Return(time.Now())
+		alive.EXPECT().ID().AnyTimes().Return(vanus.NewIDFromUint64(1))
+		lost := server.NewMockInstance(ctrl)
+		lost.EXPECT().GetHeartbeatTime().AnyTimes().Return(time.Now().Add(-2 * time.Minute))
+		lost.EXPECT().ID().AnyTimes().Return(vanus.NewIDFromUint64(2))
+		volMgr.EXPECT().GetAllVolumes().Return([]server.Instance{alive, lost})
+
+		vols := utMgr.lostVolumes()
+		So(vols, ShouldHaveLength, 1)
+		So(vols, ShouldContainKey, uint64(2))
+
+		alive.EXPECT().GetMeta().AnyTimes().Return(&metadata.VolumeMetadata{ID: vanus.NewIDFromUint64(1), Draining: true})
+		lost.EXPECT().GetMeta().AnyTimes().Return(&metadata.VolumeMetadata{ID: vanus.NewIDFromUint64(2)})
+		volMgr.EXPECT().GetAllVolumes().Return([]server.Instance{alive, lost})
+		vols = utMgr.drainingVolumes()
+		So(vols, ShouldHaveLength, 1)
+		So(vols, ShouldContainKey, uint64(1))
+	})
+}
+
+func TestEventlogManager_ReplaceBlock(t *testing.T) {
+	Convey("test replaceBlock", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		volMgr := volume.NewMockManager(ctrl)
+		kvCli := kv.NewMockClient(ctrl)
+		alloc := block.NewMockAllocator(ctrl)
+		utMgr := &eventlogManager{
+			segmentReplicaNum:     3,
+			volMgr:                volMgr,
+			kvClient:              kvCli,
+			allocator:             alloc,
+			learnerCatchUpTimeout: time.Minute,
+		}
+
+		el, err := newEventlog(ctx, &metadata.Eventlog{
+			ID:           snowflake.NewTestID(),
+			EventbusID:   snowflake.NewTestID(),
+			EventbusName: "ut",
+		}, kvCli, false)
+		So(err, ShouldBeNil)
+		utMgr.eventlogMap.Store(el.md.ID.Key(), el)
+
+		vol1, vol2, vol3, vol4 := snowflake.NewTestID(), snowflake.NewTestID(), snowflake.NewTestID(),
+			snowflake.NewTestID()
+		leader := &metadata.Block{ID: snowflake.NewTestID(), VolumeID: vol1}
+		follower := &metadata.Block{ID: snowflake.NewTestID(), VolumeID: vol2}
+		lost := &metadata.Block{ID: snowflake.NewTestID(), VolumeID: vol3}
+		seg := &Segment{
+			ID:         snowflake.NewTestID(),
+			EventlogID: el.md.ID,
+			Replicas: &ReplicaGroup{
+				ID:     snowflake.NewTestID(),
+				Leader: leader.ID.Uint64(),
+				Peers: map[uint64]*metadata.Block{
+					leader.ID.Uint64():   leader,
+					follower.ID.Uint64(): follower,
+					lost.ID.Uint64():     lost,
+				},
+			},
+			State: StateWorking,
+		}
+		utMgr.globalSegmentMap.Store(seg.ID.Key(), seg)
+		for _, blk := range seg.Replicas.Peers {
+			utMgr.globalBlockMap.Store(blk.ID.Key(), blk)
+		}
+
+		leaderIns := server.NewMockInstance(ctrl)
+		volMgr.EXPECT().GetVolumeInstanceByID(vol1).AnyTimes().Return(leaderIns)
+		srv := server.NewMockServer(ctrl)
+		leaderIns.EXPECT().GetServer().AnyTimes().Return(srv)
+		cli := segpb.NewMockSegmentServerClient(ctrl)
+		srv.EXPECT().GetClient().AnyTimes().Return(cli)
+		newIns := server.NewMockInstance(ctrl)
+		volMgr.EXPECT().GetVolumeInstanceByID(vol4).AnyTimes().Return(newIns)
+		newIns.EXPECT().Address().AnyTimes().Return("127.0.0.1:11814")
+
+		So(utMgr.lostBlockOf(seg, map[uint64]struct{}{vol3.Uint64(): {}}), ShouldEqual, lost)
+		So(utMgr.lostBlockOf(seg, map[uint64]struct{}{vol4.Uint64(): {}}), ShouldBeNil)
+
+		newBlk := &metadata.Block{ID: snowflake.NewTestID(), VolumeID: vol4}
+		alloc.EXPECT().PickExcept(gomock.Any(), gomock.Len(3), metapb.CompressAlgorithm_NONE).Return(newBlk, nil)
+
+		Convey("replace the lost block", func() {
+			kvCli.EXPECT().Set(gomock.Any(), metadata.GetBlockMetadataKey(vol4, newBlk.ID), gomock.Any()).Return(nil)
+			kvCli.EXPECT().Set(gomock.Any(), metadata.GetSegmentMetadataKey(seg.ID), gomock.Any()).Times(2).Return(nil)
+			kvCli.EXPECT().Delete(gomock.Any(), metadata.GetBlockMetadataKey(vol3, lost.ID)).Return(nil)
+			gomock.InOrder(
+				cli.EXPECT().ChangeMembership(gomock.Any(), &segpb.ChangeMembershipRequest{
+					BlockId:  leader.ID.Uint64(),
+					Type:     segpb.MembershipChangeType_ADD_LEARNER,
+					PeerId:   newBlk.ID.Uint64(),
+					Endpoint: "127.0.0.1:11814",
+				}).Return(nil, nil),
+				cli.EXPECT().ChangeMembership(gomock.Any(), &segpb.ChangeMembershipRequest{
+					BlockId:  leader.ID.Uint64(),
+					Type:     segpb.MembershipChangeType_PROMOTE_LEARNER,
+					PeerId:   newBlk.ID.Uint64(),
+					Endpoint: "127.0.0.1:11814",
+				}).Return(nil, errors.ErrNotReady),
+				cli.EXPECT().ChangeMembership(gomock.Any(), gomock.Any()).Return(nil, nil),
+				cli.EXPECT().ChangeMembership(gomock.Any(), &segpb.ChangeMembershipRequest{
+					BlockId: leader.ID.Uint64(),
+					Type:    segpb.MembershipChangeType_REMOVE_PEER,
+					PeerId:  lost.ID.Uint64(),
+				}).Return(nil, nil),
+			)
+
+			err = utMgr.replaceBlock(ctx, seg, lost)
+			So(err, ShouldBeNil)
+			So(seg.Replicas.Peers, ShouldHaveLength, 3)
+			So(seg.Replicas.Peers, ShouldContainKey, newBlk.ID.Uint64())
+			So(seg.Replicas.Peers, ShouldNotContainKey, lost.ID.Uint64())
+			So(newBlk.SegmentID, ShouldEqual, seg.ID)
+			So(newBlk.EventlogID, ShouldEqual, el.md.ID)
+			So(utMgr.GetBlock(newBlk.ID), ShouldEqual, newBlk)
+			So(utMgr.GetBlock(lost.ID), ShouldBeNil)
+		})
+
+		Convey("failed to promote the learner", func() {
+			utMgr.learnerCatchUpTimeout = 10 * time.Millisecond
+			kvCli.EXPECT().Set(gomock.Any(), metadata.GetBlockMetadataKey(vol4, newBlk.ID), gomock.Any()).Return(nil)
+			kvCli.EXPECT().Delete(gomock.Any(), metadata.GetBlockMetadataKey(vol4, newBlk.ID)).Return(nil)
+			newIns.EXPECT().DeleteBlock(gomock.Any(), newBlk.ID).Return(nil)
+			gomock.InOrder(
+				cli.EXPECT().ChangeMembership(gomock.Any(), gomock.Any()).Return(nil, nil),
+				cli.EXPECT().ChangeMembership(gomock.Any(), gomock.Any()).Return(nil, errors.ErrNotReady),
+				cli.EXPECT().ChangeMembership(gomock.Any(), &segpb.ChangeMembershipRequest{
+					BlockId:  leader.ID.Uint64(),
+					Type:     segpb.MembershipChangeType_REMOVE_PEER,
+					PeerId:   newBlk.ID.Uint64(),
+					Endpoint: "127.0.0.1:11814",
+				}).Return(nil, nil),
+			)
+
+			err = utMgr.replaceBlock(ctx, seg, lost)
+			So(err, ShouldNotBeNil)
+			So(seg.Replicas.Peers, ShouldHaveLength, 3)
+			So(seg.Replicas.Peers, ShouldContainKey, lost.ID.Uint64())
+			So(seg.Replicas.Peers, ShouldNotContainKey, newBlk.ID.Uint64())
+		})
+	})
+
+	Convey("test replaceBlock without available leader", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		kvCli := kv.NewMockClient(ctrl)
+		volMgr := volume.NewMockManager(ctrl)
+		utMgr := &eventlogManager{segmentReplicaNum: 3, volMgr: volMgr}
+		el, err := newEventlog(ctx, &metadata.Eventlog{
+			ID:         snowflake.NewTestID(),
+			EventbusID: snowflake.NewTestID(),
+		}, kvCli, false)
+		So(err, ShouldBeNil)
+		utMgr.eventlogMap.Store(el.md.ID.Key(), el)
+
+		volID := snowflake.NewTestID()
+		ins := server.NewMockInstance(ctrl)
+		volMgr.EXPECT().GetVolumeInstanceByID(volID).AnyTimes().Return(ins)
+		md := &metadata.VolumeMetadata{ID: volID}
+		ins.EXPECT().GetMeta().AnyTimes().Return(md)
+
+		seg := createTestSegment(volID)
+		seg.EventlogID = el.md.ID
+		err = utMgr.replaceBlock(ctx, seg, seg.GetLeaderBlock())
+		So(errors.Is(err, errors.ErrNotRaftLeader), ShouldBeTrue)
+
+		// All followers are on the draining volume too.
+		md.Draining = true
+		err = utMgr.replaceBlock(ctx, seg, seg.GetLeaderBlock())
+		So(errors.Is(err, errors.ErrNotRaftLeader), ShouldBeTrue)
+	})
+
+	Convey("test replaceBlock of leader on draining volume", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		kvCli := kv.NewMockClient(ctrl)
+		volMgr := volume.NewMockManager(ctrl)
+		utMgr := &eventlogManager{segmentReplicaNum: 3, volMgr: volMgr}
+		el, err := newEventlog(ctx, &metadata.Eventlog{
+			ID:         snowflake.NewTestID(),
+			EventbusID: snowflake.NewTestID(),
+		}, kvCli, false)
+		So(err, ShouldBeNil)
+		utMgr.eventlogMap.Store(el.md.ID.Key(), el)
+
+		drainingVol, vol := snowflake.NewTestID(), snowflake.NewTestID()
+		drainingIns := server.NewMockInstance(ctrl)
+		volMgr.EXPECT().GetVolumeInstanceByID(drainingVol).AnyTimes().Return(drainingIns)
+		drainingIns.EXPECT().GetMeta().AnyTimes().Return(&metadata.VolumeMetadata{ID: drainingVol, Draining: true})
+		srv := server.NewMockServer(ctrl)
+		drainingIns.EXPECT().GetServer().AnyTimes().Return(srv)
+		cli := segpb.NewMockSegmentServerClient(ctrl)
+		srv.EXPECT().GetClient().AnyTimes().Return(cli)
+		ins := server.NewMockInstance(ctrl)
+		volMgr.EXPECT().GetVolumeInstanceByID(vol).AnyTimes().Return(ins)
+		ins.EXPECT().GetMeta().AnyTimes().Return(&metadata.VolumeMetadata{ID: vol})
+
+		leader := &metadata.Block{ID: snowflake.NewTestID(), VolumeID: drainingVol}
+		follower := &metadata.Block{ID: snowflake.NewTestID(), VolumeID: vol}
+		seg := &Segment{
+			ID:         snowflake.NewTestID(),
+			EventlogID: el.md.ID,
+			Replicas: &ReplicaGroup{
+				ID:     snowflake.NewTestID(),
+				Leader: leader.ID.Uint64(),
+				Peers: map[uint64]*metadata.Block{
+					leader.ID.Uint64():   leader,
+					follower.ID.Uint64(): follower,
+				},
+			},
+		}
+
+		utMgr.globalSegmentMap.Store(seg.ID.Key(), seg)
+		So(utMgr.CountBlocksOnVolume(drainingVol), ShouldEqual, 1)
+		So(utMgr.CountBlocksOnVolume(snowflake.NewTestID()), ShouldEqual, 0)
+
+		cli.EXPECT().TransferLeadership(gomock.Any(), &segpb.TransferLeadershipRequest{
+			BlockId:      leader.ID.Uint64(),
+			TransfereeId: follower.ID.Uint64(),
+		}).Return(nil, nil)
+		err = utMgr.replaceBlock(ctx, seg, leader)
+		So(errors.Is(err, errors.ErrNotRaftLeader), ShouldBeTrue)
+	})
+}
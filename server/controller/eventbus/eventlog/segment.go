@@ -37,18 +37,19 @@ const (
 )
 
 type Segment struct {
-	ID                 vanus.ID      `json:"id,omitempty"`
-	Capacity           int64         `json:"capacity,omitempty"`
-	EventlogID         vanus.ID      `json:"event_log_id,omitempty"`
-	PreviousSegmentID  vanus.ID      `json:"previous_segment_id,omitempty"`
-	NextSegmentID      vanus.ID      `json:"next_segment_id,omitempty"`
-	StartOffsetInLog   int64         `json:"start_offset_in_log,omitempty"`
-	Replicas           *ReplicaGroup `json:"replicas,omitempty"`
-	State              SegmentState  `json:"state,omitempty"`
-	Size               int64         `json:"size,omitempty"`
-	Number             int32         `json:"number,omitempty"`
-	FirstEventBornTime time.Time     `json:"first_event_born_time"`
-	LastEventBornTime  time.Time     `json:"last_event_born_time"`
+	ID                 vanus.ID                 `json:"id,omitempty"`
+	Capacity           int64                    `json:"capacity,omitempty"`
+	EventlogID         vanus.ID                 `json:"event_log_id,omitempty"`
+	PreviousSegmentID  vanus.ID                 `json:"previous_segment_id,omitempty"`
+	NextSegmentID      vanus.ID                 `json:"next_segment_id,omitempty"`
+	StartOffsetInLog   int64                    `json:"start_offset_in_log,omitempty"`
+	Replicas           *ReplicaGroup            `json:"replicas,omitempty"`
+	State              SegmentState             `json:"state,omitempty"`
+	Size               int64                    `json:"size,omitempty"`
+	Number             int32                    `json:"number,omitempty"`
+	FirstEventBornTime time.Time                `json:"first_event_born_time"`
+	LastEventBornTime  time.Time                `json:"last_event_born_time"`
+	Compress           metapb.CompressAlgorithm `json:"compress,omitempty"`
 }
 
 func (seg *Segment) IsAppendable() bool {
@@ -172,6 +173,7 @@ func Convert2ProtoSegment(ctx context.Context, ins ...Segment) []*metapb.Segment
 			State:                    string(seg.State),
 			FirstEventBornAtByUnixMs: seg.FirstEventBornTime.UnixMilli(),
 			LastEventBornAtByUnixMs:  seg.LastEventBornTime.UnixMilli(),
+			Compressed:               seg.Compress,
 		}
 		if seg.GetLeaderBlock() != nil {
 			segs[idx].LeaderBlockId = seg.GetLeaderBlock().ID.Uint64()
@@ -31,6 +31,41 @@ type Eventbus struct {
 	CreatedAt   time.Time   `json:"created_at"`
 	UpdatedAt   time.Time   `json:"updated_at"`
 	NamespaceID uint64      `json:"namespace_id"`
+	// Retention is nil if the eventbus follows the default policy of cluster.
+	Retention *Retention `json:"retention,omitempty"`
+	// CompressAlgorithm is used to compress events of segments created later.
+	CompressAlgorithm meta.CompressAlgorithm `json:"compress_algorithm,omitempty"`
+}
+
+// Retention is the retention policy of each eventlog of an eventbus, the zero value
+// of MaxAge or MaxBytes means no limitation on it.
+type Retention struct {
+	MaxAge   time.Duration `json:"max_age"`
+	MaxBytes int64         `json:"max_bytes"`
+}
+
+func (r *Retention) IsInfinite() bool {
+	return r.MaxAge <= 0 && r.MaxBytes <= 0
+}
+
+func NewRetention(pb *meta.RetentionPolicy) *Retention {
+	if pb == nil {
+		return nil
+	}
+	return &Retention{
+		MaxAge:   time.Duration(pb.MaxAgeSeconds) * time.Second,
+		MaxBytes: pb.MaxBytes,
+	}
+}
+
+func Convert2ProtoRetention(r *Retention) *meta.RetentionPolicy {
+	if r == nil {
+		return nil
+	}
+	return &meta.RetentionPolicy{
+		MaxAgeSeconds: int64(r.MaxAge / time.Second),
+		MaxBytes:      r.MaxBytes,
+	}
 }
 
 func Convert2ProtoEventbus(ins ...*Eventbus) []*meta.Eventbus {
@@ -38,14 +73,16 @@ func Convert2ProtoEventbus(ins ...*Eventbus) []*meta.Eventbus {
 	for idx := 0; idx < len(ins); idx++ {
 		eb := ins[idx]
 		pebs[idx] = &meta.Eventbus{
-			Name:        eb.Name,
-			LogNumber:   int32(eb.LogNumber),
-			Logs:        Convert2ProtoEventlog(eb.Eventlogs...),
-			Id:          eb.ID.Uint64(),
-			Description: eb.Description,
-			CreatedAt:   eb.CreatedAt.UnixMilli(),
-			UpdatedAt:   eb.UpdatedAt.UnixMilli(),
-			NamespaceId: eb.NamespaceID,
+			Name:              eb.Name,
+			LogNumber:         int32(eb.LogNumber),
+			Logs:              Convert2ProtoEventlog(eb.Eventlogs...),
+			Id:                eb.ID.Uint64(),
+			Description:       eb.Description,
+			CreatedAt:         eb.CreatedAt.UnixMilli(),
+			UpdatedAt:         eb.UpdatedAt.UnixMilli(),
+			NamespaceId:       eb.NamespaceID,
+			Retention:         Convert2ProtoRetention(eb.Retention),
+			CompressAlgorithm: eb.CompressAlgorithm,
 		}
 	}
 	return pebs
@@ -84,15 +121,29 @@ type VolumeMetadata struct {
 	Capacity int64             `json:"capacity"`
 	Used     int64             `json:"used"`
 	Blocks   map[uint64]*Block `json:"blocks"`
+	Topology Topology          `json:"topology"`
+	// Draining means the volume is being decommissioned, no more Block will be placed on it, and
+	// replicas on it are migrating to other volumes.
+	Draining bool `json:"draining,omitempty"`
+}
+
+// Topology is the failure domains which a volume located in, empty label means unknown.
+type Topology struct {
+	Zone string `json:"zone,omitempty"`
+	Rack string `json:"rack,omitempty"`
+	Host string `json:"host,omitempty"`
 }
 
 type Block struct {
-	ID         vanus.ID `json:"id"`
-	Capacity   int64    `json:"capacity"`
-	Size       int64    `json:"size"`
-	VolumeID   vanus.ID `json:"volume_id"`
-	EventlogID vanus.ID `json:"eventlog_id"`
-	SegmentID  vanus.ID `json:"segment_id"`
+	ID         vanus.ID               `json:"id"`
+	Capacity   int64                  `json:"capacity"`
+	Size       int64                  `json:"size"`
+	VolumeID   vanus.ID               `json:"volume_id"`
+	EventlogID vanus.ID               `json:"eventlog_id"`
+	SegmentID  vanus.ID               `json:"segment_id"`
+	Compress   meta.CompressAlgorithm `json:"compress,omitempty"`
+	// Offloaded means the data of Block is moved to tiered storage, it takes no space of volume.
+	Offloaded bool `json:"offloaded,omitempty"`
 }
 
 func (bl *Block) String() string {
@@ -18,8 +18,10 @@ package server
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/vanus-labs/vanus/api/errors"
+	metapb "github.com/vanus-labs/vanus/api/meta"
 	segpb "github.com/vanus-labs/vanus/api/segment"
 	vanus "github.com/vanus-labs/vanus/api/vsr"
 	"github.com/vanus-labs/vanus/pkg/observability/log"
@@ -33,15 +35,24 @@ type Instance interface {
 	Address() string
 	Close() error
 	GetMeta() *metadata.VolumeMetadata
-	CreateBlock(context.Context, int64) (*metadata.Block, error)
+	CreateBlock(context.Context, int64, metapb.CompressAlgorithm) (*metadata.Block, error)
 	DeleteBlock(context.Context, vanus.ID) error
+	// MarkBlockOffloaded marks the Block as offloaded, it no longer takes space of volume. It returns false if the
+	// Block is not found or has been offloaded.
+	MarkBlockOffloaded(vanus.ID) bool
 	GetServer() Server
 	SetServer(Server)
+	// Polish records that a heartbeat of the volume was received.
+	Polish()
+	// GetHeartbeatTime returns the time of the latest heartbeat, it is the time of the instance
+	// created if no heartbeat was received since then.
+	GetHeartbeatTime() time.Time
 }
 
 func NewInstance(md *metadata.VolumeMetadata) Instance {
 	return &volumeInstance{
-		md: md,
+		md:            md,
+		heartbeatTime: time.Now(),
 	}
 }
 
@@ -50,13 +61,17 @@ type volumeInstance struct {
 	metaMutex sync.Mutex
 	srv       Server
 	rwMutex   sync.RWMutex
+	// heartbeatTime is protected by rwMutex.
+	heartbeatTime time.Time
 }
 
 func (ins *volumeInstance) GetMeta() *metadata.VolumeMetadata {
 	return ins.md
 }
 
-func (ins *volumeInstance) CreateBlock(ctx context.Context, capacity int64) (*metadata.Block, error) {
+func (ins *volumeInstance) CreateBlock(
+	ctx context.Context, capacity int64, compress metapb.CompressAlgorithm,
+) (*metadata.Block, error) {
 	id, err := snowflake.NewID()
 	if err != nil {
 		return nil, err
@@ -65,13 +80,15 @@ func (ins *volumeInstance) CreateBlock(ctx context.Context, capacity int64) (*me
 		ID:       id,
 		Capacity: capacity,
 		VolumeID: ins.md.ID,
+		Compress: compress,
 	}
 	if ins.srv == nil {
 		return nil, errors.ErrVolumeInstanceNoServer
 	}
 	_, err = ins.srv.GetClient().CreateBlock(ctx, &segpb.CreateBlockRequest{
-		Size: blk.Capacity,
-		Id:   blk.ID.Uint64(),
+		Size:              blk.Capacity,
+		Id:                blk.ID.Uint64(),
+		CompressAlgorithm: compress,
 	})
 	if err != nil {
 		log.Warn(ctx).
@@ -108,11 +125,26 @@ func (ins *volumeInstance) DeleteBlock(ctx context.Context, id vanus.ID) error {
 	ins.metaMutex.Lock()
 	defer ins.metaMutex.Unlock()
 
-	ins.md.Used -= blk.Capacity
+	if !blk.Offloaded {
+		ins.md.Used -= blk.Capacity
+	}
 	delete(ins.md.Blocks, blk.ID.Uint64())
 	return nil
 }
 
+func (ins *volumeInstance) MarkBlockOffloaded(id vanus.ID) bool {
+	ins.metaMutex.Lock()
+	defer ins.metaMutex.Unlock()
+
+	blk := ins.md.Blocks[id.Uint64()]
+	if blk == nil || blk.Offloaded {
+		return false
+	}
+	blk.Offloaded = true
+	ins.md.Used -= blk.Capacity
+	return true
+}
+
 func (ins *volumeInstance) ID() vanus.ID {
 	return ins.md.ID
 }
@@ -162,3 +194,15 @@ func (ins *volumeInstance) GetServer() Server {
 	}
 	return ins.srv
 }
+
+func (ins *volumeInstance) Polish() {
+	ins.rwMutex.Lock()
+	defer ins.rwMutex.Unlock()
+	ins.heartbeatTime = time.Now()
+}
+
+func (ins *volumeInstance) GetHeartbeatTime() time.Time {
+	ins.rwMutex.RLock()
+	defer ins.rwMutex.RUnlock()
+	return ins.heartbeatTime
+}
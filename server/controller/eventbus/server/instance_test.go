@@ -24,6 +24,7 @@ import (
 	"google.golang.org/grpc"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 
+	metapb "github.com/vanus-labs/vanus/api/meta"
 	segpb "github.com/vanus-labs/vanus/api/segment"
 
 	"github.com/vanus-labs/vanus/pkg/snowflake"
@@ -72,7 +73,7 @@ func TestVolumeInstance(t *testing.T) {
 			return &emptypb.Empty{}, nil
 		}
 		segCli.EXPECT().CreateBlock(ctx, gomock.Any(), gomock.Any()).Times(1).DoAndReturn(f)
-		block, err := ins.CreateBlock(ctx, 32*1024*1024)
+		block, err := ins.CreateBlock(ctx, 32*1024*1024, metapb.CompressAlgorithm_NONE)
 		So(err, ShouldBeNil)
 		So(block.VolumeID, ShouldEqual, md.ID)
 		So(block.Capacity, ShouldEqual, 32*1024*1024)
@@ -82,11 +83,13 @@ func TestVolumeInstance(t *testing.T) {
 
 		f = func(ctx stdCtx.Context, in *segpb.CreateBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 			So(in.Size, ShouldEqual, 64*1024*1024)
+			So(in.CompressAlgorithm, ShouldEqual, metapb.CompressAlgorithm_ZSTD)
 			return &emptypb.Empty{}, nil
 		}
 		segCli.EXPECT().CreateBlock(ctx, gomock.Any(), gomock.Any()).Times(1).DoAndReturn(f)
-		block2, err := ins.CreateBlock(ctx, 64*1024*1024)
+		block2, err := ins.CreateBlock(ctx, 64*1024*1024, metapb.CompressAlgorithm_ZSTD)
 		So(err, ShouldBeNil)
+		So(block2.Compress, ShouldEqual, metapb.CompressAlgorithm_ZSTD)
 
 		So(md.Used, ShouldEqual, 96*1024*1024)
 		So(md.Blocks[block.ID.Uint64()], ShouldEqual, block)
@@ -105,5 +108,17 @@ func TestVolumeInstance(t *testing.T) {
 		So(md.Used, ShouldEqual, 64*1024*1024)
 		So(md.Blocks[block.ID.Uint64()], ShouldBeNil)
 		So(md.Blocks[block2.ID.Uint64()], ShouldEqual, block2)
+
+		So(ins.MarkBlockOffloaded(block.ID), ShouldBeFalse)
+		So(ins.MarkBlockOffloaded(block2.ID), ShouldBeTrue)
+		So(block2.Offloaded, ShouldBeTrue)
+		So(md.Used, ShouldEqual, 0)
+		So(ins.MarkBlockOffloaded(block2.ID), ShouldBeFalse)
+		So(md.Used, ShouldEqual, 0)
+
+		// the offloaded block has freed its space.
+		segCli.EXPECT().RemoveBlock(ctx, gomock.Any(), gomock.Any()).Times(1).Return(&emptypb.Empty{}, nil)
+		So(ins.DeleteBlock(ctx, block2.ID), ShouldBeNil)
+		So(md.Used, ShouldEqual, 0)
 	})
 }
@@ -12,7 +12,9 @@ package server
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	meta "github.com/vanus-labs/vanus/api/meta"
 	vsr "github.com/vanus-labs/vanus/api/vsr"
 	metadata "github.com/vanus-labs/vanus/server/controller/eventbus/metadata"
 	gomock "go.uber.org/mock/gomock"
@@ -70,18 +72,18 @@ func (mr *MockInstanceMockRecorder) Close() *gomock.Call {
 }
 
 // CreateBlock mocks base method.
-func (m *MockInstance) CreateBlock(arg0 context.Context, arg1 int64) (*metadata.Block, error) {
+func (m *MockInstance) CreateBlock(arg0 context.Context, arg1 int64, arg2 meta.CompressAlgorithm) (*metadata.Block, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBlock", arg0, arg1)
+	ret := m.ctrl.Call(m, "CreateBlock", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*metadata.Block)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateBlock indicates an expected call of CreateBlock.
-func (mr *MockInstanceMockRecorder) CreateBlock(arg0, arg1 any) *gomock.Call {
+func (mr *MockInstanceMockRecorder) CreateBlock(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlock", reflect.TypeOf((*MockInstance)(nil).CreateBlock), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlock", reflect.TypeOf((*MockInstance)(nil).CreateBlock), arg0, arg1, arg2)
 }
 
 // DeleteBlock mocks base method.
@@ -98,6 +100,20 @@ func (mr *MockInstanceMockRecorder) DeleteBlock(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlock", reflect.TypeOf((*MockInstance)(nil).DeleteBlock), arg0, arg1)
 }
 
+// GetHeartbeatTime mocks base method.
+func (m *MockInstance) GetHeartbeatTime() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHeartbeatTime")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// GetHeartbeatTime indicates an expected call of GetHeartbeatTime.
+func (mr *MockInstanceMockRecorder) GetHeartbeatTime() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeartbeatTime", reflect.TypeOf((*MockInstance)(nil).GetHeartbeatTime))
+}
+
 // GetMeta mocks base method.
 func (m *MockInstance) GetMeta() *metadata.VolumeMetadata {
 	m.ctrl.T.Helper()
@@ -140,6 +156,32 @@ func (mr *MockInstanceMockRecorder) ID() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ID", reflect.TypeOf((*MockInstance)(nil).ID))
 }
 
+// MarkBlockOffloaded mocks base method.
+func (m *MockInstance) MarkBlockOffloaded(arg0 vsr.ID) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkBlockOffloaded", arg0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// MarkBlockOffloaded indicates an expected call of MarkBlockOffloaded.
+func (mr *MockInstanceMockRecorder) MarkBlockOffloaded(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkBlockOffloaded", reflect.TypeOf((*MockInstance)(nil).MarkBlockOffloaded), arg0)
+}
+
+// Polish mocks base method.
+func (m *MockInstance) Polish() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Polish")
+}
+
+// Polish indicates an expected call of Polish.
+func (mr *MockInstanceMockRecorder) Polish() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Polish", reflect.TypeOf((*MockInstance)(nil).Polish))
+}
+
 // SetServer mocks base method.
 func (m *MockInstance) SetServer(arg0 Server) {
 	m.ctrl.T.Helper()
@@ -22,6 +22,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/vanus-labs/vanus/api/errors"
 	vanus "github.com/vanus-labs/vanus/api/vsr"
 	"github.com/vanus-labs/vanus/pkg/observability/log"
 
@@ -33,11 +34,19 @@ import (
 type Manager interface {
 	Init(ctx context.Context, kvClient kv.Client) error
 	GetAllActiveVolumes() []server.Instance
+	GetAllVolumes() []server.Instance
 	RegisterVolume(ctx context.Context, md *metadata.VolumeMetadata) (server.Instance, error)
+	// UpdateVolume updates the capacity and topology reported by the segment server of volume.
+	UpdateVolume(ctx context.Context, id vanus.ID, capacity int64, topology metadata.Topology) error
+	// SetDraining marks the volume as draining or not, Blocks on a draining volume will be migrated
+	// to other volumes.
+	SetDraining(ctx context.Context, id vanus.ID, draining bool) error
 	UpdateRouting(ctx context.Context, ins server.Instance, srv server.Server)
 	GetVolumeInstanceByID(id vanus.ID) server.Instance
 	LookupVolumeByID(id uint64) server.Instance
 	GetBlocksOfVolume(ctx context.Context, instance server.Instance) (map[uint64]*metadata.Block, error)
+	// MarkBlockOffloaded persists that the Block is offloaded to tiered storage, and frees its space of volume.
+	MarkBlockOffloaded(ctx context.Context, blk *metadata.Block) error
 }
 
 var mgr = &volumeMgr{}
@@ -70,6 +79,55 @@ func (mgr *volumeMgr) RegisterVolume(ctx context.Context, md *metadata.VolumeMet
 	return ins, nil
 }
 
+func (mgr *volumeMgr) UpdateVolume(
+	ctx context.Context, id vanus.ID, capacity int64, topology metadata.Topology,
+) error {
+	return mgr.updateVolume(ctx, id, func(md *metadata.VolumeMetadata) bool {
+		if md.Capacity == capacity && md.Topology == topology {
+			return false
+		}
+		md.Capacity = capacity
+		md.Topology = topology
+		return true
+	})
+}
+
+func (mgr *volumeMgr) SetDraining(ctx context.Context, id vanus.ID, draining bool) error {
+	return mgr.updateVolume(ctx, id, func(md *metadata.VolumeMetadata) bool {
+		if md.Draining == draining {
+			return false
+		}
+		md.Draining = draining
+		return true
+	})
+}
+
+// updateVolume persists the metadata of volume modified by f, the cached metadata is modified only if
+// it has been persisted. f returns false if nothing need to change.
+func (mgr *volumeMgr) updateVolume(
+	ctx context.Context, id vanus.ID, f func(md *metadata.VolumeMetadata) bool,
+) error {
+	ins := mgr.GetVolumeInstanceByID(id)
+	if ins == nil {
+		return errors.ErrVolumeInstanceNotFound
+	}
+	md := ins.GetMeta()
+
+	// Blocks are stored separately.
+	newMD := *md
+	newMD.Blocks = nil
+	if !f(&newMD) {
+		return nil
+	}
+	data, _ := json.Marshal(&newMD)
+	key := filepath.Join(metadata.VolumeKeyPrefixInKVStore, md.ID.String())
+	if err := mgr.kvCli.Set(ctx, key, data); err != nil {
+		return err
+	}
+	f(md)
+	return nil
+}
+
 func (mgr *volumeMgr) Init(ctx context.Context, kvClient kv.Client) error {
 	mgr.kvCli = kvClient
 
@@ -98,6 +156,8 @@ func (mgr *volumeMgr) Init(ctx context.Context, kvClient kv.Client) error {
 			}
 			md.Blocks[bl.ID.Uint64()] = bl
 		}
+		// Used isn't persisted when creating or deleting blocks, so it is recomputed from blocks.
+		md.Used = usedCapacity(md.Blocks)
 
 		ins := server.NewInstance(md)
 		mgr.volInstanceMap.Store(md.ID.Key(), ins)
@@ -182,6 +242,16 @@ func (mgr *volumeMgr) GetAllActiveVolumes() []server.Instance {
 	return results
 }
 
+func (mgr *volumeMgr) GetAllVolumes() []server.Instance {
+	results := make([]server.Instance, 0)
+	mgr.volInstanceMap.Range(func(key, value interface{}) bool {
+		ins, _ := value.(server.Instance)
+		results = append(results, ins)
+		return true
+	})
+	return results
+}
+
 func (mgr *volumeMgr) UpdateRouting(ctx context.Context, ins server.Instance, srv server.Server) {
 	key := filepath.Join(metadata.VolumeInstanceKeyPrefixInKVStore, ins.ID().String())
 	if srv == nil {
@@ -214,6 +284,38 @@ func (mgr *volumeMgr) UpdateRouting(ctx context.Context, ins server.Instance, sr
 	mgr.volInstanceMap.Store(ins.ID().Key(), ins)
 }
 
+// usedCapacity returns the total capacity of blocks which aren't offloaded.
+func usedCapacity(blocks map[uint64]*metadata.Block) int64 {
+	var used int64
+	for _, bl := range blocks {
+		if !bl.Offloaded {
+			used += bl.Capacity
+		}
+	}
+	return used
+}
+
+func (mgr *volumeMgr) MarkBlockOffloaded(ctx context.Context, blk *metadata.Block) error {
+	if blk.Offloaded {
+		return nil
+	}
+	ins := mgr.GetVolumeInstanceByID(blk.VolumeID)
+	if ins == nil {
+		return errors.ErrVolumeInstanceNotFound
+	}
+
+	newBlk := *blk
+	newBlk.Offloaded = true
+	data, _ := json.Marshal(&newBlk)
+	key := filepath.Join(metadata.BlockKeyPrefixInKVStore, blk.VolumeID.Key(), blk.ID.String())
+	if err := mgr.kvCli.Set(ctx, key, data); err != nil {
+		return err
+	}
+	blk.Offloaded = true
+	ins.MarkBlockOffloaded(blk.ID)
+	return nil
+}
+
 func (mgr *volumeMgr) GetBlocksOfVolume(ctx context.Context,
 	instance server.Instance,
 ) (map[uint64]*metadata.Block, error) {
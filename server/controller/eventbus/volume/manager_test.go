@@ -29,6 +29,7 @@ import (
 
 	// first-party libraries.
 	"github.com/vanus-labs/vanus/api/errors"
+	vanus "github.com/vanus-labs/vanus/api/vsr"
 	"github.com/vanus-labs/vanus/lib/sync"
 
 	// this project.
@@ -60,7 +61,8 @@ func TestVolumeMgr_Init(t *testing.T) {
 			volume3 := &metadata.VolumeMetadata{
 				ID:       snowflake.NewTestID(),
 				Capacity: 64 * 1024 * 1024 * 1024,
-				Used:     0,
+				// the stale used capacity is recomputed from blocks.
+				Used: 64 * 1024 * 1024,
 			}
 			data1, _ := stdJson.Marshal(volume1)
 			data2, _ := stdJson.Marshal(volume2)
@@ -138,9 +140,10 @@ func TestVolumeMgr_Init(t *testing.T) {
 				VolumeID: volume2.ID,
 			}
 			block3 = metadata.Block{
-				ID:       snowflake.NewTestID(),
-				Capacity: 64 * 1024 * 1024,
-				VolumeID: volume2.ID,
+				ID:        snowflake.NewTestID(),
+				Capacity:  64 * 1024 * 1024,
+				VolumeID:  volume2.ID,
+				Offloaded: true,
 			}
 			data1, _ = stdJson.Marshal(block1)
 			data2, _ = stdJson.Marshal(block2)
@@ -177,6 +180,7 @@ func TestVolumeMgr_Init(t *testing.T) {
 			So(vol1.ID(), ShouldEqual, volume1.ID)
 			So(vol1.Address(), ShouldBeEmpty)
 			So(vol1.GetMeta().Blocks, ShouldHaveLength, 4)
+			So(vol1.GetMeta().Used, ShouldEqual, 4*64*1024*1024)
 
 			v, exist = mgr.volInstanceMap.Load(volume2.ID.Key())
 			So(exist, ShouldBeTrue)
@@ -184,6 +188,8 @@ func TestVolumeMgr_Init(t *testing.T) {
 			So(vol2.ID(), ShouldEqual, volume2.ID)
 			So(vol2.Address(), ShouldBeEmpty)
 			So(vol2.GetMeta().Blocks, ShouldHaveLength, 3)
+			// the offloaded block takes no space.
+			So(vol2.GetMeta().Used, ShouldEqual, 2*64*1024*1024)
 
 			v, exist = mgr.volInstanceMap.Load(volume3.ID.Key())
 			So(exist, ShouldBeTrue)
@@ -191,6 +197,7 @@ func TestVolumeMgr_Init(t *testing.T) {
 			So(vol3.ID(), ShouldEqual, volume3.ID)
 			So(vol3.Address(), ShouldBeEmpty)
 			So(vol3.GetMeta().Blocks, ShouldHaveLength, 0)
+			So(vol3.GetMeta().Used, ShouldEqual, 0)
 			So(sync.MapLen(&mgr.volInstanceMap), ShouldEqual, 3)
 		})
 
@@ -198,13 +205,11 @@ func TestVolumeMgr_Init(t *testing.T) {
 			volume1 := &metadata.VolumeMetadata{
 				ID:       snowflake.NewTestID(),
 				Capacity: 64 * 1024 * 1024 * 1024,
-				Used:     4 * 64 * 1024 * 1024,
 				Blocks:   map[uint64]*metadata.Block{},
 			}
 			volume2 := &metadata.VolumeMetadata{
 				ID:       snowflake.NewTestID(),
 				Capacity: 64 * 1024 * 1024 * 1024,
-				Used:     3 * 64 * 1024 * 1024,
 				Blocks:   map[uint64]*metadata.Block{},
 			}
 			data1, _ := stdJson.Marshal(volume1)
@@ -351,6 +356,72 @@ func TestVolumeMgr_RegisterVolume(t *testing.T) {
 	})
 }
 
+func TestVolumeMgr_UpdateVolume(t *testing.T) {
+	Convey("test update volume", t, func() {
+		ctrl := gomock.NewController(t)
+		kvCli := kv.NewMockClient(ctrl)
+		srvMgr := server.NewMockManager(ctrl)
+		mgr := &volumeMgr{serverMgr: srvMgr, kvCli: kvCli}
+		ctx := stdCtx.Background()
+
+		volume := &metadata.VolumeMetadata{
+			ID:       snowflake.NewTestID(),
+			Capacity: 64 * 1024 * 1024 * 1024,
+			Blocks: map[uint64]*metadata.Block{
+				1: {ID: vanus.NewIDFromUint64(1)},
+			},
+		}
+		topology := metadata.Topology{Zone: "z1", Rack: "r1", Host: "h1"}
+
+		Convey("test volume not found", func() {
+			err := mgr.UpdateVolume(ctx, volume.ID, volume.Capacity, topology)
+			So(errors.Is(err, errors.ErrVolumeInstanceNotFound), ShouldBeTrue)
+		})
+
+		mgr.volInstanceMap.Store(volume.ID.Key(), server.NewInstance(volume))
+		Convey("test nothing changed", func() {
+			err := mgr.UpdateVolume(ctx, volume.ID, volume.Capacity, metadata.Topology{})
+			So(err, ShouldBeNil)
+		})
+
+		Convey("test update topology", func() {
+			expected := &metadata.VolumeMetadata{
+				ID:       volume.ID,
+				Capacity: volume.Capacity,
+				Topology: topology,
+			}
+			data, _ := stdJson.Marshal(expected)
+			key := filepath.Join(metadata.VolumeKeyPrefixInKVStore, volume.ID.String())
+			kvCli.EXPECT().Set(ctx, key, data).Times(1).Return(nil)
+			err := mgr.UpdateVolume(ctx, volume.ID, volume.Capacity, topology)
+			So(err, ShouldBeNil)
+			So(volume.Topology, ShouldResemble, topology)
+			So(volume.Blocks, ShouldHaveLength, 1)
+
+			kvCli.EXPECT().Set(ctx, key, gomock.Any()).Times(1).Return(errors.ErrInternal)
+			err = mgr.UpdateVolume(ctx, volume.ID, 2*volume.Capacity, topology)
+			So(err, ShouldNotBeNil)
+			So(volume.Capacity, ShouldEqual, 64*1024*1024*1024)
+		})
+
+		Convey("test set draining", func() {
+			key := filepath.Join(metadata.VolumeKeyPrefixInKVStore, volume.ID.String())
+			kvCli.EXPECT().Set(ctx, key, gomock.Any()).Times(2).DoAndReturn(
+				func(_ stdCtx.Context, _ string, data []byte) error {
+					md := &metadata.VolumeMetadata{}
+					So(stdJson.Unmarshal(data, md), ShouldBeNil)
+					So(md.Blocks, ShouldBeNil)
+					return nil
+				})
+			So(mgr.SetDraining(ctx, volume.ID, true), ShouldBeNil)
+			So(volume.Draining, ShouldBeTrue)
+			So(mgr.SetDraining(ctx, volume.ID, true), ShouldBeNil)
+			So(mgr.SetDraining(ctx, volume.ID, false), ShouldBeNil)
+			So(volume.Draining, ShouldBeFalse)
+		})
+	})
+}
+
 func TestVolumeMgr_GetAllActiveVolumes(t *testing.T) {
 	Convey("test register volume", t, func() {
 		ctrl := gomock.NewController(t)
@@ -57,6 +57,20 @@ func (mr *MockManagerMockRecorder) GetAllActiveVolumes() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllActiveVolumes", reflect.TypeOf((*MockManager)(nil).GetAllActiveVolumes))
 }
 
+// GetAllVolumes mocks base method.
+func (m *MockManager) GetAllVolumes() []server.Instance {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllVolumes")
+	ret0, _ := ret[0].([]server.Instance)
+	return ret0
+}
+
+// GetAllVolumes indicates an expected call of GetAllVolumes.
+func (mr *MockManagerMockRecorder) GetAllVolumes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllVolumes", reflect.TypeOf((*MockManager)(nil).GetAllVolumes))
+}
+
 // GetBlocksOfVolume mocks base method.
 func (m *MockManager) GetBlocksOfVolume(ctx context.Context, instance server.Instance) (map[uint64]*metadata.Block, error) {
 	m.ctrl.T.Helper()
@@ -114,6 +128,20 @@ func (mr *MockManagerMockRecorder) LookupVolumeByID(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupVolumeByID", reflect.TypeOf((*MockManager)(nil).LookupVolumeByID), id)
 }
 
+// MarkBlockOffloaded mocks base method.
+func (m *MockManager) MarkBlockOffloaded(ctx context.Context, blk *metadata.Block) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkBlockOffloaded", ctx, blk)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkBlockOffloaded indicates an expected call of MarkBlockOffloaded.
+func (mr *MockManagerMockRecorder) MarkBlockOffloaded(ctx, blk any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkBlockOffloaded", reflect.TypeOf((*MockManager)(nil).MarkBlockOffloaded), ctx, blk)
+}
+
 // RegisterVolume mocks base method.
 func (m *MockManager) RegisterVolume(ctx context.Context, md *metadata.VolumeMetadata) (server.Instance, error) {
 	m.ctrl.T.Helper()
@@ -129,6 +157,20 @@ func (mr *MockManagerMockRecorder) RegisterVolume(ctx, md any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterVolume", reflect.TypeOf((*MockManager)(nil).RegisterVolume), ctx, md)
 }
 
+// SetDraining mocks base method.
+func (m *MockManager) SetDraining(ctx context.Context, id vsr.ID, draining bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDraining", ctx, id, draining)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDraining indicates an expected call of SetDraining.
+func (mr *MockManagerMockRecorder) SetDraining(ctx, id, draining any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDraining", reflect.TypeOf((*MockManager)(nil).SetDraining), ctx, id, draining)
+}
+
 // UpdateRouting mocks base method.
 func (m *MockManager) UpdateRouting(ctx context.Context, ins server.Instance, srv server.Server) {
 	m.ctrl.T.Helper()
@@ -140,3 +182,17 @@ func (mr *MockManagerMockRecorder) UpdateRouting(ctx, ins, srv any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRouting", reflect.TypeOf((*MockManager)(nil).UpdateRouting), ctx, ins, srv)
 }
+
+// UpdateVolume mocks base method.
+func (m *MockManager) UpdateVolume(ctx context.Context, id vsr.ID, capacity int64, topology metadata.Topology) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVolume", ctx, id, capacity, topology)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVolume indicates an expected call of UpdateVolume.
+func (mr *MockManagerMockRecorder) UpdateVolume(ctx, id, capacity, topology any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolume", reflect.TypeOf((*MockManager)(nil).UpdateVolume), ctx, id, capacity, topology)
+}
@@ -62,7 +62,7 @@ func NewController(config Config, mem member.Member) *controller {
 	ctrl := &controller{
 		config:                config,
 		member:                mem,
-		needCleanSubscription: map[vanus.ID]string{},
+		needCleanSubscription: map[vanus.ID][]string{},
 		state:                 primitive.ServerStateCreated,
 		cl:                    cluster.NewClusterController(config.ControllerAddr, insecure.NewCredentials()),
 		ebClient:              eb.Connect(config.ControllerAddr),
@@ -79,7 +79,7 @@ type controller struct {
 	subscriptionManager   subscription.Manager
 	workerManager         worker.Manager
 	scheduler             *worker.SubscriptionScheduler
-	needCleanSubscription map[vanus.ID]string
+	needCleanSubscription map[vanus.ID][]string
 	lock                  sync.Mutex
 	membershipMutex       sync.Mutex
 	isLeader              bool
@@ -118,6 +118,20 @@ func (ctrl *controller) GetDeadLetterEventOffset(
 	return &ctrlpb.GetDeadLetterEventOffsetResponse{Offset: offset}, err
 }
 
+func (ctrl *controller) GetSubscriptionLag(
+	ctx context.Context, request *ctrlpb.GetSubscriptionLagRequest,
+) (*ctrlpb.GetSubscriptionLagResponse, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	subID := vanus.ID(request.SubscriptionId)
+	lag, err := ctrl.subscriptionManager.GetLag(ctx, subID)
+	if err != nil {
+		return nil, err
+	}
+	return convert.ToPbSubscriptionLag(lag), nil
+}
+
 func (ctrl *controller) CommitOffset(
 	ctx context.Context, request *ctrlpb.CommitOffsetRequest,
 ) (*ctrlpb.CommitOffsetResponse, error) {
@@ -290,14 +304,14 @@ func (ctrl *controller) DeleteSubscription(
 		if err != nil {
 			return nil, err
 		}
-		go func(subID vanus.ID, addr string) {
-			err := ctrl.gcSubscription(ctrl.ctx, subID, addr)
+		go func(subID vanus.ID, addrs []string) {
+			err := ctrl.gcSubscription(ctrl.ctx, subID, addrs)
 			if err != nil {
 				ctrl.lock.Lock()
 				defer ctrl.lock.Unlock()
-				ctrl.needCleanSubscription[subID] = addr
+				ctrl.needCleanSubscription[subID] = addrs
 			}
-		}(subID, sub.TriggerWorker)
+		}(subID, sub.TriggerWorkers())
 	}
 	return &emptypb.Empty{}, nil
 }
@@ -428,6 +442,7 @@ func (ctrl *controller) triggerWorkerHeartbeatRequest(
 			Msg("unknown trigger worker")
 		return errors.ErrResourceNotFound.WithMessage("unknown trigger worker")
 	}
+	ctrl.workerManager.UpdateTriggerWorkerLoad(req.Address, convert.FromPbSubscriptionLoads(req.SubscriptionLoad))
 	for _, subInfo := range req.SubscriptionInfo {
 		if len(subInfo.Offsets) == 0 {
 			continue
@@ -496,12 +511,14 @@ func (ctrl *controller) ListSubscription(
 // 1.trigger worker remove subscription
 // 2.delete offset
 // 3.delete subscription .
-func (ctrl *controller) gcSubscription(ctx context.Context, id vanus.ID, addr string) error {
-	tWorker := ctrl.workerManager.GetTriggerWorker(addr)
-	if tWorker != nil {
-		err := tWorker.UnAssignSubscription(id)
-		if err != nil {
-			return err
+func (ctrl *controller) gcSubscription(ctx context.Context, id vanus.ID, addrs []string) error {
+	for _, addr := range addrs {
+		tWorker := ctrl.workerManager.GetTriggerWorker(addr)
+		if tWorker != nil {
+			err := tWorker.UnAssignSubscription(id)
+			if err != nil {
+				return err
+			}
 		}
 	}
 	err := ctrl.subscriptionManager.DeleteSubscription(ctx, id)
@@ -515,8 +532,8 @@ func (ctrl *controller) gcSubscriptions(ctx context.Context) {
 	primitive.UntilWithContext(ctx, func(ctx context.Context) {
 		ctrl.lock.Lock()
 		defer ctrl.lock.Unlock()
-		for ID, addr := range ctrl.needCleanSubscription {
-			err := ctrl.gcSubscription(ctx, ID, addr)
+		for ID, addrs := range ctrl.needCleanSubscription {
+			err := ctrl.gcSubscription(ctx, ID, addrs)
 			if err == nil {
 				delete(ctrl.needCleanSubscription, ID)
 			}
@@ -529,23 +546,24 @@ func (ctrl *controller) requeueSubscription(ctx context.Context, id vanus.ID, ad
 	if sub == nil {
 		return nil
 	}
-	if sub.TriggerWorker != addr {
+	if !sub.HasTriggerWorker(addr) {
 		// data is not consistent, record
 		log.Error(ctx).
-			Str(log.KeyTriggerWorkerAddr, sub.TriggerWorker).
+			Strs(log.KeyTriggerWorkerAddr, sub.TriggerWorkers()).
 			Str("runningAddr", addr).
 			Msg("requeue subscription invalid")
 	}
 	switch sub.Phase {
 	case metadata.SubscriptionPhaseCreated, metadata.SubscriptionPhaseRunning, metadata.SubscriptionPhasePending:
-		sub.TriggerWorker = ""
+		// the shards run by other trigger workers are kept.
+		sub.ReplaceTriggerWorker(addr, "")
 		sub.Phase = metadata.SubscriptionPhasePending
 		err := ctrl.subscriptionManager.UpdateSubscription(ctx, sub)
 		if err != nil {
 			return err
 		}
 	}
-	metrics.CtrlTriggerGauge.WithLabelValues(sub.TriggerWorker).Dec()
+	metrics.CtrlTriggerGauge.WithLabelValues(addr).Dec()
 	ctrl.scheduler.EnqueueSubscription(id)
 	return nil
 }
@@ -568,7 +586,7 @@ func (ctrl *controller) init(ctx context.Context) error {
 		case metadata.SubscriptionPhasePending, metadata.SubscriptionPhaseStopping:
 			ctrl.scheduler.EnqueueSubscription(sub.ID)
 		case metadata.SubscriptionPhaseToDelete:
-			ctrl.needCleanSubscription[sub.ID] = sub.TriggerWorker
+			ctrl.needCleanSubscription[sub.ID] = sub.TriggerWorkers()
 		}
 	}
 	return nil
@@ -25,7 +25,9 @@ import (
 	"go.uber.org/mock/gomock"
 
 	ctrlpb "github.com/vanus-labs/vanus/api/controller"
+	"github.com/vanus-labs/vanus/api/errors"
 	metapb "github.com/vanus-labs/vanus/api/meta"
+	vanus "github.com/vanus-labs/vanus/api/vsr"
 
 	primitive "github.com/vanus-labs/vanus/pkg"
 	"github.com/vanus-labs/vanus/pkg/info"
@@ -112,6 +114,53 @@ func TestController_ResetOffsetToTimestamp(t *testing.T) {
 	})
 }
 
+func TestController_GetSubscriptionLag(t *testing.T) {
+	Convey("test get subscription lag", t, func() {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ctrl := NewController(Config{}, nil)
+		ctx := context.Background()
+		subManager := subscription.NewMockManager(mockCtrl)
+		ctrl.subscriptionManager = subManager
+
+		subID := snowflake.NewTestID()
+		ctrl.state = primitive.ServerStateRunning
+		Convey("get lag subscription not exist", func() {
+			subManager.EXPECT().GetLag(gomock.Any(), subID).Return(nil, errors.ErrResourceNotFound)
+			_, err := ctrl.GetSubscriptionLag(ctx, &ctrlpb.GetSubscriptionLagRequest{
+				SubscriptionId: subID.Uint64(),
+			})
+			So(err, ShouldEqual, errors.ErrResourceNotFound)
+		})
+		Convey("get lag", func() {
+			logID := snowflake.NewTestID()
+			subManager.EXPECT().GetLag(gomock.Any(), subID).Return(&metadata.SubscriptionLag{
+				SubscriptionID: subID,
+				Eventlogs: []metadata.EventlogLag{{
+					EventlogID:      logID,
+					CommittedOffset: 10,
+					LatestOffset:    15,
+					Lag:             5,
+					LagTime:         time.Minute,
+				}},
+				Lag:     5,
+				LagTime: time.Minute,
+			}, nil)
+			resp, err := ctrl.GetSubscriptionLag(ctx, &ctrlpb.GetSubscriptionLagRequest{
+				SubscriptionId: subID.Uint64(),
+			})
+			So(err, ShouldBeNil)
+			So(resp.SubscriptionId, ShouldEqual, subID.Uint64())
+			So(resp.Lag, ShouldEqual, 5)
+			So(resp.LagTime, ShouldEqual, time.Minute.Milliseconds())
+			So(resp.Eventlogs, ShouldHaveLength, 1)
+			So(resp.Eventlogs[0].EventlogId, ShouldEqual, logID.Uint64())
+			So(resp.Eventlogs[0].CommittedOffset, ShouldEqual, 10)
+			So(resp.Eventlogs[0].LatestOffset, ShouldEqual, 15)
+		})
+	})
+}
+
 func TestController_CreateSubscription(t *testing.T) {
 	Convey("test create subscription", t, func() {
 		mockCtrl := gomock.NewController(t)
@@ -533,6 +582,9 @@ func TestController_TriggerWorkerHeartbeat(t *testing.T) {
 		request := &ctrlpb.TriggerWorkerHeartbeatRequest{
 			Address:          "test",
 			SubscriptionInfo: []*metapb.SubscriptionInfo{sub1, sub2, sub3},
+			SubscriptionLoad: []*ctrlpb.SubscriptionLoad{
+				{SubscriptionId: subID1.Uint64(), Throughput: 10, Lag: 5, Goroutines: 2},
+			},
 		}
 		subManager.EXPECT().Heartbeat(gomock.Any(), gomock.Eq(subID1), request.Address,
 			gomock.Any()).AnyTimes().Return(fmt.Errorf("error"))
@@ -546,6 +598,10 @@ func TestController_TriggerWorkerHeartbeat(t *testing.T) {
 		})
 		Convey("heartbeat success", func() {
 			workerManager.EXPECT().UpdateTriggerWorkerInfo(gomock.Any(), gomock.Eq(request.Address)).Return(nil)
+			workerManager.EXPECT().UpdateTriggerWorkerLoad(gomock.Eq(request.Address),
+				gomock.Eq(map[vanus.ID]metadata.SubscriptionLoad{
+					subID1: {Throughput: 10, Lag: 5, Goroutines: 2},
+				}))
 			subManager.EXPECT().SaveOffset(gomock.Any(), gomock.Eq(subID1), gomock.Any(), false).Return(nil)
 			subManager.EXPECT().SaveOffset(gomock.Any(), gomock.Eq(subID2),
 				gomock.Any(), false).Return(fmt.Errorf("error"))
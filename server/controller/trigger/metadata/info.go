@@ -37,6 +37,18 @@ type TriggerWorkerInfo struct {
 	ID    string             `json:"-"`
 	Addr  string             `json:"addr"`
 	Phase TriggerWorkerPhase `json:"phase"`
+	// Load is reported by the trigger worker heartbeat, keyed by subscription.
+	Load map[vanus.ID]SubscriptionLoad `json:"-"`
+}
+
+// SubscriptionLoad is the load of a subscription running on a trigger worker.
+type SubscriptionLoad struct {
+	// Throughput is the number of events received per second.
+	Throughput float64
+	// Lag is the number of events read but not yet delivered.
+	Lag uint64
+	// Goroutines is the number of running delivery goroutines.
+	Goroutines int
 }
 
 func NewTriggerWorkerInfo(addr string) *TriggerWorkerInfo {
@@ -88,7 +100,87 @@ type Subscription struct {
 	TimerEventbusID      vanus.ID          `json:"timer_eventbus_id"`
 	Phase                SubscriptionPhase `json:"phase"`
 	TriggerWorker        string            `json:"trigger_worker,omitempty"`
-	HeartbeatTime        time.Time         `json:"-"`
+	// ShardWorkers are the trigger workers of shards except the first one, which
+	// runs on TriggerWorker.
+	ShardWorkers  []string  `json:"shard_workers,omitempty"`
+	HeartbeatTime time.Time `json:"-"`
+}
+
+// GetShardWorker returns the trigger worker of the shard, it is empty if not assigned.
+func (s *Subscription) GetShardWorker(shard uint32) string {
+	if shard == 0 {
+		return s.TriggerWorker
+	}
+	if int(shard) > len(s.ShardWorkers) {
+		return ""
+	}
+	return s.ShardWorkers[shard-1]
+}
+
+// SetShardWorker assigns the shard to the trigger worker.
+func (s *Subscription) SetShardWorker(shard uint32, addr string) {
+	if shard == 0 {
+		s.TriggerWorker = addr
+		return
+	}
+	for len(s.ShardWorkers) < int(shard) {
+		s.ShardWorkers = append(s.ShardWorkers, "")
+	}
+	s.ShardWorkers[shard-1] = addr
+}
+
+// TriggerWorkers returns the distinct trigger workers which run shards of the subscription.
+func (s *Subscription) TriggerWorkers() []string {
+	var addrs []string
+	for shard := uint32(0); shard < s.Config.GetShards(); shard++ {
+		addr := s.GetShardWorker(shard)
+		if addr == "" || containsWorker(addrs, addr) {
+			continue
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs
+}
+
+func containsWorker(addrs []string, addr string) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// HasTriggerWorker reports whether the trigger worker runs any shard of the subscription.
+func (s *Subscription) HasTriggerWorker(addr string) bool {
+	return containsWorker(s.TriggerWorkers(), addr)
+}
+
+// WorkerShards returns the shards run by the trigger worker.
+func (s *Subscription) WorkerShards(addr string) []uint32 {
+	var shards []uint32
+	for shard := uint32(0); shard < s.Config.GetShards(); shard++ {
+		if s.GetShardWorker(shard) == addr {
+			shards = append(shards, shard)
+		}
+	}
+	return shards
+}
+
+// ReplaceTriggerWorker moves the shards run by the trigger worker to another one, the
+// shards become unassigned if to is empty.
+func (s *Subscription) ReplaceTriggerWorker(from, to string) {
+	for shard := uint32(0); shard < s.Config.GetShards(); shard++ {
+		if s.GetShardWorker(shard) == from {
+			s.SetShardWorker(shard, to)
+		}
+	}
+}
+
+// ClearTriggerWorkers un-assigns all shards.
+func (s *Subscription) ClearTriggerWorkers() {
+	s.TriggerWorker = ""
+	s.ShardWorkers = nil
 }
 
 // Update property change from api .
@@ -145,3 +237,24 @@ func (s *Subscription) Update(update *Subscription) bool {
 	}
 	return change
 }
+
+// SubscriptionLag is how far a subscription falls behind the events of its eventbus.
+type SubscriptionLag struct {
+	SubscriptionID vanus.ID
+	Eventlogs      []EventlogLag
+	// Lag is the sum of lag of all eventlogs.
+	Lag uint64
+	// LagTime is the max lag time of all eventlogs.
+	LagTime time.Duration
+}
+
+// EventlogLag is how far a subscription falls behind the events of an eventlog.
+type EventlogLag struct {
+	EventlogID      vanus.ID
+	CommittedOffset uint64
+	LatestOffset    uint64
+	// Lag is the number of events which haven't been consumed.
+	Lag uint64
+	// LagTime is the duration since the first unconsumed event was written.
+	LagTime time.Duration
+}
@@ -97,6 +97,27 @@ func (p *SecretStorage) Read(ctx context.Context,
 			return nil, errors.ErrAESDecrypt.Wrap(err)
 		}
 		return primitive.NewPlainSinkCredential(identifier, secret), nil
+	case primitive.HMAC:
+		credential := &primitive.HMACSinkCredential{}
+		if err = json.Unmarshal(v, credential); err != nil {
+			return nil, errors.ErrJSONUnMarshal.Wrap(err)
+		}
+		secret, err := aes.Decrypt(credential.Secret, p.cipherKey)
+		if err != nil {
+			return nil, errors.ErrAESDecrypt.Wrap(err)
+		}
+		return primitive.NewHMACSinkCredential(secret), nil
+	case primitive.OAuth2:
+		credential := &primitive.OAuth2SinkCredential{}
+		if err = json.Unmarshal(v, credential); err != nil {
+			return nil, errors.ErrJSONUnMarshal.Wrap(err)
+		}
+		clientSecret, err := aes.Decrypt(credential.ClientSecret, p.cipherKey)
+		if err != nil {
+			return nil, errors.ErrAESDecrypt.Wrap(err)
+		}
+		credential.ClientSecret = clientSecret
+		return credential, nil
 	}
 	return nil, errors.ErrInvalidRequest.WithMessage("unknown credential type")
 }
@@ -133,6 +154,21 @@ func (p *SecretStorage) Write(ctx context.Context, subID vanus.ID, credential pr
 			return errors.ErrAESEncrypt.Wrap(err)
 		}
 		save = primitive.NewPlainSinkCredential(identifier, s)
+	case primitive.HMAC:
+		hmac, _ := credential.(*primitive.HMACSinkCredential)
+		s, err := aes.Encrypt(hmac.Secret, p.cipherKey)
+		if err != nil {
+			return errors.ErrAESEncrypt.Wrap(err)
+		}
+		save = primitive.NewHMACSinkCredential(s)
+	case primitive.OAuth2:
+		oauth2, _ := credential.(*primitive.OAuth2SinkCredential)
+		clientSecret, err := aes.Encrypt(oauth2.ClientSecret, p.cipherKey)
+		if err != nil {
+			return errors.ErrAESEncrypt.Wrap(err)
+		}
+		// only the client secret is sensitive.
+		save = primitive.NewOAuth2SinkCredential(oauth2.TokenURL, oauth2.ClientID, clientSecret, oauth2.Scopes)
 	default:
 		return errors.ErrInvalidRequest.WithMessage("unknown credential type")
 	}
@@ -99,6 +99,44 @@ func TestSecretStorage(t *testing.T) {
 				So(err, ShouldBeNil)
 			})
 		})
+		Convey("test credential type hmac", func() {
+			subID := snowflake.NewTestID()
+			Convey("test read", func() {
+				s, _ := aes.Encrypt("test_secret", secret.cipherKey)
+				v, _ := json.Marshal(primitive.NewHMACSinkCredential(s))
+				kvClient.EXPECT().Get(ctx, secret.getKey(subID)).Return(v, nil)
+				credential, err := secret.Read(ctx, subID, primitive.HMAC)
+				So(err, ShouldBeNil)
+				So(credential.GetType(), ShouldEqual, primitive.HMAC)
+				So(credential.(*primitive.HMACSinkCredential).Secret, ShouldEqual, "test_secret")
+			})
+			Convey("test write", func() {
+				credential := primitive.NewHMACSinkCredential("test_secret")
+				kvClient.EXPECT().Set(ctx, secret.getKey(subID), gomock.Any()).Return(nil)
+				err := secret.Write(ctx, subID, credential)
+				So(err, ShouldBeNil)
+			})
+		})
+		Convey("test credential type oauth2", func() {
+			subID := snowflake.NewTestID()
+			Convey("test read", func() {
+				s, _ := aes.Encrypt("test_client_secret", secret.cipherKey)
+				v, _ := json.Marshal(primitive.NewOAuth2SinkCredential("http://example.com/token",
+					"test_client_id", s, []string{"scope"}))
+				kvClient.EXPECT().Get(ctx, secret.getKey(subID)).Return(v, nil)
+				credential, err := secret.Read(ctx, subID, primitive.OAuth2)
+				So(err, ShouldBeNil)
+				So(credential, ShouldResemble, primitive.NewOAuth2SinkCredential("http://example.com/token",
+					"test_client_id", "test_client_secret", []string{"scope"}))
+			})
+			Convey("test write", func() {
+				credential := primitive.NewOAuth2SinkCredential("http://example.com/token",
+					"test_client_id", "test_client_secret", nil)
+				kvClient.EXPECT().Set(ctx, secret.getKey(subID), gomock.Any()).Return(nil)
+				err := secret.Write(ctx, subID, credential)
+				So(err, ShouldBeNil)
+			})
+		})
 		Convey("test delete", func() {
 			subID := snowflake.NewTestID()
 			kvClient.EXPECT().Delete(ctx, secret.getKey(subID)).Return(nil)
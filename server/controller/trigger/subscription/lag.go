@@ -0,0 +1,174 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscription
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/vanus-labs/vanus/api/errors"
+	segpb "github.com/vanus-labs/vanus/api/segment"
+	vanus "github.com/vanus-labs/vanus/api/vsr"
+	"github.com/vanus-labs/vanus/client/pkg/api"
+	"github.com/vanus-labs/vanus/client/pkg/option"
+	"github.com/vanus-labs/vanus/client/pkg/policy"
+
+	"github.com/vanus-labs/vanus/pkg/observability/log"
+	"github.com/vanus-labs/vanus/pkg/observability/metrics"
+	"github.com/vanus-labs/vanus/server/controller/trigger/metadata"
+)
+
+const (
+	defaultLagInterval = 30 * time.Second
+	// lagMetricsTimeout bounds the lag of a subscription, so an unresponsive store server can't stall
+	// the lag metrics of others.
+	lagMetricsTimeout = 10 * time.Second
+	readStimeTimeout  = 3 * time.Second
+)
+
+func (m *manager) GetLag(ctx context.Context, id vanus.ID) (*metadata.SubscriptionLag, error) {
+	subscription := m.GetSubscription(ctx, id)
+	if subscription == nil {
+		return nil, errors.ErrResourceNotFound
+	}
+	offsets, err := m.offsetManager.GetOffset(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	committed := make(map[vanus.ID]uint64, len(offsets))
+	for _, offset := range offsets {
+		committed[offset.EventlogID] = offset.Offset
+	}
+	bus := m.ebCli.Eventbus(ctx, api.WithID(subscription.EventbusID.Uint64()))
+	logs, err := bus.ListLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lag := &metadata.SubscriptionLag{
+		SubscriptionID: id,
+		Eventlogs:      make([]metadata.EventlogLag, 0, len(logs)),
+	}
+	now := time.Now()
+	for _, l := range logs {
+		elLag := metadata.EventlogLag{EventlogID: vanus.NewIDFromUint64(l.ID())}
+		latest, err := l.LatestOffset(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if latest > 0 {
+			elLag.LatestOffset = uint64(latest)
+		}
+		offset, exist := committed[elLag.EventlogID]
+		if !exist {
+			// the trigger worker starts from the earliest offset of the eventlog without offset.
+			earliest, err := l.EarliestOffset(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if earliest > 0 {
+				offset = uint64(earliest)
+			}
+		}
+		elLag.CommittedOffset = offset
+		if elLag.LatestOffset > offset {
+			elLag.Lag = elLag.LatestOffset - offset
+			stime, err := readStime(ctx, bus, l, offset)
+			if err != nil {
+				log.Warn(ctx).Err(err).
+					Stringer(log.KeySubscriptionID, id).
+					Stringer(log.KeyEventlogID, elLag.EventlogID).
+					Uint64("offset", offset).
+					Msg("read the stime of the first unconsumed event failed")
+			} else if now.After(stime) {
+				elLag.LagTime = now.Sub(stime)
+			}
+		}
+		lag.Eventlogs = append(lag.Eventlogs, elLag)
+		lag.Lag += elLag.Lag
+		if elLag.LagTime > lag.LagTime {
+			lag.LagTime = elLag.LagTime
+		}
+	}
+	return lag, nil
+}
+
+// readStime returns the time when the event at the offset was written to the eventlog.
+func readStime(ctx context.Context, bus api.Eventbus, l api.Eventlog, offset uint64) (time.Time, error) {
+	ctx, cancel := context.WithTimeout(ctx, readStimeTimeout)
+	defer cancel()
+	reader := bus.Reader(
+		option.WithDisablePolling(),
+		option.WithReadPolicy(policy.NewManuallyReadPolicy(l, int64(offset))),
+		option.WithBatchSize(1),
+	)
+	batch, _, _, err := reader.Read(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(batch.GetEvents()) == 0 {
+		return time.Time{}, errors.ErrOffsetOnEnd
+	}
+	attr, ok := batch.GetEvents()[0].GetAttributes()[segpb.XVanusStime]
+	if !ok {
+		return time.Time{}, errors.ErrInternal.WithMessage("the event has no stime")
+	}
+	return attr.GetCeTimestamp().AsTime(), nil
+}
+
+// runLagMetrics refreshes the lag metrics of all subscriptions periodically.
+func (m *manager) runLagMetrics(ctx context.Context) {
+	ticker := time.NewTicker(defaultLagInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, subscription := range m.ListSubscription(ctx) {
+				m.updateLagMetrics(ctx, subscription)
+			}
+		}
+	}
+}
+
+func (m *manager) updateLagMetrics(ctx context.Context, subscription *metadata.Subscription) {
+	ctx, cancel := context.WithTimeout(ctx, lagMetricsTimeout)
+	defer cancel()
+	lag, err := m.GetLag(ctx, subscription.ID)
+	if err != nil {
+		log.Warn(ctx).Err(err).
+			Stringer(log.KeySubscriptionID, subscription.ID).
+			Msg("get subscription lag failed")
+		return
+	}
+	for _, elLag := range lag.Eventlogs {
+		labels := []string{subscription.ID.Key(), subscription.EventbusID.Key(), elLag.EventlogID.Key()}
+		metrics.SubscriptionLagGaugeVec.WithLabelValues(labels...).Set(float64(elLag.Lag))
+		if elLag.Lag > 0 && elLag.LagTime == 0 {
+			// the stime of the first unconsumed event isn't read, the lag time is unknown rather than 0.
+			metrics.SubscriptionLagTimeGaugeVec.DeleteLabelValues(labels...)
+			continue
+		}
+		metrics.SubscriptionLagTimeGaugeVec.WithLabelValues(labels...).Set(elLag.LagTime.Seconds())
+	}
+}
+
+func deleteLagMetrics(id vanus.ID) {
+	labels := prometheus.Labels{metrics.LabelTrigger: id.Key()}
+	metrics.SubscriptionLagGaugeVec.DeletePartialMatch(labels)
+	metrics.SubscriptionLagTimeGaugeVec.DeletePartialMatch(labels)
+}
@@ -0,0 +1,148 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subscription
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/vanus-labs/vanus/api/cloudevents"
+	"github.com/vanus-labs/vanus/api/cluster"
+	"github.com/vanus-labs/vanus/api/errors"
+	segpb "github.com/vanus-labs/vanus/api/segment"
+	"github.com/vanus-labs/vanus/client"
+	"github.com/vanus-labs/vanus/client/pkg/api"
+
+	"github.com/vanus-labs/vanus/pkg/info"
+	"github.com/vanus-labs/vanus/pkg/observability/metrics"
+	"github.com/vanus-labs/vanus/pkg/snowflake"
+	"github.com/vanus-labs/vanus/server/controller/trigger/metadata"
+	"github.com/vanus-labs/vanus/server/controller/trigger/secret"
+	"github.com/vanus-labs/vanus/server/controller/trigger/storage"
+	"github.com/vanus-labs/vanus/server/controller/trigger/subscription/offset"
+)
+
+func TestGetLag(t *testing.T) {
+	Convey("test get lag", t, func() {
+		ctx := context.Background()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		storage := storage.NewMockStorage(ctrl)
+		secret := secret.NewMockStorage(ctrl)
+		ebCli := client.NewMockClient(ctrl)
+		cl := cluster.NewMockCluster(ctrl)
+		m := NewSubscriptionManager(storage, secret, ebCli, cl).(*manager)
+		offsetManager := offset.NewMockManager(ctrl)
+		m.offsetManager = offsetManager
+		storage.MockSubscriptionStorage.EXPECT().CreateSubscription(ctx, gomock.Any()).AnyTimes().Return(nil)
+		id := snowflake.NewTestID()
+		sub := &metadata.Subscription{
+			ID:         id,
+			EventbusID: snowflake.NewTestID(),
+		}
+		m.retryEventbusID = snowflake.NewTestID()
+		m.timerEventbusID = snowflake.NewTestID()
+		m.deadLetterEventbusMap[sub.EventbusID] = snowflake.NewTestID()
+		_ = m.AddSubscription(ctx, sub)
+
+		Convey("subscription no exist", func() {
+			_, err := m.GetLag(ctx, snowflake.NewTestID())
+			So(err, ShouldEqual, errors.ErrResourceNotFound)
+		})
+
+		logID1 := snowflake.NewTestID()
+		logID2 := snowflake.NewTestID()
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockEventlog1 := api.NewMockEventlog(ctrl)
+		mockEventlog2 := api.NewMockEventlog(ctrl)
+		mockReader := api.NewMockBusReader(ctrl)
+		ebCli.EXPECT().Eventbus(gomock.Any(), gomock.Any()).AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().ListLog(gomock.Any()).AnyTimes().Return(
+			[]api.Eventlog{mockEventlog1, mockEventlog2}, nil)
+		mockEventbus.EXPECT().Reader(gomock.Any()).AnyTimes().Return(mockReader)
+		mockEventlog1.EXPECT().ID().AnyTimes().Return(logID1.Uint64())
+		mockEventlog2.EXPECT().ID().AnyTimes().Return(logID2.Uint64())
+		mockEventlog1.EXPECT().LatestOffset(gomock.Any()).AnyTimes().Return(int64(15), nil)
+		mockEventlog2.EXPECT().LatestOffset(gomock.Any()).AnyTimes().Return(int64(5), nil)
+		// the subscription hasn't committed offsets of eventlog2.
+		mockEventlog2.EXPECT().EarliestOffset(gomock.Any()).AnyTimes().Return(int64(2), nil)
+		offsetManager.EXPECT().GetOffset(ctx, id).AnyTimes().Return(info.ListOffsetInfo{
+			{EventlogID: logID1, Offset: 10},
+		}, nil)
+		stime := time.Now().Add(-time.Minute)
+		newBatch := func(t time.Time) *cloudevents.CloudEventBatch {
+			return &cloudevents.CloudEventBatch{Events: []*cloudevents.CloudEvent{{
+				Attributes: map[string]*cloudevents.CloudEvent_CloudEventAttributeValue{
+					segpb.XVanusStime: {
+						Attr: &cloudevents.CloudEvent_CloudEventAttributeValue_CeTimestamp{
+							CeTimestamp: timestamppb.New(t),
+						},
+					},
+				},
+			}}}
+		}
+
+		Convey("get lag", func() {
+			mockReader.EXPECT().Read(gomock.Any()).Times(1).Return(newBatch(stime), int64(10), logID1.Uint64(), nil)
+			mockReader.EXPECT().Read(gomock.Any()).Times(1).Return(newBatch(stime.Add(time.Second)),
+				int64(2), logID2.Uint64(), nil)
+			lag, err := m.GetLag(ctx, id)
+			So(err, ShouldBeNil)
+			So(lag.SubscriptionID, ShouldEqual, id)
+			So(lag.Eventlogs, ShouldHaveLength, 2)
+			So(lag.Eventlogs[0].EventlogID, ShouldEqual, logID1)
+			So(lag.Eventlogs[0].CommittedOffset, ShouldEqual, 10)
+			So(lag.Eventlogs[0].LatestOffset, ShouldEqual, 15)
+			So(lag.Eventlogs[0].Lag, ShouldEqual, 5)
+			So(lag.Eventlogs[0].LagTime, ShouldBeGreaterThanOrEqualTo, time.Minute)
+			So(lag.Eventlogs[1].CommittedOffset, ShouldEqual, 2)
+			So(lag.Eventlogs[1].Lag, ShouldEqual, 3)
+			So(lag.Eventlogs[1].LagTime, ShouldBeLessThan, lag.Eventlogs[0].LagTime)
+			So(lag.Lag, ShouldEqual, 8)
+			So(lag.LagTime, ShouldEqual, lag.Eventlogs[0].LagTime)
+		})
+
+		Convey("read stime failed", func() {
+			mockReader.EXPECT().Read(gomock.Any()).AnyTimes().Return(nil, int64(0), uint64(0), errors.ErrOffsetOnEnd)
+			lag, err := m.GetLag(ctx, id)
+			So(err, ShouldBeNil)
+			So(lag.Lag, ShouldEqual, 8)
+			So(lag.LagTime, ShouldEqual, 0)
+		})
+
+		Convey("read stime with deadline", func() {
+			offsetManager.EXPECT().GetOffset(gomock.Any(), id).Times(1).Return(info.ListOffsetInfo{
+				{EventlogID: logID1, Offset: 10},
+			}, nil)
+			mockReader.EXPECT().Read(gomock.Any()).Times(2).DoAndReturn(
+				func(ctx context.Context, _ ...api.ReadOption) (*cloudevents.CloudEventBatch, int64, uint64, error) {
+					_, ok := ctx.Deadline()
+					So(ok, ShouldBeTrue)
+					return nil, 0, 0, context.DeadlineExceeded
+				})
+			m.updateLagMetrics(ctx, sub)
+			labels := []string{id.Key(), sub.EventbusID.Key(), logID1.Key()}
+			So(testutil.ToFloat64(metrics.SubscriptionLagGaugeVec.WithLabelValues(labels...)), ShouldEqual, 5)
+			So(metrics.SubscriptionLagTimeGaugeVec.DeleteLabelValues(labels...), ShouldBeFalse)
+			deleteLagMetrics(id)
+		})
+	})
+}
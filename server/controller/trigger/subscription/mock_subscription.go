@@ -86,6 +86,21 @@ func (mr *MockManagerMockRecorder) GetDeadLetterOffset(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetterOffset", reflect.TypeOf((*MockManager)(nil).GetDeadLetterOffset), ctx, id)
 }
 
+// GetLag mocks base method.
+func (m *MockManager) GetLag(ctx context.Context, id vsr.ID) (*metadata.SubscriptionLag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLag", ctx, id)
+	ret0, _ := ret[0].(*metadata.SubscriptionLag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLag indicates an expected call of GetLag.
+func (mr *MockManagerMockRecorder) GetLag(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLag", reflect.TypeOf((*MockManager)(nil).GetLag), ctx, id)
+}
+
 // GetOffset mocks base method.
 func (m *MockManager) GetOffset(ctx context.Context, id vsr.ID) (info.ListOffsetInfo, error) {
 	m.ctrl.T.Helper()
@@ -47,6 +47,8 @@ type Manager interface {
 	GetDeadLetterOffset(ctx context.Context, id vanus.ID) (uint64, error)
 	SaveDeadLetterOffset(ctx context.Context, id vanus.ID, offset uint64) error
 	ResetOffsetByTimestamp(ctx context.Context, id vanus.ID, timestamp uint64) (info.ListOffsetInfo, error)
+	// GetLag computes the lag of the subscription by comparing the offsets to the latest offsets of eventlogs.
+	GetLag(ctx context.Context, id vanus.ID) (*metadata.SubscriptionLag, error)
 	ListSubscription(ctx context.Context) []*metadata.Subscription
 	GetSubscription(ctx context.Context, id vanus.ID) *metadata.Subscription
 	GetSubscriptionByName(ctx context.Context, namespaceID vanus.ID, name string) *metadata.Subscription
@@ -78,6 +80,7 @@ type manager struct {
 	retryEventlogID       vanus.ID
 	retryEventbusID       vanus.ID
 	timerEventbusID       vanus.ID
+	stop                  context.CancelFunc
 }
 
 func NewSubscriptionManager(storage storage.Storage, secretStorage secret.Storage,
@@ -265,6 +268,7 @@ func (m *manager) DeleteSubscription(ctx context.Context, id vanus.ID) error {
 		return err
 	}
 	delete(m.subscriptionMap, id)
+	deleteLagMetrics(id)
 	metrics.SubscriptionGauge.WithLabelValues(subscription.EventbusID.Key()).Dec()
 	if subscription.Transformer.Exist() {
 		metrics.SubscriptionTransformerGauge.WithLabelValues(subscription.EventbusID.Key()).Dec()
@@ -277,11 +281,11 @@ func (m *manager) Heartbeat(ctx context.Context, id vanus.ID, addr string, time
 	if subscription == nil {
 		return errors.ErrResourceNotFound
 	}
-	if subscription.TriggerWorker != addr {
+	if !subscription.HasTriggerWorker(addr) {
 		// data is not consistent, record
 		log.Error(ctx).
 			Stringer(log.KeySubscriptionID, id).
-			Str(log.KeyTriggerWorkerAddr, subscription.TriggerWorker).
+			Strs(log.KeyTriggerWorkerAddr, subscription.TriggerWorkers()).
 			Str("running_addr", addr).
 			Msg("subscription trigger worker invalid")
 	}
@@ -290,6 +294,9 @@ func (m *manager) Heartbeat(ctx context.Context, id vanus.ID, addr string, time
 }
 
 func (m *manager) Stop() {
+	if m.stop != nil {
+		m.stop()
+	}
 	m.offsetManager.Stop()
 }
 
@@ -313,8 +320,8 @@ func (m *manager) Init(ctx context.Context) error {
 		if sub.Transformer.Exist() {
 			metrics.SubscriptionTransformerGauge.WithLabelValues(sub.EventbusID.Key()).Inc()
 		}
-		if sub.TriggerWorker != "" {
-			metrics.CtrlTriggerGauge.WithLabelValues(sub.TriggerWorker).Inc()
+		for _, addr := range sub.TriggerWorkers() {
+			metrics.CtrlTriggerGauge.WithLabelValues(addr).Inc()
 		}
 	}
 	return nil
@@ -322,4 +329,7 @@ func (m *manager) Init(ctx context.Context) error {
 
 func (m *manager) Start() {
 	m.offsetManager.Start()
+	ctx, cancel := context.WithCancel(context.Background())
+	m.stop = cancel
+	go m.runLagMetrics(ctx)
 }
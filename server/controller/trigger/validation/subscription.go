@@ -19,12 +19,13 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"regexp"
 
 	// third-party libraries.
-	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	cesqlparser "github.com/cloudevents/sdk-go/sql/v2/parser"
 	"google.golang.org/api/idtoken"
 	"google.golang.org/api/option"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	// this project.
 	ctrlpb "github.com/vanus-labs/vanus/api/controller"
@@ -38,6 +39,8 @@ import (
 	"github.com/vanus-labs/vanus/pkg/convert"
 	"github.com/vanus-labs/vanus/pkg/transform/arg"
 	"github.com/vanus-labs/vanus/pkg/transform/runtime"
+	"github.com/vanus-labs/vanus/server/trigger/client"
+	"github.com/vanus-labs/vanus/server/trigger/filter"
 	"github.com/vanus-labs/vanus/server/trigger/transform"
 )
 
@@ -54,7 +57,8 @@ func ValidateSubscriptionRequest(ctx context.Context, request *ctrlpb.Subscripti
 	if err := validateProtocol(ctx, request.Protocol); err != nil {
 		return err
 	}
-	if err := ValidateSinkAndProtocol(ctx, request.Sink, request.Protocol, request.SinkCredential); err != nil {
+	if err := validateSink(ctx, request.Sink, request.Protocol, request.SinkCredential,
+		request.ProtocolSettings); err != nil {
 		return err
 	}
 	if err := validateSinkCredential(ctx, request.Sink, request.SinkCredential); err != nil {
@@ -70,49 +74,39 @@ func ValidateSubscriptionRequest(ctx context.Context, request *ctrlpb.Subscripti
 }
 
 func validateProtocol(_ context.Context, protocol metapb.Protocol) error {
-	switch protocol {
-	case metapb.Protocol_HTTP:
-	case metapb.Protocol_AWS_LAMBDA:
-	case metapb.Protocol_GCLOUD_FUNCTIONS:
-	case metapb.Protocol_GRPC:
-
-	default:
+	if _, exist := client.GetPlugin(convert.FromPbProtocol(protocol)); !exist {
 		return errors.ErrInvalidRequest.WithMessage("protocol is invalid")
 	}
 	return nil
 }
 
-func ValidateSinkAndProtocol(_ context.Context,
+func ValidateSinkAndProtocol(ctx context.Context,
+	sink string,
+	protocol metapb.Protocol,
+	credential *metapb.SinkCredential,
+) error {
+	return validateSink(ctx, sink, protocol, credential, nil)
+}
+
+// validateSink checks the sink by the plugin of the protocol.
+func validateSink(ctx context.Context,
 	sink string,
 	protocol metapb.Protocol,
 	credential *metapb.SinkCredential,
+	setting *metapb.ProtocolSetting,
 ) error {
 	if sink == "" {
 		return errors.ErrInvalidRequest.WithMessage("sink is empty")
 	}
-	switch protocol {
-	case metapb.Protocol_AWS_LAMBDA:
-		if _, err := arn.Parse(sink); err != nil {
-			return errors.ErrInvalidRequest.
-				WithMessage("protocol is aws lambda, sink is arn, arn parse error").Wrap(err)
-		}
-		if credential.GetCredentialType() != metapb.SinkCredential_AWS {
-			return errors.ErrInvalidRequest.
-				WithMessage("protocol is aws lambda, sink credential can not be nil and credential type is aws")
-		}
-	case metapb.Protocol_GCLOUD_FUNCTIONS:
-		if credential.GetCredentialType() != metapb.SinkCredential_GCLOUD {
-			return errors.ErrInvalidRequest.
-				WithMessage("protocol is gcloud functions, sink credential can not be nil and credential type is gcloud")
-		}
-	case metapb.Protocol_HTTP:
-		if _, err := url.Parse(sink); err != nil {
-			return errors.ErrInvalidRequest.
-				WithMessage("protocol is http, sink is url,url parse error").Wrap(err)
-		}
-	case metapb.Protocol_GRPC:
+	plugin, exist := client.GetPlugin(convert.FromPbProtocol(protocol))
+	if !exist {
+		return errors.ErrInvalidRequest.WithMessage("protocol is invalid")
 	}
-	return nil
+	return plugin.Validate(ctx, client.Config{
+		Sink:       sink,
+		Credential: convert.FromPbSinkCredential(credential),
+		Setting:    convert.FromPbProtocolSettings(setting),
+	})
 }
 
 func validateSinkCredential(ctx context.Context, sink string, credential *metapb.SinkCredential) error {
@@ -145,6 +139,23 @@ func validateSinkCredential(ctx context.Context, sink string, credential *metapb
 			return errors.ErrInvalidRequest.
 				WithMessage("gcloud credential json invalid").Wrap(err)
 		}
+	case metapb.SinkCredential_HMAC:
+		if credential.GetHmac().GetSecret() == "" {
+			return errors.ErrInvalidRequest.WithMessage("sink credential type is hmac,secret can not empty")
+		}
+	case metapb.SinkCredential_OAUTH2:
+		oauth2 := credential.GetOauth2()
+		if oauth2.GetClientId() == "" || oauth2.GetClientSecret() == "" {
+			return errors.ErrInvalidRequest.
+				WithMessage("sink credential type is oauth2,client id and client secret can not empty")
+		}
+		// the masked token url of a got subscription is filled with the saved one when updating.
+		if oauth2.GetTokenUrl() == primitive.SecretsMask {
+			break
+		}
+		if u, err := url.Parse(oauth2.GetTokenUrl()); err != nil || u.Host == "" {
+			return errors.ErrInvalidRequest.WithMessage("sink credential type is oauth2,token url is invalid")
+		}
 	default:
 		return errors.ErrInvalidRequest.WithMessage("sink credential type is invalid")
 	}
@@ -169,6 +180,14 @@ func validateSubscriptionConfig(_ context.Context, cfg *metapb.SubscriptionConfi
 		return errors.ErrInvalidRequest.WithMessage(
 			fmt.Sprintf("could not set max retry attempts greater than %d", primitive.MaxRetryAttempts))
 	}
+	if cfg.OrderedKeyAttribute != "" && !cfg.OrderedEvent {
+		return errors.ErrInvalidRequest.WithMessage(
+			"ordered key attribute can only be set when ordered event is enabled")
+	}
+	if cfg.Shards > primitive.MaxShards {
+		return errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("could not set shards greater than %d", primitive.MaxShards))
+	}
 	return nil
 }
 
@@ -250,6 +269,18 @@ func ValidateFilter(ctx context.Context, f *metapb.Filter) error {
 	if err := validateAttributeMap("suffix", f.Suffix); err != nil {
 		return err
 	}
+	if err := validateRange(f.Range); err != nil {
+		return err
+	}
+	if err := validateFilterKeys("exists", f.Exists); err != nil {
+		return err
+	}
+	if err := validateIn(f.In); err != nil {
+		return err
+	}
+	if err := validateRegex(f.Regex); err != nil {
+		return err
+	}
 	if f.Sql != "" {
 		if err := validateCeSQL(ctx, f.Sql); err != nil {
 			return err
@@ -315,49 +346,116 @@ func validateAttributeMap(attributeName string, attribute map[string]string) err
 	return nil
 }
 
-func hasMultipleDialects(f *metapb.Filter) bool {
-	dialectFound := false
-	if len(f.Exact) > 0 {
-		dialectFound = true
+func validateFilterKey(dialect, key string) error {
+	if key == "" {
+		return errors.ErrFilterAttributeIsEmpty.WithMessage(
+			dialect + " filter dialect attribute name must not empty")
 	}
-	if len(f.Prefix) > 0 {
-		if dialectFound {
-			return true
-		}
-		dialectFound = true
+	if err := filter.ValidateKey(key); err != nil {
+		return errors.ErrInvalidJSONPath.WithMessage(
+			fmt.Sprintf("%s filter dialect key %s invalid", dialect, key)).Wrap(err)
 	}
-	if len(f.Suffix) > 0 {
-		if dialectFound {
-			return true
+	return nil
+}
+
+func validateFilterKeys(dialect string, keys []string) error {
+	for _, key := range keys {
+		if err := validateFilterKey(dialect, key); err != nil {
+			return err
 		}
-		dialectFound = true
 	}
-	if len(f.All) > 0 {
-		if dialectFound {
-			return true
+	return nil
+}
+
+func validateRange(ranges map[string]*metapb.RangeCondition) error {
+	for key, c := range ranges {
+		if err := validateFilterKey("range", key); err != nil {
+			return err
+		}
+		if c == nil || (c.Gt == nil && c.Gte == nil && c.Lt == nil && c.Lte == nil) {
+			return errors.ErrInvalidArgument.WithMessage(
+				fmt.Sprintf("range filter dialect key %s must have a bound", key))
+		}
+		if (c.Gt != nil && c.Gte != nil) || (c.Lt != nil && c.Lte != nil) {
+			return errors.ErrInvalidArgument.WithMessage(
+				fmt.Sprintf("range filter dialect key %s has duplicated bounds", key))
+		}
+		lower, upper := c.Gt, c.Lt
+		if lower == nil {
+			lower = c.Gte
+		}
+		if upper == nil {
+			upper = c.Lte
+		}
+		if lower != nil && upper != nil && *lower > *upper {
+			return errors.ErrInvalidArgument.WithMessage(
+				fmt.Sprintf("range filter dialect key %s lower bound is greater than upper bound", key))
 		}
-		dialectFound = true
 	}
-	if len(f.Any) > 0 {
-		if dialectFound {
-			return true
+	return nil
+}
+
+func validateIn(in map[string]*structpb.ListValue) error {
+	for key, list := range in {
+		if err := validateFilterKey("in", key); err != nil {
+			return err
+		}
+		if len(list.GetValues()) == 0 {
+			return errors.ErrInvalidArgument.WithMessage(
+				fmt.Sprintf("in filter dialect key %s must have values", key))
+		}
+		for _, v := range list.GetValues() {
+			switch v.GetKind().(type) {
+			case *structpb.Value_StructValue, *structpb.Value_ListValue:
+				return errors.ErrInvalidArgument.WithMessage(
+					fmt.Sprintf("in filter dialect key %s values must be scalars", key))
+			}
 		}
-		dialectFound = true
 	}
-	if f.Not != nil {
-		if dialectFound {
-			return true
+	return nil
+}
+
+func validateRegex(regex map[string]string) error {
+	for key, expr := range regex {
+		if err := validateFilterKey("regex", key); err != nil {
+			return err
+		}
+		if expr == "" {
+			return errors.ErrFilterAttributeIsEmpty.WithMessage(
+				"regex filter dialect attribute value must not empty")
+		}
+		if _, err := regexp.Compile(expr); err != nil {
+			return errors.ErrInvalidArgument.WithMessage(
+				fmt.Sprintf("regex filter dialect expression %s invalid", expr)).Wrap(err)
 		}
-		dialectFound = true
 	}
-	if f.Sql != "" {
+	return nil
+}
+
+func hasMultipleDialects(f *metapb.Filter) bool {
+	dialects := []bool{
+		len(f.Exact) > 0,
+		len(f.Prefix) > 0,
+		len(f.Suffix) > 0,
+		len(f.All) > 0,
+		len(f.Any) > 0,
+		f.Not != nil,
+		f.Sql != "",
+		f.Cel != "",
+		len(f.Range) > 0,
+		len(f.Exists) > 0,
+		len(f.In) > 0,
+		len(f.Regex) > 0,
+	}
+	dialectFound := false
+	for _, found := range dialects {
+		if !found {
+			continue
+		}
 		if dialectFound {
 			return true
 		}
 		dialectFound = true
 	}
-	if f.Cel != "" && dialectFound {
-		return true
-	}
 	return false
 }
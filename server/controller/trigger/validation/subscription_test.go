@@ -28,6 +28,7 @@ import (
 	metapb "github.com/vanus-labs/vanus/api/meta"
 
 	// this project.
+	primitive "github.com/vanus-labs/vanus/pkg"
 	"github.com/vanus-labs/vanus/pkg/snowflake"
 )
 
@@ -72,6 +73,22 @@ func TestValidateSubscriptionConfig(t *testing.T) {
 			}
 			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
 		})
+		Convey("test ordered key attribute", func() {
+			config := &metapb.SubscriptionConfig{
+				OrderedKeyAttribute: "partitionkey",
+			}
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+			config.OrderedEvent = true
+			So(validateSubscriptionConfig(ctx, config), ShouldBeNil)
+		})
+		Convey("test shards", func() {
+			config := &metapb.SubscriptionConfig{
+				Shards: primitive.MaxShards + 1,
+			}
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+			config.Shards = 4
+			So(validateSubscriptionConfig(ctx, config), ShouldBeNil)
+		})
 	})
 }
 
@@ -202,6 +219,53 @@ func TestValidateSinkCredential(t *testing.T) {
 			So(validateSinkCredential(ctx, sink, credential), ShouldNotBeNil)
 		})
 	})
+	Convey("subscription sink credential type is hmac", t, func() {
+		sink := "https://example.com"
+		Convey("secret is empty", func() {
+			credential := &metapb.SinkCredential{
+				CredentialType: metapb.SinkCredential_HMAC,
+			}
+			So(validateSinkCredential(ctx, sink, credential), ShouldNotBeNil)
+		})
+		Convey("all valid", func() {
+			credential := &metapb.SinkCredential{
+				CredentialType: metapb.SinkCredential_HMAC,
+				Credential: &metapb.SinkCredential_Hmac{
+					Hmac: &metapb.HMACCredential{Secret: "whsec_MfKQ9r8GKYqrTwjUPD8ILPZIo2LaLaSw"},
+				},
+			}
+			So(validateSinkCredential(ctx, sink, credential), ShouldBeNil)
+		})
+	})
+	Convey("subscription sink credential type is oauth2", t, func() {
+		sink := "https://example.com"
+		oauth2 := &metapb.OAuth2Credential{
+			TokenUrl:     "https://example.com/oauth2/token",
+			ClientId:     "xxxxxx",
+			ClientSecret: "xxxxxx",
+		}
+		credential := &metapb.SinkCredential{
+			CredentialType: metapb.SinkCredential_OAUTH2,
+			Credential:     &metapb.SinkCredential_Oauth2{Oauth2: oauth2},
+		}
+		Convey("all valid", func() {
+			So(validateSinkCredential(ctx, sink, credential), ShouldBeNil)
+		})
+		Convey("client secret is empty", func() {
+			oauth2.ClientSecret = ""
+			So(validateSinkCredential(ctx, sink, credential), ShouldNotBeNil)
+		})
+		Convey("token url is invalid", func() {
+			oauth2.TokenUrl = "token"
+			So(validateSinkCredential(ctx, sink, credential), ShouldNotBeNil)
+		})
+		Convey("credential is masked", func() {
+			oauth2.TokenUrl = primitive.SecretsMask
+			oauth2.ClientId = primitive.SecretsMask
+			oauth2.ClientSecret = primitive.SecretsMask
+			So(validateSinkCredential(ctx, sink, credential), ShouldBeNil)
+		})
+	})
 }
 
 func TestValidateFilter(t *testing.T) {
@@ -288,6 +352,65 @@ func TestValidateFilter(t *testing.T) {
 		}
 		So(ValidateFilter(ctx, f), ShouldBeNil)
 	})
+	Convey("range", t, func() {
+		lower, upper := float64(1), float64(10)
+		f := &metapb.Filter{
+			Range: map[string]*metapb.RangeCondition{
+				"data.num": {Gt: &lower, Lte: &upper},
+			},
+		}
+		So(ValidateFilter(ctx, f), ShouldBeNil)
+		f.Range["data.num"] = &metapb.RangeCondition{}
+		So(ValidateFilter(ctx, f), ShouldNotBeNil)
+		f.Range["data.num"] = &metapb.RangeCondition{Gt: &lower, Gte: &lower}
+		So(ValidateFilter(ctx, f), ShouldNotBeNil)
+		f.Range["data.num"] = &metapb.RangeCondition{Gte: &upper, Lt: &lower}
+		So(ValidateFilter(ctx, f), ShouldNotBeNil)
+		f.Range = map[string]*metapb.RangeCondition{"data.[": {Gt: &lower}}
+		So(ValidateFilter(ctx, f), ShouldNotBeNil)
+	})
+	Convey("exists", t, func() {
+		f := &metapb.Filter{
+			Exists: []string{"subject", "data.items[0].id"},
+		}
+		So(ValidateFilter(ctx, f), ShouldBeNil)
+		f.Exists = []string{""}
+		So(ValidateFilter(ctx, f), ShouldNotBeNil)
+	})
+	Convey("in", t, func() {
+		values, _ := structpb.NewList([]interface{}{"a", 1, true, nil})
+		f := &metapb.Filter{
+			In: map[string]*structpb.ListValue{
+				"data.key": values,
+			},
+		}
+		So(ValidateFilter(ctx, f), ShouldBeNil)
+		f.In["data.key"] = &structpb.ListValue{}
+		So(ValidateFilter(ctx, f), ShouldNotBeNil)
+		f.In["data.key"], _ = structpb.NewList([]interface{}{[]interface{}{"a"}})
+		So(ValidateFilter(ctx, f), ShouldNotBeNil)
+	})
+	Convey("regex", t, func() {
+		f := &metapb.Filter{
+			Regex: map[string]string{
+				"source": "^test",
+			},
+		}
+		So(ValidateFilter(ctx, f), ShouldBeNil)
+		f.Regex["source"] = "[a-"
+		So(ValidateFilter(ctx, f), ShouldNotBeNil)
+		f.Regex["source"] = ""
+		So(ValidateFilter(ctx, f), ShouldNotBeNil)
+	})
+	Convey("typed dialects are exclusive", t, func() {
+		f := &metapb.Filter{
+			Exists: []string{"subject"},
+			Regex: map[string]string{
+				"source": "^test",
+			},
+		}
+		So(ValidateFilter(ctx, f), ShouldNotBeNil)
+	})
 	filters := []*metapb.Filter{
 		{
 			Exact: map[string]string{
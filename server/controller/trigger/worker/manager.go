@@ -45,6 +45,7 @@ type Manager interface {
 	GetTriggerWorker(addr string) TriggerWorker
 	RemoveTriggerWorker(ctx context.Context, addr string)
 	UpdateTriggerWorkerInfo(ctx context.Context, addr string) error
+	UpdateTriggerWorkerLoad(addr string, load map[vanus.ID]metadata.SubscriptionLoad)
 	GetActiveRunningTriggerWorker() []metadata.TriggerWorkerInfo
 	Init(ctx context.Context) error
 	Start()
@@ -200,6 +201,14 @@ func (m *manager) UpdateTriggerWorkerInfo(ctx context.Context, addr string) erro
 	return nil
 }
 
+func (m *manager) UpdateTriggerWorkerLoad(addr string, load map[vanus.ID]metadata.SubscriptionLoad) {
+	tWorker := m.GetTriggerWorker(addr)
+	if tWorker == nil {
+		return
+	}
+	tWorker.SetLoad(load)
+}
+
 func (m *manager) startTriggerWorker(ctx context.Context, tWorker TriggerWorker) {
 	assignSubscription := tWorker.GetAssignedSubscriptions()
 	err := tWorker.RemoteStart(ctx)
@@ -310,8 +319,8 @@ func (m *manager) Init(ctx context.Context) error {
 	}
 	subscriptions := m.subscriptionManager.ListSubscription(ctx)
 	for _, metaData := range subscriptions {
-		if metaData.TriggerWorker != "" {
-			tWorker, exist := m.triggerWorkers[metaData.TriggerWorker]
+		for _, addr := range metaData.TriggerWorkers() {
+			tWorker, exist := m.triggerWorkers[addr]
 			if exist {
 				tWorker.AssignSubscription(metaData.ID)
 			}
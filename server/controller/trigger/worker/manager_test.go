@@ -188,6 +188,29 @@ func TestManager_UpdateTriggerWorkerInfo(t *testing.T) {
 	})
 }
 
+func TestManager_UpdateTriggerWorkerLoad(t *testing.T) {
+	Convey("test update trigger worker load", t, func() {
+		addr := "test"
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		workerStorage := storage.NewMockTriggerWorkerStorage(ctrl)
+		twManager := NewTriggerWorkerManager(Config{}, workerStorage, nil,
+			getTestTriggerWorkerRemoveSubscription()).(*manager)
+		load := map[vanus.ID]metadata.SubscriptionLoad{
+			1: {Throughput: 10, Lag: 5, Goroutines: 2},
+		}
+		Convey("trigger worker not exist", func() {
+			twManager.UpdateTriggerWorkerLoad(addr, load)
+		})
+		Convey("trigger worker exist", func() {
+			tWorker := NewTriggerWorkerByAddr(addr, nil)
+			twManager.triggerWorkers[addr] = tWorker
+			twManager.UpdateTriggerWorkerLoad(addr, load)
+			So(tWorker.GetInfo().Load, ShouldResemble, load)
+		})
+	})
+}
+
 func TestPendingTriggerWorkerHandler(t *testing.T) {
 	Convey("pending worker handler", t, func() {
 		ctx := context.Background()
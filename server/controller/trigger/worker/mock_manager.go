@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	vsr "github.com/vanus-labs/vanus/api/vsr"
 	metadata "github.com/vanus-labs/vanus/server/controller/trigger/metadata"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -145,3 +146,15 @@ func (mr *MockManagerMockRecorder) UpdateTriggerWorkerInfo(ctx, addr any) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTriggerWorkerInfo", reflect.TypeOf((*MockManager)(nil).UpdateTriggerWorkerInfo), ctx, addr)
 }
+
+// UpdateTriggerWorkerLoad mocks base method.
+func (m *MockManager) UpdateTriggerWorkerLoad(addr string, load map[vsr.ID]metadata.SubscriptionLoad) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateTriggerWorkerLoad", addr, load)
+}
+
+// UpdateTriggerWorkerLoad indicates an expected call of UpdateTriggerWorkerLoad.
+func (mr *MockManagerMockRecorder) UpdateTriggerWorkerLoad(addr, load any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTriggerWorkerLoad", reflect.TypeOf((*MockManager)(nil).UpdateTriggerWorkerLoad), addr, load)
+}
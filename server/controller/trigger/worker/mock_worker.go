@@ -218,6 +218,18 @@ func (mr *MockTriggerWorkerMockRecorder) Reset() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockTriggerWorker)(nil).Reset))
 }
 
+// SetLoad mocks base method.
+func (m *MockTriggerWorker) SetLoad(load map[vsr.ID]metadata.SubscriptionLoad) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetLoad", load)
+}
+
+// SetLoad indicates an expected call of SetLoad.
+func (mr *MockTriggerWorkerMockRecorder) SetLoad(load any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLoad", reflect.TypeOf((*MockTriggerWorker)(nil).SetLoad), load)
+}
+
 // SetPhase mocks base method.
 func (m *MockTriggerWorker) SetPhase(arg0 metadata.TriggerWorkerPhase) {
 	m.ctrl.T.Helper()
@@ -17,12 +17,23 @@ package worker
 import (
 	"context"
 	"math/rand"
+	"sort"
+	"sync"
 	"sync/atomic"
 	"time"
 
+	vanus "github.com/vanus-labs/vanus/api/vsr"
+
 	"github.com/vanus-labs/vanus/server/controller/trigger/metadata"
 )
 
+const (
+	defaultLoadThreshold      = 0.2
+	defaultMoveCoolDown       = 10 * time.Minute
+	defaultMaxMovesPerRound   = 4
+	defaultPlacementRetention = 30 * time.Second
+)
+
 type TriggerWorkerPolicy interface {
 	Acquire(context.Context, []metadata.TriggerWorkerInfo) metadata.TriggerWorkerInfo
 }
@@ -44,3 +55,215 @@ type RandomPolicy struct {
 func (r *RandomPolicy) Acquire(_ context.Context, workers []metadata.TriggerWorkerInfo) metadata.TriggerWorkerInfo {
 	return workers[rand.New(rand.NewSource(time.Now().Unix())).Intn(len(workers))]
 }
+
+// SubscriptionMove moves a running subscription from one trigger worker to another.
+type SubscriptionMove struct {
+	SubscriptionID vanus.ID
+	From           string
+	To             string
+}
+
+// TriggerWorkerRebalancer is implemented by policies which can move running
+// subscriptions away from overloaded trigger workers.
+type TriggerWorkerRebalancer interface {
+	Rebalance(context.Context, []metadata.TriggerWorkerInfo) []SubscriptionMove
+}
+
+// LoadPolicy places a subscription on the least loaded trigger worker, and
+// rebalances subscriptions when a worker is loaded beyond Threshold above the
+// average.
+//
+// The load of a subscription is the sum of its throughput, lag and goroutines,
+// each as a share of the cluster total, so that none of them dominates.
+type LoadPolicy struct {
+	// Threshold is the tolerated load of a worker above the average, as a
+	// ratio of the average. No subscription is moved within it.
+	Threshold float64
+	// CoolDown is the minimum interval between two moves of a subscription.
+	CoolDown time.Duration
+	// MaxMoves bounds the number of subscriptions moved in a round.
+	MaxMoves int
+
+	lock sync.Mutex
+	// placed records recent placements, which are not reported by heartbeat yet.
+	placed map[string][]time.Time
+	moved  map[vanus.ID]time.Time
+	now    func() time.Time
+}
+
+func NewLoadPolicy() *LoadPolicy {
+	return &LoadPolicy{
+		Threshold: defaultLoadThreshold,
+		CoolDown:  defaultMoveCoolDown,
+		MaxMoves:  defaultMaxMovesPerRound,
+		placed:    map[string][]time.Time{},
+		moved:     map[vanus.ID]time.Time{},
+		now:       time.Now,
+	}
+}
+
+type workerLoad struct {
+	addr          string
+	score         float64
+	subscriptions map[vanus.ID]float64
+	placed        int
+}
+
+func (p *LoadPolicy) Acquire(_ context.Context, workers []metadata.TriggerWorkerInfo) metadata.TriggerWorkerInfo {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	loads := p.workerLoads(workers)
+	var total float64
+	var num int
+	for _, l := range loads {
+		total += l.score
+		num += len(l.subscriptions)
+	}
+	// A subscription placed recently is assumed to be as heavy as the average one.
+	avg := 0.0
+	if num > 0 {
+		avg = total / float64(num)
+	}
+	idx := 0
+	for i := 1; i < len(loads); i++ {
+		if lessLoaded(loads[i], loads[idx], avg) {
+			idx = i
+		}
+	}
+	addr := workers[idx].Addr
+	p.placed[addr] = append(p.placed[addr], p.now())
+	return workers[idx]
+}
+
+func lessLoaded(a, b *workerLoad, avg float64) bool {
+	sa := a.score + float64(a.placed)*avg
+	sb := b.score + float64(b.placed)*avg
+	if sa != sb {
+		return sa < sb
+	}
+	return len(a.subscriptions)+a.placed < len(b.subscriptions)+b.placed
+}
+
+func (p *LoadPolicy) Rebalance(_ context.Context, workers []metadata.TriggerWorkerInfo) []SubscriptionMove {
+	if len(workers) < 2 {
+		return nil
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	now := p.now()
+	for id, t := range p.moved {
+		if now.Sub(t) >= p.CoolDown {
+			delete(p.moved, id)
+		}
+	}
+	loads := p.workerLoads(workers)
+	var total float64
+	for _, l := range loads {
+		total += l.score
+	}
+	if total == 0 {
+		return nil
+	}
+	threshold := total / float64(len(loads)) * (1 + p.Threshold)
+	var moves []SubscriptionMove
+	for len(moves) < p.MaxMoves {
+		sort.SliceStable(loads, func(i, j int) bool {
+			return loads[i].score > loads[j].score
+		})
+		heaviest, lightest := loads[0], loads[len(loads)-1]
+		if heaviest.score <= threshold {
+			break
+		}
+		// Moving a subscription heavier than half of the gap only swaps the roles
+		// of the two workers, so it would be moved back in the next round.
+		id, score, ok := p.pickSubscription(heaviest, (heaviest.score-lightest.score)/2)
+		if !ok {
+			break
+		}
+		delete(heaviest.subscriptions, id)
+		heaviest.score -= score
+		lightest.subscriptions[id] = score
+		lightest.score += score
+		p.moved[id] = now
+		moves = append(moves, SubscriptionMove{
+			SubscriptionID: id,
+			From:           heaviest.addr,
+			To:             lightest.addr,
+		})
+	}
+	return moves
+}
+
+// pickSubscription returns the heaviest subscription of the worker whose score
+// is not greater than limit and which is not in cool down.
+func (p *LoadPolicy) pickSubscription(w *workerLoad, limit float64) (vanus.ID, float64, bool) {
+	var (
+		picked vanus.ID
+		best   float64
+		ok     bool
+	)
+	for id, score := range w.subscriptions {
+		if score <= 0 || score > limit {
+			continue
+		}
+		if _, exist := p.moved[id]; exist {
+			continue
+		}
+		if !ok || score > best || (score == best && id < picked) {
+			picked, best, ok = id, score, true
+		}
+	}
+	return picked, best, ok
+}
+
+func (p *LoadPolicy) workerLoads(workers []metadata.TriggerWorkerInfo) []*workerLoad {
+	var total metadata.SubscriptionLoad
+	for _, w := range workers {
+		for _, l := range w.Load {
+			total.Throughput += l.Throughput
+			total.Lag += l.Lag
+			total.Goroutines += l.Goroutines
+		}
+	}
+	now := p.now()
+	loads := make([]*workerLoad, len(workers))
+	for i, w := range workers {
+		wl := &workerLoad{
+			addr:          w.Addr,
+			subscriptions: make(map[vanus.ID]float64, len(w.Load)),
+		}
+		for id, l := range w.Load {
+			score := loadScore(l, total)
+			wl.subscriptions[id] = score
+			wl.score += score
+		}
+		placed := p.placed[w.Addr][:0]
+		for _, t := range p.placed[w.Addr] {
+			if now.Sub(t) < defaultPlacementRetention {
+				placed = append(placed, t)
+			}
+		}
+		if len(placed) == 0 {
+			delete(p.placed, w.Addr)
+		} else {
+			p.placed[w.Addr] = placed
+		}
+		wl.placed = len(placed)
+		loads[i] = wl
+	}
+	return loads
+}
+
+func loadScore(l, total metadata.SubscriptionLoad) float64 {
+	var score float64
+	if total.Throughput > 0 {
+		score += l.Throughput / total.Throughput
+	}
+	if total.Lag > 0 {
+		score += float64(l.Lag) / float64(total.Lag)
+	}
+	if total.Goroutines > 0 {
+		score += float64(l.Goroutines) / float64(total.Goroutines)
+	}
+	return score
+}
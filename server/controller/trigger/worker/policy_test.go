@@ -18,9 +18,12 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	. "github.com/smartystreets/goconvey/convey"
 
+	vanus "github.com/vanus-labs/vanus/api/vsr"
+
 	"github.com/vanus-labs/vanus/server/controller/trigger/metadata"
 )
 
@@ -48,6 +51,104 @@ func TestRoundRobinPolicy(t *testing.T) {
 	})
 }
 
+func TestLoadPolicy_Acquire(t *testing.T) {
+	ctx := context.Background()
+	Convey("load policy acquire", t, func() {
+		p := NewLoadPolicy()
+		Convey("least loaded worker", func() {
+			workers := []metadata.TriggerWorkerInfo{
+				{Addr: "addr0", Load: map[vanus.ID]metadata.SubscriptionLoad{
+					1: {Throughput: 100, Lag: 1000, Goroutines: 10},
+				}},
+				{Addr: "addr1", Load: map[vanus.ID]metadata.SubscriptionLoad{
+					2: {Throughput: 10, Lag: 10, Goroutines: 1},
+					3: {Throughput: 10, Lag: 10, Goroutines: 1},
+				}},
+			}
+			So(p.Acquire(ctx, workers).Addr, ShouldEqual, "addr1")
+		})
+		Convey("spread placements before load is reported", func() {
+			workers := getTriggerWorker(3)
+			seen := map[string]int{}
+			for i := 0; i < 6; i++ {
+				seen[p.Acquire(ctx, workers).Addr]++
+			}
+			So(seen, ShouldResemble, map[string]int{"addr0": 2, "addr1": 2, "addr2": 2})
+		})
+		Convey("recent placements expire", func() {
+			now := time.Now()
+			p.now = func() time.Time { return now }
+			workers := getTriggerWorker(2)
+			So(p.Acquire(ctx, workers).Addr, ShouldEqual, "addr0")
+			So(p.Acquire(ctx, workers).Addr, ShouldEqual, "addr1")
+			p.now = func() time.Time { return now.Add(defaultPlacementRetention) }
+			So(p.Acquire(ctx, workers).Addr, ShouldEqual, "addr0")
+		})
+	})
+}
+
+func TestLoadPolicy_Rebalance(t *testing.T) {
+	ctx := context.Background()
+	Convey("load policy rebalance", t, func() {
+		p := NewLoadPolicy()
+		now := time.Now()
+		p.now = func() time.Time { return now }
+		Convey("move subscriptions from the overloaded worker", func() {
+			workers := []metadata.TriggerWorkerInfo{
+				{Addr: "addr0", Load: map[vanus.ID]metadata.SubscriptionLoad{
+					1: {Throughput: 350, Lag: 350, Goroutines: 35},
+					2: {Throughput: 300, Lag: 300, Goroutines: 30},
+					3: {Throughput: 100, Lag: 100, Goroutines: 10},
+				}},
+				{Addr: "addr1", Load: map[vanus.ID]metadata.SubscriptionLoad{
+					4: {Throughput: 100, Lag: 100, Goroutines: 10},
+				}},
+				{Addr: "addr2", Load: map[vanus.ID]metadata.SubscriptionLoad{}},
+			}
+			moves := p.Rebalance(ctx, workers)
+			So(moves, ShouldResemble, []SubscriptionMove{
+				{SubscriptionID: 1, From: "addr0", To: "addr2"},
+				{SubscriptionID: 3, From: "addr0", To: "addr1"},
+			})
+
+			Convey("moved subscriptions cool down", func() {
+				workers[0].Load = map[vanus.ID]metadata.SubscriptionLoad{
+					1: {Throughput: 400, Lag: 400, Goroutines: 40},
+					3: {Throughput: 400, Lag: 400, Goroutines: 40},
+				}
+				workers[1].Load = map[vanus.ID]metadata.SubscriptionLoad{}
+				workers[2].Load = map[vanus.ID]metadata.SubscriptionLoad{}
+				So(p.Rebalance(ctx, workers), ShouldBeEmpty)
+				p.now = func() time.Time { return now.Add(p.CoolDown) }
+				So(p.Rebalance(ctx, workers), ShouldHaveLength, 1)
+			})
+		})
+		Convey("within threshold", func() {
+			workers := []metadata.TriggerWorkerInfo{
+				{Addr: "addr0", Load: map[vanus.ID]metadata.SubscriptionLoad{
+					1: {Throughput: 110, Lag: 110, Goroutines: 11},
+				}},
+				{Addr: "addr1", Load: map[vanus.ID]metadata.SubscriptionLoad{
+					2: {Throughput: 90, Lag: 90, Goroutines: 9},
+				}},
+			}
+			So(p.Rebalance(ctx, workers), ShouldBeEmpty)
+		})
+		Convey("single heavy subscription is not moved", func() {
+			workers := []metadata.TriggerWorkerInfo{
+				{Addr: "addr0", Load: map[vanus.ID]metadata.SubscriptionLoad{
+					1: {Throughput: 1000, Lag: 1000, Goroutines: 100},
+				}},
+				{Addr: "addr1", Load: map[vanus.ID]metadata.SubscriptionLoad{}},
+			}
+			So(p.Rebalance(ctx, workers), ShouldBeEmpty)
+		})
+		Convey("no load", func() {
+			So(p.Rebalance(ctx, getTriggerWorker(3)), ShouldBeEmpty)
+		})
+	})
+}
+
 func getTriggerWorker(size int) []metadata.TriggerWorkerInfo {
 	var list []metadata.TriggerWorkerInfo
 	for i := 0; i < size; i++ {
@@ -22,18 +22,22 @@ import (
 	"github.com/vanus-labs/vanus/pkg/observability/log"
 	"github.com/vanus-labs/vanus/pkg/observability/metrics"
 
+	primitive "github.com/vanus-labs/vanus/pkg"
 	"github.com/vanus-labs/vanus/pkg/queue"
+	"github.com/vanus-labs/vanus/server/controller/trigger/metadata"
 	"github.com/vanus-labs/vanus/server/controller/trigger/subscription"
 )
 
 const (
-	defaultRetryPrintLog = 5
+	defaultRetryPrintLog     = 5
+	defaultRebalanceInterval = time.Minute
 )
 
 type SubscriptionScheduler struct {
 	normalQueue         queue.Queue
 	maxRetryPrintLog    int
 	policy              TriggerWorkerPolicy
+	rebalanceInterval   time.Duration
 	workerManager       Manager
 	subscriptionManager subscription.Manager
 	ctx                 context.Context
@@ -46,7 +50,8 @@ func NewSubscriptionScheduler(workerManager Manager,
 	s := &SubscriptionScheduler{
 		normalQueue:         queue.New(),
 		maxRetryPrintLog:    defaultRetryPrintLog,
-		policy:              &RoundRobinPolicy{},
+		policy:              NewLoadPolicy(),
+		rebalanceInterval:   defaultRebalanceInterval,
 		workerManager:       workerManager,
 		subscriptionManager: subscriptionManager,
 	}
@@ -90,6 +95,60 @@ func (s *SubscriptionScheduler) Run() {
 			}
 		}
 	}()
+	if _, ok := s.policy.(TriggerWorkerRebalancer); ok {
+		go primitive.UntilWithContext(s.ctx, s.rebalance, s.rebalanceInterval)
+	}
+}
+
+func (s *SubscriptionScheduler) rebalance(ctx context.Context) {
+	rebalancer, _ := s.policy.(TriggerWorkerRebalancer)
+	moves := rebalancer.Rebalance(ctx, s.workerManager.GetActiveRunningTriggerWorker())
+	for _, move := range moves {
+		if err := s.moveSubscription(ctx, move); err != nil {
+			log.Warn(ctx).Err(err).
+				Stringer(log.KeySubscriptionID, move.SubscriptionID).
+				Str("from", move.From).
+				Str("to", move.To).
+				Msg("scheduler move subscription has error")
+		}
+	}
+}
+
+func (s *SubscriptionScheduler) moveSubscription(ctx context.Context, move SubscriptionMove) error {
+	subscription := s.subscriptionManager.GetSubscription(ctx, move.SubscriptionID)
+	if subscription == nil || subscription.Phase != metadata.SubscriptionPhaseRunning ||
+		!subscription.HasTriggerWorker(move.From) {
+		// changed since the load was reported.
+		return nil
+	}
+	to := s.workerManager.GetTriggerWorker(move.To)
+	if to == nil {
+		return ErrTriggerWorkerNotFound
+	}
+	if from := s.workerManager.GetTriggerWorker(move.From); from != nil {
+		if err := from.UnAssignSubscription(move.SubscriptionID); err != nil {
+			// keep it on the original trigger worker.
+			from.AssignSubscription(move.SubscriptionID)
+			return err
+		}
+	}
+	// the shards join the ones already run by the target trigger worker if any.
+	hosted := subscription.HasTriggerWorker(move.To)
+	subscription.ReplaceTriggerWorker(move.From, move.To)
+	if err := s.subscriptionManager.UpdateSubscription(ctx, subscription); err != nil {
+		return err
+	}
+	metrics.CtrlTriggerGauge.WithLabelValues(move.From).Dec()
+	if !hosted {
+		metrics.CtrlTriggerGauge.WithLabelValues(move.To).Inc()
+	}
+	to.AssignSubscription(move.SubscriptionID)
+	log.Info(ctx).
+		Stringer(log.KeySubscriptionID, move.SubscriptionID).
+		Str("from", move.From).
+		Str("to", move.To).
+		Msg("scheduler moved subscription for load balance")
+	return nil
 }
 
 func (s *SubscriptionScheduler) handler(ctx context.Context, subscriptionID vanus.ID) error {
@@ -97,36 +156,84 @@ func (s *SubscriptionScheduler) handler(ctx context.Context, subscriptionID vanu
 	if subscription == nil {
 		return nil
 	}
-	twAddr := subscription.TriggerWorker
-	if twAddr == "" {
-		for {
-			select {
-			case <-ctx.Done():
-				return nil
-			default:
-			}
-			twInfos := s.workerManager.GetActiveRunningTriggerWorker()
-			if len(twInfos) == 0 {
-				time.Sleep(time.Second)
-				continue
-			}
-			twInfo := s.policy.Acquire(ctx, twInfos)
-			twAddr = twInfo.Addr
-			break
+	// assign trigger workers to the shards without trigger worker, different shards
+	// prefer different trigger workers.
+	assigned := make(map[uint32]string)
+	twAddrs := subscription.TriggerWorkers()
+	for shard := uint32(0); shard < subscription.Config.GetShards(); shard++ {
+		if subscription.GetShardWorker(shard) != "" {
+			continue
+		}
+		twAddr, ok := s.acquire(ctx, twAddrs)
+		if !ok {
+			return nil
+		}
+		assigned[shard] = twAddr
+		if !containsAddr(twAddrs, twAddr) {
+			twAddrs = append(twAddrs, twAddr)
 		}
 	}
-	tWorker := s.workerManager.GetTriggerWorker(twAddr)
-	if tWorker == nil {
-		return ErrTriggerWorkerNotFound
+	tWorkers := make([]TriggerWorker, 0, len(twAddrs))
+	for _, twAddr := range twAddrs {
+		tWorker := s.workerManager.GetTriggerWorker(twAddr)
+		if tWorker == nil {
+			return ErrTriggerWorkerNotFound
+		}
+		tWorkers = append(tWorkers, tWorker)
 	}
-	if subscription.TriggerWorker == "" {
-		subscription.TriggerWorker = twAddr
+	if len(assigned) > 0 {
+		hosts := subscription.TriggerWorkers()
+		for shard, twAddr := range assigned {
+			subscription.SetShardWorker(shard, twAddr)
+		}
 		err := s.subscriptionManager.UpdateSubscription(ctx, subscription)
 		if err != nil {
 			return err
 		}
-		metrics.CtrlTriggerGauge.WithLabelValues(twAddr).Inc()
+		for _, twAddr := range subscription.TriggerWorkers() {
+			if !containsAddr(hosts, twAddr) {
+				metrics.CtrlTriggerGauge.WithLabelValues(twAddr).Inc()
+			}
+		}
+	}
+	for _, tWorker := range tWorkers {
+		tWorker.AssignSubscription(subscriptionID)
 	}
-	tWorker.AssignSubscription(subscriptionID)
 	return nil
 }
+
+// acquire picks a trigger worker by the policy, the trigger workers in excludes are
+// picked only if there is no other one.
+func (s *SubscriptionScheduler) acquire(ctx context.Context, excludes []string) (string, bool) {
+	for {
+		select {
+		case <-ctx.Done():
+			return "", false
+		default:
+		}
+		twInfos := s.workerManager.GetActiveRunningTriggerWorker()
+		if len(twInfos) == 0 {
+			time.Sleep(time.Second)
+			continue
+		}
+		candidates := make([]metadata.TriggerWorkerInfo, 0, len(twInfos))
+		for _, twInfo := range twInfos {
+			if !containsAddr(excludes, twInfo.Addr) {
+				candidates = append(candidates, twInfo)
+			}
+		}
+		if len(candidates) == 0 {
+			candidates = twInfos
+		}
+		return s.policy.Acquire(ctx, candidates).Addr, true
+	}
+}
+
+func containsAddr(addrs []string, addr string) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
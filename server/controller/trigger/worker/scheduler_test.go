@@ -25,6 +25,7 @@ import (
 
 	vanus "github.com/vanus-labs/vanus/api/vsr"
 
+	primitive "github.com/vanus-labs/vanus/pkg"
 	"github.com/vanus-labs/vanus/server/controller/trigger/metadata"
 	"github.com/vanus-labs/vanus/server/controller/trigger/subscription"
 )
@@ -72,6 +73,31 @@ func TestSubscriptionSchedulerHandler(t *testing.T) {
 			subscriptionManager.EXPECT().UpdateSubscription(ctx, gomock.Any()).AnyTimes().Return(nil)
 			scheduler.handler(ctx, subscriptionID)
 		})
+
+		Convey("test scheduler handler shards", func() {
+			sub := &metadata.Subscription{
+				ID:            subscriptionID,
+				Phase:         metadata.SubscriptionPhasePending,
+				Config:        primitive.SubscriptionConfig{Shards: 3},
+				TriggerWorker: workerAddr,
+			}
+			subscriptionManager.EXPECT().GetSubscription(ctx, subscriptionID).Return(sub)
+			workerAddr2 := "test2"
+			workerManager.EXPECT().GetActiveRunningTriggerWorker().AnyTimes().Return([]metadata.TriggerWorkerInfo{
+				{Addr: workerAddr}, {Addr: workerAddr2},
+			})
+			tWorker2 := NewMockTriggerWorker(ctrl)
+			workerManager.EXPECT().GetTriggerWorker(workerAddr).Return(tWorker)
+			workerManager.EXPECT().GetTriggerWorker(workerAddr2).Return(tWorker2)
+			subscriptionManager.EXPECT().UpdateSubscription(ctx, gomock.Any()).Return(nil)
+			tWorker2.EXPECT().AssignSubscription(subscriptionID).Return()
+			err := scheduler.handler(ctx, subscriptionID)
+			So(err, ShouldBeNil)
+			So(sub.GetShardWorker(0), ShouldEqual, workerAddr)
+			So(sub.GetShardWorker(1), ShouldEqual, workerAddr2)
+			So(sub.GetShardWorker(2), ShouldNotBeEmpty)
+			So(sub.TriggerWorkers(), ShouldHaveLength, 2)
+		})
 	})
 }
 
@@ -110,3 +136,77 @@ func TestSubscriptionSchedulerRun(t *testing.T) {
 		})
 	})
 }
+
+func TestSubscriptionSchedulerRebalance(t *testing.T) {
+	Convey("test scheduler rebalance", t, func() {
+		ctx := context.Background()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		subscriptionManager := subscription.NewMockManager(ctrl)
+		workerManager := NewMockManager(ctrl)
+		scheduler := NewSubscriptionScheduler(workerManager, subscriptionManager)
+		from := NewMockTriggerWorker(ctrl)
+		to := NewMockTriggerWorker(ctrl)
+		workerManager.EXPECT().GetTriggerWorker("addr0").AnyTimes().Return(from)
+		workerManager.EXPECT().GetTriggerWorker("addr1").AnyTimes().Return(to)
+		workerManager.EXPECT().GetActiveRunningTriggerWorker().Return([]metadata.TriggerWorkerInfo{
+			{Addr: "addr0", Load: map[vanus.ID]metadata.SubscriptionLoad{
+				1: {Throughput: 100, Lag: 100, Goroutines: 10},
+				2: {Throughput: 100, Lag: 100, Goroutines: 10},
+			}},
+			{Addr: "addr1", Load: map[vanus.ID]metadata.SubscriptionLoad{}},
+		})
+		sub := &metadata.Subscription{
+			ID:            1,
+			Phase:         metadata.SubscriptionPhaseRunning,
+			TriggerWorker: "addr0",
+		}
+		subscriptionManager.EXPECT().GetSubscription(ctx, vanus.ID(1)).Return(sub)
+
+		Convey("move subscription", func() {
+			from.EXPECT().UnAssignSubscription(vanus.ID(1)).Return(nil)
+			subscriptionManager.EXPECT().UpdateSubscription(ctx, gomock.Any()).Return(nil)
+			to.EXPECT().AssignSubscription(vanus.ID(1))
+			scheduler.rebalance(ctx)
+			So(sub.TriggerWorker, ShouldEqual, "addr1")
+		})
+
+		Convey("remove from original trigger worker failed", func() {
+			from.EXPECT().UnAssignSubscription(vanus.ID(1)).Return(errors.New("remove error"))
+			from.EXPECT().AssignSubscription(vanus.ID(1))
+			scheduler.rebalance(ctx)
+			So(sub.TriggerWorker, ShouldEqual, "addr0")
+		})
+	})
+}
+
+func TestSubscriptionSchedulerMoveSubscription(t *testing.T) {
+	Convey("test scheduler move subscription", t, func() {
+		ctx := context.Background()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		subscriptionManager := subscription.NewMockManager(ctrl)
+		workerManager := NewMockManager(ctrl)
+		scheduler := NewSubscriptionScheduler(workerManager, subscriptionManager)
+		move := SubscriptionMove{SubscriptionID: 1, From: "addr0", To: "addr1"}
+
+		Convey("subscription has been reassigned", func() {
+			subscriptionManager.EXPECT().GetSubscription(ctx, vanus.ID(1)).Return(&metadata.Subscription{
+				ID:            1,
+				Phase:         metadata.SubscriptionPhaseRunning,
+				TriggerWorker: "addr2",
+			})
+			So(scheduler.moveSubscription(ctx, move), ShouldBeNil)
+		})
+
+		Convey("target trigger worker not found", func() {
+			subscriptionManager.EXPECT().GetSubscription(ctx, vanus.ID(1)).Return(&metadata.Subscription{
+				ID:            1,
+				Phase:         metadata.SubscriptionPhaseRunning,
+				TriggerWorker: "addr0",
+			})
+			workerManager.EXPECT().GetTriggerWorker("addr1").Return(nil)
+			So(scheduler.moveSubscription(ctx, move), ShouldEqual, ErrTriggerWorkerNotFound)
+		})
+	})
+}
@@ -49,6 +49,7 @@ type TriggerWorker interface {
 	GetPendingTime() time.Time
 	GetHeartbeatTime() time.Time
 	Polish()
+	SetLoad(load map[vanus.ID]metadata.SubscriptionLoad)
 	AssignSubscription(id vanus.ID)
 	UnAssignSubscription(id vanus.ID) error
 	GetAssignedSubscriptions() []vanus.ID
@@ -142,7 +143,7 @@ func (tw *triggerWorker) handler(ctx context.Context, subscriptionID vanus.ID) e
 		if sub.Phase != metadata.SubscriptionPhaseStopped {
 			// modify phase to stopped.
 			sub.Phase = metadata.SubscriptionPhaseStopped
-			sub.TriggerWorker = ""
+			sub.ClearTriggerWorkers()
 			err = tw.subscriptionManager.UpdateSubscription(ctx, sub)
 			if err != nil {
 				return err
@@ -155,6 +156,15 @@ func (tw *triggerWorker) handler(ctx context.Context, subscriptionID vanus.ID) e
 		tw.assignSubscriptionIDs.Delete(subscriptionID)
 		return nil
 	}
+	var shards []uint32
+	if sub.Config.GetShards() > 1 {
+		shards = sub.WorkerShards(tw.info.Addr)
+		if len(shards) == 0 {
+			// shards have been moved to other trigger workers.
+			tw.assignSubscriptionIDs.Delete(subscriptionID)
+			return tw.removeSubscription(ctx, subscriptionID)
+		}
+	}
 	offsets, err := tw.subscriptionManager.GetOrSaveOffset(ctx, subscriptionID)
 	if err != nil {
 		return err
@@ -196,6 +206,7 @@ func (tw *triggerWorker) handler(ctx context.Context, subscriptionID vanus.ID) e
 		Protocol:             sub.Protocol,
 		ProtocolSetting:      sub.ProtocolSetting,
 		SinkCredential:       sub.SinkCredential,
+		Shards:               shards,
 	})
 	if err != nil {
 		return err
@@ -230,6 +241,8 @@ func (tw *triggerWorker) Reset() {
 }
 
 func (tw *triggerWorker) GetInfo() metadata.TriggerWorkerInfo {
+	tw.lock.RLock()
+	defer tw.lock.RUnlock()
 	return *tw.info
 }
 
@@ -255,6 +268,13 @@ func (tw *triggerWorker) Polish() {
 	tw.heartbeatTime = time.Now()
 }
 
+// SetLoad replaces the subscription load reported by the trigger worker.
+func (tw *triggerWorker) SetLoad(load map[vanus.ID]metadata.SubscriptionLoad) {
+	tw.lock.Lock()
+	defer tw.lock.Unlock()
+	tw.info.Load = load
+}
+
 func (tw *triggerWorker) AssignSubscription(id vanus.ID) {
 	_, exist := tw.assignSubscriptionIDs.Load(id)
 	var msg string
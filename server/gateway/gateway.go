@@ -46,6 +46,10 @@ import (
 
 var requestDataFromContext = cehttp.RequestDataFromContext
 
+// headerPartitionKeyAttribute is the HTTP header which specifies the name of attribute or extension
+// used as the partition key, events with the same key are written to the same eventlog.
+const headerPartitionKeyAttribute = "X-Vanus-Partition-Key-Attribute"
+
 type EventData struct {
 	EventID string   `json:"event_id"`
 	BusID   vanus.ID `json:"eventbus_id"`
@@ -104,7 +108,8 @@ func (ga *ceGateway) startCloudEventsReceiver(ctx context.Context) error {
 }
 
 func (ga *ceGateway) receive(ctx context.Context, event v2.Event) (re *v2.Event, result protocol.Result) {
-	eventbusID, err := ga.getEventbusFromPath(ctx, requestDataFromContext(ctx))
+	reqData := requestDataFromContext(ctx)
+	eventbusID, err := ga.getEventbusFromPath(ctx, reqData)
 	if err != nil {
 		return nil, v2.NewHTTPResult(http.StatusInternalServerError, err.Error())
 	}
@@ -118,7 +123,8 @@ func (ga *ceGateway) receive(ctx context.Context, event v2.Event) (re *v2.Event,
 		Events: &cloudevents.CloudEventBatch{
 			Events: []*cloudevents.CloudEvent{e},
 		},
-		EventbusId: eventbusID.Uint64(),
+		EventbusId:            eventbusID.Uint64(),
+		PartitionKeyAttribute: reqData.Header.Get(headerPartitionKeyAttribute),
 	})
 
 	if err != nil {
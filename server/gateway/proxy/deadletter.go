@@ -26,6 +26,7 @@ import (
 	"github.com/vanus-labs/vanus/api/cloudevents"
 	ctrlpb "github.com/vanus-labs/vanus/api/controller"
 	"github.com/vanus-labs/vanus/api/errors"
+	metapb "github.com/vanus-labs/vanus/api/meta"
 	proxypb "github.com/vanus-labs/vanus/api/proxy"
 	vanus "github.com/vanus-labs/vanus/api/vsr"
 	"github.com/vanus-labs/vanus/client/pkg/api"
@@ -35,6 +36,10 @@ import (
 
 	primitive "github.com/vanus-labs/vanus/pkg"
 	"github.com/vanus-labs/vanus/pkg/authorization"
+	"github.com/vanus-labs/vanus/pkg/convert"
+	"github.com/vanus-labs/vanus/pkg/observability/log"
+	"github.com/vanus-labs/vanus/server/gateway/auth"
+	eventclient "github.com/vanus-labs/vanus/server/trigger/client"
 )
 
 func authGetDeadLetterEvent(_ context.Context, req interface{},
@@ -155,7 +160,7 @@ func authResendDeadLetterEvent(_ context.Context, req interface{},
 
 func (cp *ControllerProxy) ResendDeadLetterEvent( //nolint:funlen // ok
 	ctx context.Context, req *proxypb.ResendDeadLetterEventRequest,
-) (*emptypb.Empty, error) {
+) (*proxypb.ResendDeadLetterEventResponse, error) {
 	if req.GetSubscriptionId() == 0 {
 		return nil, errors.ErrInvalidRequest.WithMessage("subscription is empty")
 	}
@@ -165,56 +170,207 @@ func (cp *ControllerProxy) ResendDeadLetterEvent( //nolint:funlen // ok
 	if err != nil {
 		return nil, err
 	}
+	filter, err := newDeadLetterFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	var target deadLetterTarget
+	if !req.GetPurge() {
+		if target, err = cp.newDeadLetterTarget(ctx, req); err != nil {
+			return nil, err
+		}
+		defer target.close()
+	}
 	subscriptionIDStr := vanus.NewIDFromUint64(req.SubscriptionId).String()
-	storeOffset, err := cp.triggerCtrl.GetDeadLetterEventOffset(ctx,
-		&ctrlpb.GetDeadLetterEventOffsetRequest{SubscriptionId: req.GetSubscriptionId()})
+	busReader, readPolicy, offset, err := cp.openDeadLetter(ctx, subscription,
+		req.GetStartOffset(), req.GetEndOffset(), readSize)
 	if err != nil {
 		return nil, err
 	}
-	offset := req.GetStartOffset()
+	res := &proxypb.ResendDeadLetterEventResponse{Offset: offset}
+	savedOffset := offset
+	// A single offset can't skip events, so it only moves across the contiguous events which are resent or
+	// purged, and stays at the first unmatched event kept in the dead letter. The matched events after it are
+	// still resent, but purging can't drop them.
+	contiguous := true
+	var events []*v2.Event
+	flush := func() error {
+		if req.GetDryRun() {
+			return nil
+		}
+		if len(events) != 0 {
+			if err := target.send(ctx, events); err != nil {
+				return err
+			}
+			events = nil
+		}
+		if res.Offset == savedOffset {
+			return nil
+		}
+		_, err := cp.triggerCtrl.SetDeadLetterEventOffset(ctx, &ctrlpb.SetDeadLetterEventOffsetRequest{
+			SubscriptionId: req.GetSubscriptionId(), Offset: res.Offset,
+		})
+		if err != nil {
+			return errors.ErrInternal.Wrap(err).WithMessage("save offset error")
+		}
+		savedOffset = res.Offset
+		return nil
+	}
+loop:
+	for {
+		_events, _, _, err2 := api.Read(ctx, busReader)
+		if err2 != nil {
+			if errors.Is(err2, errors.ErrOffsetOnEnd) { // read end
+				break
+			}
+			// todo errors.ErrTryAgain maybe need retry read
+			return nil, err2
+		}
+		if len(_events) == 0 {
+			break
+		}
+		for _, v := range _events {
+			ec, _ := v.Context.(*v2.EventContextV1)
+			offsetByte, _ := ec.Extensions[eventlog.XVanusLogOffset].([]byte)
+			_endOffset := binary.BigEndian.Uint64(offsetByte)
+			if req.GetEndOffset() != 0 && _endOffset > req.GetEndOffset() {
+				break loop
+			}
+			if ec.Extensions[primitive.XVanusSubscriptionID] != subscriptionIDStr {
+				if contiguous {
+					res.Offset = _endOffset + 1
+				}
+				continue
+			}
+			if !filter.match(v) {
+				if req.GetPurgeUnmatched() {
+					if contiguous {
+						res.Offset = _endOffset + 1
+					}
+					continue
+				}
+				res.Unmatched++
+				contiguous = false
+				continue
+			}
+			res.Matched++
+			if contiguous {
+				res.Offset = _endOffset + 1
+			}
+			if req.GetPurge() {
+				continue
+			}
+			// remove retry attribute
+			delete(ec.Extensions, primitive.XVanusRetryAttempts)
+			// remove dead letter attribute
+			delete(ec.Extensions, primitive.LastDeliveryTime)
+			delete(ec.Extensions, primitive.LastDeliveryError)
+			delete(ec.Extensions, primitive.DeadLetterReason)
+			delete(ec.Extensions, primitive.DeadLetterCode)
+			events = append(events, v)
+		}
+		readPolicy.Forward(len(_events))
+		if len(events) > 10 {
+			if err2 = flush(); err2 != nil {
+				return nil, err2
+			}
+		}
+	}
+	if err = flush(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// openDeadLetter returns the reader of the dead letter of the subscription from the offset,
+// which is the dead letter offset of the subscription if it's 0.
+func (cp *ControllerProxy) openDeadLetter(
+	ctx context.Context, subscription *metapb.Subscription, offset, endOffset uint64, batchSize int,
+) (api.BusReader, api.ReadPolicy, uint64, error) {
+	storeOffset, err := cp.triggerCtrl.GetDeadLetterEventOffset(ctx,
+		&ctrlpb.GetDeadLetterEventOffsetRequest{SubscriptionId: subscription.GetId()})
+	if err != nil {
+		return nil, nil, 0, err
+	}
 	if offset == 0 {
 		offset = storeOffset.GetOffset()
 	} else if offset < storeOffset.GetOffset() {
-		return nil, errors.ErrInvalidRequest.WithMessage(
+		return nil, nil, 0, errors.ErrInvalidRequest.WithMessage(
 			fmt.Sprintf("start_offset is invalid, param is %d it but now is %d", offset, storeOffset.Offset))
 	}
 	deadLetterEventbusID, err := cp.getDealLetterEventbusID(ctx,
 		vanus.NewIDFromUint64(subscription.EventbusId))
 	if err != nil {
-		return nil, err
+		return nil, nil, 0, err
 	}
 	ls, err := cp.client.Eventbus(ctx, api.WithID(deadLetterEventbusID.Uint64())).ListLog(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, 0, err
 	}
 	earliestOffset, err := ls[0].EarliestOffset(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, 0, err
 	}
 	if earliestOffset > 0 && offset < uint64(earliestOffset) {
 		offset = uint64(earliestOffset)
 	}
-	if req.GetEndOffset() != 0 && req.GetEndOffset() < offset {
-		return nil, errors.ErrInvalidRequest.WithMessage(
-			fmt.Sprintf("end_offset is invalid, param is %d it but start is %d", offset, req.GetEndOffset()))
+	if endOffset != 0 && endOffset < offset {
+		return nil, nil, 0, errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("end_offset is invalid, param is %d it but start is %d", offset, endOffset))
 	}
 	readPolicy := policy.NewManuallyReadPolicy(ls[0], int64(offset))
 	busReader := cp.client.Eventbus(ctx, api.WithID(deadLetterEventbusID.Uint64())).Reader(
 		option.WithDisablePolling(),
 		option.WithReadPolicy(readPolicy),
-		option.WithBatchSize(readSize),
+		option.WithBatchSize(batchSize),
+	)
+	return busReader, readPolicy, offset, nil
+}
+
+func authGetDeadLetterStats(_ context.Context, req interface{},
+) (authorization.ResourceKind, vanus.ID, authorization.Action) {
+	id := vanus.NewIDFromUint64((req.(*proxypb.GetDeadLetterStatsRequest)).GetSubscriptionId())
+	return authorization.ResourceSubscription, id, authorization.SubscriptionGet
+}
+
+// GetDeadLetterStats aggregates the dead letter events of the subscription in the gateway,
+// so that clients needn't download all events.
+func (cp *ControllerProxy) GetDeadLetterStats(
+	ctx context.Context, req *proxypb.GetDeadLetterStatsRequest,
+) (*proxypb.GetDeadLetterStatsResponse, error) {
+	if req.GetSubscriptionId() == 0 {
+		return nil, errors.ErrInvalidRequest.WithMessage("subscription is empty")
+	}
+	subscription, err := cp.triggerCtrl.GetSubscription(ctx, &ctrlpb.GetSubscriptionRequest{
+		Id: req.GetSubscriptionId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = defaultDeadLetterStatsLimit
+	}
+	busReader, readPolicy, offset, err := cp.openDeadLetter(ctx, subscription,
+		req.GetStartOffset(), req.GetEndOffset(), maximumNumberPerGetRequest)
+	if err != nil {
+		return nil, err
+	}
+	subscriptionIDStr := vanus.NewIDFromUint64(req.SubscriptionId).String()
+	stats := newDeadLetterStats()
+	var (
+		scanned   uint64
+		endOffset = offset
+		truncated bool
 	)
-	var endOffset uint64
-	var events []*cloudevents.CloudEvent
 loop:
 	for {
-		_events, _, _, err2 := api.Read(ctx, busReader)
-		if err2 != nil {
-			if errors.Is(err2, errors.ErrOffsetOnEnd) { // read end
+		_events, _, _, err := api.Read(ctx, busReader)
+		if err != nil {
+			if errors.Is(err, errors.ErrOffsetOnEnd) { // read end
 				break
 			}
-			// todo errors.ErrTryAgain maybe need retry read
-			return nil, err2
+			return nil, err
 		}
 		if len(_events) == 0 {
 			break
@@ -222,68 +378,164 @@ loop:
 		for _, v := range _events {
 			ec, _ := v.Context.(*v2.EventContextV1)
 			offsetByte, _ := ec.Extensions[eventlog.XVanusLogOffset].([]byte)
-			_endOffset := binary.BigEndian.Uint64(offsetByte)
-			if req.GetEndOffset() != 0 && _endOffset > req.GetEndOffset() {
+			eventOffset := binary.BigEndian.Uint64(offsetByte)
+			if req.GetEndOffset() != 0 && eventOffset > req.GetEndOffset() {
+				break loop
+			}
+			if scanned == limit {
+				truncated = true
 				break loop
 			}
-			endOffset = _endOffset
+			scanned++
+			endOffset = eventOffset + 1
 			if ec.Extensions[primitive.XVanusSubscriptionID] != subscriptionIDStr {
 				continue
 			}
-			// remove retry attribute
-			delete(ec.Extensions, primitive.XVanusRetryAttempts)
-			// remove dead letter attribute
-			delete(ec.Extensions, primitive.LastDeliveryTime)
-			delete(ec.Extensions, primitive.LastDeliveryError)
-			delete(ec.Extensions, primitive.DeadLetterReason)
-			pbEvent, err3 := cloudevents.ToProto(v)
-			if err3 != nil {
-				return nil, err3
-			}
-			events = append(events, pbEvent)
+			stats.add(v)
 		}
 		readPolicy.Forward(len(_events))
-		if len(events) > 10 {
-			err2 = cp.writeDeadLetterEvent(ctx, req.SubscriptionId, endOffset+1, events)
-			if err2 != nil {
-				return nil, err2
-			}
-			events = nil
-		}
 	}
-	if len(events) != 0 {
-		err = cp.writeDeadLetterEvent(ctx, req.SubscriptionId, endOffset+1, events)
-		if err != nil {
+	res := stats.toPb()
+	res.StartOffset = offset
+	res.EndOffset = endOffset
+	res.Truncated = truncated
+	return res, nil
+}
+
+// deadLetterTarget receives the resent dead letter events.
+type deadLetterTarget interface {
+	send(ctx context.Context, events []*v2.Event) error
+	close()
+}
+
+func (cp *ControllerProxy) newDeadLetterTarget(
+	ctx context.Context, req *proxypb.ResendDeadLetterEventRequest,
+) (deadLetterTarget, error) {
+	switch {
+	case req.GetTargetEventbusId() != 0 && req.GetTargetSink() != "":
+		return nil, errors.ErrInvalidRequest.WithMessage("target eventbus and target sink can't be both set")
+	case req.GetTargetEventbusId() != 0:
+		id := vanus.NewIDFromUint64(req.GetTargetEventbusId())
+		if err := cp.authorize(ctx, authorization.ResourceEventbus, id, authorization.EventbusWrite,
+			"no permission to write the target eventbus"); err != nil {
+			return nil, err
+		}
+		if _, err := cp.ctrl.EventbusService().GetEventbus(ctx, id.Uint64()); err != nil {
+			return nil, err
+		}
+		return &eventbusTarget{cp: cp, id: id, detach: true}, nil
+	case req.GetTargetSink() != "":
+		// the gateway sends requests to the sink with the credential, which must be trusted.
+		if err := cp.authorize(ctx, authorization.ResourceSubscription, vanus.NewIDFromUint64(req.GetSubscriptionId()),
+			authorization.SubscriptionRedirect, "no permission to resend to the target sink"); err != nil {
+			return nil, err
+		}
+		protocol := convert.FromPbProtocol(req.GetTargetProtocol())
+		plugin, ok := eventclient.GetPlugin(protocol)
+		if !ok {
+			return nil, errors.ErrInvalidRequest.WithMessage("target protocol is invalid")
+		}
+		cfg := eventclient.Config{
+			Sink:       req.GetTargetSink(),
+			Credential: convert.FromPbSinkCredential(req.GetTargetSinkCredential()),
+		}
+		if err := plugin.Validate(ctx, cfg); err != nil {
 			return nil, err
 		}
+		return &sinkTarget{client: plugin.NewClient(cfg), batch: plugin.Batch()}, nil
 	}
-	return &emptypb.Empty{}, nil
+	meta, err := cp.ctrl.EventbusService().GetSystemEventbusByName(ctx, primitive.RetryEventbusName)
+	if err != nil {
+		return nil, err
+	}
+	return &eventbusTarget{cp: cp, id: vanus.NewIDFromUint64(meta.Id)}, nil
 }
 
-func (cp *ControllerProxy) writeDeadLetterEvent(
-	ctx context.Context, subscriptionID uint64, offset uint64, events []*cloudevents.CloudEvent,
+func (cp *ControllerProxy) authorize(
+	ctx context.Context, kind authorization.ResourceKind, id vanus.ID, action authorization.Action, deniedMsg string,
 ) error {
-	meta, err := cp.ctrl.EventbusService().GetSystemEventbusByName(ctx, primitive.RetryEventbusName)
+	if cp.authService.Disable() {
+		return nil
+	}
+	ok, err := cp.authService.Authorization.Authorize(ctx, auth.GetUser(ctx),
+		authorization.NewDefaultAttributes(kind, id, action))
 	if err != nil {
 		return err
 	}
-	// write to retry eventbus
-	err = cp.writeEvents(ctx, vanus.NewIDFromUint64(meta.Id), &cloudevents.CloudEventBatch{
-		Events: events,
-	})
-	if err != nil {
+	if !ok {
+		return errors.ErrPermissionDenied.WithMessage(deniedMsg)
+	}
+	return nil
+}
+
+// eventbusTarget writes events to the eventbus, the retry eventbus routes them back to the subscription
+// by the subscription attribute, the other eventbus gets the events detached from the subscription.
+type eventbusTarget struct {
+	cp     *ControllerProxy
+	id     vanus.ID
+	detach bool
+}
+
+func (t *eventbusTarget) send(ctx context.Context, events []*v2.Event) error {
+	batch := &cloudevents.CloudEventBatch{Events: make([]*cloudevents.CloudEvent, len(events))}
+	for idx, e := range events {
+		if t.detach {
+			detachDeadLetterEvent(e)
+		}
+		pbEvent, err := cloudevents.ToProto(e)
+		if err != nil {
+			return err
+		}
+		batch.Events[idx] = pbEvent
+	}
+	if err := t.cp.writeEvents(ctx, t.id, batch, ""); err != nil {
 		return errors.ErrInternal.Wrap(err).WithMessage("write event error")
 	}
-	// save offset
-	_, err = cp.triggerCtrl.SetDeadLetterEventOffset(ctx, &ctrlpb.SetDeadLetterEventOffsetRequest{
-		SubscriptionId: subscriptionID, Offset: offset,
-	})
-	if err != nil {
-		return errors.ErrInternal.Wrap(err).WithMessage("save offset error")
+	return nil
+}
+
+func (t *eventbusTarget) close() {}
+
+type sinkTarget struct {
+	client eventclient.EventClient
+	batch  bool
+}
+
+func (t *sinkTarget) send(ctx context.Context, events []*v2.Event) error {
+	for _, e := range events {
+		detachDeadLetterEvent(e)
+	}
+	if t.batch {
+		return sinkResultError(t.client.Send(ctx, events...))
+	}
+	for _, e := range events {
+		if err := sinkResultError(t.client.Send(ctx, e)); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+func (t *sinkTarget) close() {
+	if err := t.client.Close(); err != nil {
+		log.Warn().Err(err).Msg("close the client of the dead letter target sink failed")
+	}
+}
+
+func sinkResultError(r eventclient.Result) error {
+	if r.Err == nil {
+		return nil
+	}
+	return errors.ErrInternal.Wrap(r.Err).WithMessage(fmt.Sprintf("send to sink error, status code is %d", r.StatusCode))
+}
+
+// detachDeadLetterEvent removes the attributes of vanus, which are meaningless out of the subscription.
+func detachDeadLetterEvent(e *v2.Event) {
+	ec, _ := e.Context.(*v2.EventContextV1)
+	delete(ec.Extensions, primitive.XVanusSubscriptionID)
+	delete(ec.Extensions, eventlog.XVanusLogOffset)
+}
+
 func authSetDeadLetterEventOffset(_ context.Context, req interface{},
 ) (authorization.ResourceKind, vanus.ID, authorization.Action) {
 	id := vanus.NewIDFromUint64((req.(*ctrlpb.SetDeadLetterEventOffsetRequest)).GetSubscriptionId())
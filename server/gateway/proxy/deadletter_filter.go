@@ -0,0 +1,110 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"regexp"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/vanus-labs/vanus/api/errors"
+	proxypb "github.com/vanus-labs/vanus/api/proxy"
+
+	primitive "github.com/vanus-labs/vanus/pkg"
+	"github.com/vanus-labs/vanus/pkg/cel"
+)
+
+// deadLetterFilter matches dead letter events by the attributes written by the trigger,
+// all conditions must be satisfied.
+type deadLetterFilter struct {
+	reasons   map[string]struct{}
+	lastError *regexp.Regexp
+	startTime *timestamppb.Timestamp
+	endTime   *timestamppb.Timestamp
+	cel       *cel.Expression
+}
+
+func newDeadLetterFilter(pb *proxypb.DeadLetterFilter) (*deadLetterFilter, error) {
+	f := &deadLetterFilter{}
+	if pb == nil {
+		return f, nil
+	}
+	if len(pb.GetReasons()) > 0 {
+		f.reasons = make(map[string]struct{}, len(pb.GetReasons()))
+		for _, reason := range pb.GetReasons() {
+			f.reasons[reason] = struct{}{}
+		}
+	}
+	if pb.GetLastDeliveryError() != "" {
+		r, err := regexp.Compile(pb.GetLastDeliveryError())
+		if err != nil {
+			return nil, errors.ErrInvalidRequest.WithMessage(
+				fmt.Sprintf("last delivery error %s is invalid", pb.GetLastDeliveryError())).Wrap(err)
+		}
+		f.lastError = r
+	}
+	f.startTime = pb.GetStartTime()
+	f.endTime = pb.GetEndTime()
+	if f.startTime != nil && f.endTime != nil && f.endTime.AsTime().Before(f.startTime.AsTime()) {
+		return nil, errors.ErrInvalidRequest.WithMessage("end time is before start time")
+	}
+	if pb.GetCelExpression() != "" {
+		expr, err := cel.Parse(pb.GetCelExpression())
+		if err != nil {
+			return nil, errors.ErrInvalidRequest.WithMessage(
+				fmt.Sprintf("cel expression %s is invalid", pb.GetCelExpression())).Wrap(err)
+		}
+		f.cel = expr
+	}
+	return f, nil
+}
+
+func (f *deadLetterFilter) match(e *v2.Event) bool {
+	extensions := e.Extensions()
+	if f.reasons != nil {
+		reason, _ := types.ToString(extensions[primitive.DeadLetterReason])
+		if _, ok := f.reasons[reason]; !ok {
+			return false
+		}
+	}
+	if f.lastError != nil {
+		lastError, _ := types.ToString(extensions[primitive.LastDeliveryError])
+		if !f.lastError.MatchString(lastError) {
+			return false
+		}
+	}
+	if f.startTime != nil || f.endTime != nil {
+		t, err := types.ToTime(extensions[primitive.LastDeliveryTime])
+		if err != nil {
+			return false
+		}
+		if f.startTime != nil && t.Before(f.startTime.AsTime()) {
+			return false
+		}
+		if f.endTime != nil && !t.Before(f.endTime.AsTime()) {
+			return false
+		}
+	}
+	if f.cel != nil {
+		ok, err := f.cel.Eval(*e)
+		if err != nil || !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,122 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+	stdtime "time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	proxypb "github.com/vanus-labs/vanus/api/proxy"
+
+	primitive "github.com/vanus-labs/vanus/pkg"
+)
+
+func newDeadLetterTestEvent(reason, lastError string, t stdtime.Time, data string) *v2.Event {
+	e := v2.NewEvent()
+	e.SetID("id")
+	e.SetSource("source")
+	e.SetType("type")
+	_ = e.SetData(v2.ApplicationJSON, []byte(data))
+	e.SetExtension(primitive.DeadLetterReason, reason)
+	e.SetExtension(primitive.LastDeliveryError, lastError)
+	// the attribute is a string after reading from the eventbus.
+	e.SetExtension(primitive.LastDeliveryTime, t.Format(stdtime.RFC3339Nano))
+	return &e
+}
+
+func TestDeadLetterFilter(t *testing.T) {
+	now := stdtime.Now()
+	serverError := newDeadLetterTestEvent("MaxDeliveryAttemptExceeded", "503: service unavailable", now,
+		`{"order":"a"}`)
+	badRequest := newDeadLetterTestEvent("Response400", "400: bad request", now.Add(-stdtime.Hour),
+		`{"order":"b"}`)
+
+	Convey("test empty dead letter filter", t, func() {
+		f, err := newDeadLetterFilter(nil)
+		So(err, ShouldBeNil)
+		So(f.match(serverError), ShouldBeTrue)
+		So(f.match(badRequest), ShouldBeTrue)
+	})
+
+	Convey("test dead letter filter by reason", t, func() {
+		f, err := newDeadLetterFilter(&proxypb.DeadLetterFilter{
+			Reasons: []string{"MaxDeliveryAttemptExceeded", "Response429"},
+		})
+		So(err, ShouldBeNil)
+		So(f.match(serverError), ShouldBeTrue)
+		So(f.match(badRequest), ShouldBeFalse)
+	})
+
+	Convey("test dead letter filter by last delivery error", t, func() {
+		f, err := newDeadLetterFilter(&proxypb.DeadLetterFilter{LastDeliveryError: "^5\\d\\d:"})
+		So(err, ShouldBeNil)
+		So(f.match(serverError), ShouldBeTrue)
+		So(f.match(badRequest), ShouldBeFalse)
+
+		_, err = newDeadLetterFilter(&proxypb.DeadLetterFilter{LastDeliveryError: "("})
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("test dead letter filter by time window", t, func() {
+		f, err := newDeadLetterFilter(&proxypb.DeadLetterFilter{
+			StartTime: timestamppb.New(now.Add(-stdtime.Minute)),
+		})
+		So(err, ShouldBeNil)
+		So(f.match(serverError), ShouldBeTrue)
+		So(f.match(badRequest), ShouldBeFalse)
+
+		f, err = newDeadLetterFilter(&proxypb.DeadLetterFilter{
+			StartTime: timestamppb.New(now.Add(-2 * stdtime.Hour)),
+			EndTime:   timestamppb.New(now.Add(-stdtime.Minute)),
+		})
+		So(err, ShouldBeNil)
+		So(f.match(serverError), ShouldBeFalse)
+		So(f.match(badRequest), ShouldBeTrue)
+
+		_, err = newDeadLetterFilter(&proxypb.DeadLetterFilter{
+			StartTime: timestamppb.New(now),
+			EndTime:   timestamppb.New(now.Add(-stdtime.Minute)),
+		})
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("test dead letter filter by cel expression", t, func() {
+		f, err := newDeadLetterFilter(&proxypb.DeadLetterFilter{
+			CelExpression: "$.order.(string) == 'b'",
+		})
+		So(err, ShouldBeNil)
+		So(f.match(serverError), ShouldBeFalse)
+		So(f.match(badRequest), ShouldBeTrue)
+
+		_, err = newDeadLetterFilter(&proxypb.DeadLetterFilter{CelExpression: "invalid"})
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("test dead letter filter with all conditions", t, func() {
+		f, err := newDeadLetterFilter(&proxypb.DeadLetterFilter{
+			Reasons:           []string{"MaxDeliveryAttemptExceeded"},
+			LastDeliveryError: "unavailable",
+			StartTime:         timestamppb.New(now.Add(-stdtime.Minute)),
+			EndTime:           timestamppb.New(now.Add(stdtime.Minute)),
+		})
+		So(err, ShouldBeNil)
+		So(f.match(serverError), ShouldBeTrue)
+		So(f.match(badRequest), ShouldBeFalse)
+	})
+}
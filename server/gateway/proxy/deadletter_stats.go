@@ -0,0 +1,166 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	stdtime "time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	proxypb "github.com/vanus-labs/vanus/api/proxy"
+
+	primitive "github.com/vanus-labs/vanus/pkg"
+)
+
+const (
+	defaultDeadLetterStatsLimit = 100000
+	// deadLetterReasonResponsePrefix is the prefix of reasons of non-retryable responses, like Response400.
+	deadLetterReasonResponsePrefix = "Response"
+	unknownDeadLetterStatKey       = "unknown"
+)
+
+type deadLetterStat struct {
+	count     uint64
+	firstTime stdtime.Time
+	lastTime  stdtime.Time
+}
+
+func (s *deadLetterStat) add(t stdtime.Time) {
+	s.count++
+	if t.IsZero() {
+		return
+	}
+	if s.firstTime.IsZero() || t.Before(s.firstTime) {
+		s.firstTime = t
+	}
+	if t.After(s.lastTime) {
+		s.lastTime = t
+	}
+}
+
+func (s *deadLetterStat) toPb(key string) *proxypb.DeadLetterStat {
+	return &proxypb.DeadLetterStat{
+		Key:       key,
+		Count:     s.count,
+		FirstTime: toTimestamp(s.firstTime),
+		LastTime:  toTimestamp(s.lastTime),
+	}
+}
+
+// deadLetterStats aggregates dead letter events by the reason, the response code, the event type and the hour
+// of the time that the events were written to the dead letter.
+type deadLetterStats struct {
+	total   deadLetterStat
+	reasons map[string]*deadLetterStat
+	codes   map[string]*deadLetterStat
+	types   map[string]*deadLetterStat
+	hours   map[string]*deadLetterStat
+}
+
+func newDeadLetterStats() *deadLetterStats {
+	return &deadLetterStats{
+		reasons: make(map[string]*deadLetterStat),
+		codes:   make(map[string]*deadLetterStat),
+		types:   make(map[string]*deadLetterStat),
+		hours:   make(map[string]*deadLetterStat),
+	}
+}
+
+func (s *deadLetterStats) add(e *v2.Event) {
+	extensions := e.Extensions()
+	t, _ := types.ToTime(extensions[primitive.LastDeliveryTime])
+	reason, _ := types.ToString(extensions[primitive.DeadLetterReason])
+	s.total.add(t)
+	addDeadLetterStat(s.reasons, reason, t)
+	addDeadLetterStat(s.codes, deadLetterCode(extensions, reason), t)
+	addDeadLetterStat(s.types, e.Type(), t)
+	if !t.IsZero() {
+		addDeadLetterStat(s.hours, t.UTC().Truncate(stdtime.Hour).Format(stdtime.RFC3339), t)
+	}
+}
+
+func (s *deadLetterStats) toPb() *proxypb.GetDeadLetterStatsResponse {
+	res := &proxypb.GetDeadLetterStatsResponse{
+		Total:     s.total.count,
+		FirstTime: toTimestamp(s.total.firstTime),
+		LastTime:  toTimestamp(s.total.lastTime),
+		Reasons:   sortDeadLetterStatsByCount(s.reasons),
+		Codes:     sortDeadLetterStatsByCount(s.codes),
+		Types:     sortDeadLetterStatsByCount(s.types),
+	}
+	res.Hours = make([]*proxypb.DeadLetterStat, 0, len(s.hours))
+	for key, stat := range s.hours {
+		res.Hours = append(res.Hours, stat.toPb(key))
+	}
+	// RFC3339 times in UTC sort as strings.
+	sort.Slice(res.Hours, func(i, j int) bool {
+		return res.Hours[i].Key < res.Hours[j].Key
+	})
+	return res
+}
+
+func addDeadLetterStat(m map[string]*deadLetterStat, key string, t stdtime.Time) {
+	if key == "" {
+		key = unknownDeadLetterStatKey
+	}
+	stat, ok := m[key]
+	if !ok {
+		stat = &deadLetterStat{}
+		m[key] = stat
+	}
+	stat.add(t)
+}
+
+// deadLetterCode returns the response code, events written by old triggers have no code attribute,
+// but the code of a non-retryable response is in the reason.
+func deadLetterCode(extensions map[string]interface{}, reason string) string {
+	if v, ok := extensions[primitive.DeadLetterCode]; ok {
+		if code, err := types.ToInteger(v); err == nil {
+			return strconv.Itoa(int(code))
+		}
+	}
+	if code := strings.TrimPrefix(reason, deadLetterReasonResponsePrefix); code != reason {
+		if _, err := strconv.Atoi(code); err == nil {
+			return code
+		}
+	}
+	return ""
+}
+
+func sortDeadLetterStatsByCount(m map[string]*deadLetterStat) []*proxypb.DeadLetterStat {
+	stats := make([]*proxypb.DeadLetterStat, 0, len(m))
+	for key, stat := range m {
+		stats = append(stats, stat.toPb(key))
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Count != stats[j].Count {
+			return stats[i].Count > stats[j].Count
+		}
+		return stats[i].Key < stats[j].Key
+	})
+	return stats
+}
+
+func toTimestamp(t stdtime.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
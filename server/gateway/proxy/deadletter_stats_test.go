@@ -0,0 +1,86 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+	stdtime "time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+
+	primitive "github.com/vanus-labs/vanus/pkg"
+)
+
+func TestDeadLetterStats(t *testing.T) {
+	Convey("test dead letter stats", t, func() {
+		hour := stdtime.Date(2023, 6, 1, 10, 0, 0, 0, stdtime.UTC)
+		newEvent := func(eventType, reason string, code interface{}, t stdtime.Time) *v2.Event {
+			e := newDeadLetterTestEvent(reason, "error", t, "{}")
+			e.SetType(eventType)
+			if code != nil {
+				e.SetExtension(primitive.DeadLetterCode, code)
+			}
+			return e
+		}
+		stats := newDeadLetterStats()
+		stats.add(newEvent("order", "MaxDeliveryAttemptExceeded", "503", hour.Add(10*stdtime.Minute)))
+		stats.add(newEvent("order", "MaxDeliveryAttemptExceeded", int32(503), hour.Add(50*stdtime.Minute)))
+		stats.add(newEvent("order", "MaxDeliveryAttemptExceeded", "601", hour.Add(70*stdtime.Minute)))
+		// written by old triggers without the code attribute.
+		stats.add(newEvent("user", "Response400", nil, hour.Add(5*stdtime.Minute)))
+
+		res := stats.toPb()
+		So(res.Total, ShouldEqual, 4)
+		So(res.FirstTime.AsTime(), ShouldEqual, hour.Add(5*stdtime.Minute))
+		So(res.LastTime.AsTime(), ShouldEqual, hour.Add(70*stdtime.Minute))
+
+		So(res.Reasons, ShouldHaveLength, 2)
+		So(res.Reasons[0].Key, ShouldEqual, "MaxDeliveryAttemptExceeded")
+		So(res.Reasons[0].Count, ShouldEqual, 3)
+		So(res.Reasons[0].FirstTime.AsTime(), ShouldEqual, hour.Add(10*stdtime.Minute))
+		So(res.Reasons[1].Key, ShouldEqual, "Response400")
+
+		So(res.Codes, ShouldHaveLength, 3)
+		So(res.Codes[0].Key, ShouldEqual, "503")
+		So(res.Codes[0].Count, ShouldEqual, 2)
+		So(res.Codes[1].Key, ShouldEqual, "400")
+		So(res.Codes[2].Key, ShouldEqual, "601")
+
+		So(res.Types, ShouldHaveLength, 2)
+		So(res.Types[0].Key, ShouldEqual, "order")
+		So(res.Types[0].Count, ShouldEqual, 3)
+
+		So(res.Hours, ShouldHaveLength, 2)
+		So(res.Hours[0].Key, ShouldEqual, "2023-06-01T10:00:00Z")
+		So(res.Hours[0].Count, ShouldEqual, 3)
+		So(res.Hours[1].Key, ShouldEqual, "2023-06-01T11:00:00Z")
+		So(res.Hours[1].Count, ShouldEqual, 1)
+	})
+
+	Convey("test dead letter stats with unknown attributes", t, func() {
+		e := v2.NewEvent()
+		e.SetID("id")
+		e.SetSource("source")
+		stats := newDeadLetterStats()
+		stats.add(&e)
+		res := stats.toPb()
+		So(res.Total, ShouldEqual, 1)
+		So(res.FirstTime, ShouldBeNil)
+		So(res.Reasons[0].Key, ShouldEqual, unknownDeadLetterStatKey)
+		So(res.Codes[0].Key, ShouldEqual, unknownDeadLetterStatKey)
+		So(res.Hours, ShouldBeEmpty)
+	})
+}
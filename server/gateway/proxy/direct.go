@@ -16,7 +16,6 @@ package proxy
 
 import (
 	"context"
-	stdErr "errors"
 
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -32,8 +31,6 @@ import (
 	"github.com/vanus-labs/vanus/server/gateway/auth"
 )
 
-var errMethodNotImplemented = stdErr.New("the method hasn't implemented")
-
 func authCreateEventbus(_ context.Context, req interface{},
 ) (authorization.ResourceKind, vanus.ID, authorization.Action) {
 	id := vanus.NewIDFromUint64((req.(*ctrlpb.CreateEventbusRequest)).GetNamespaceId())
@@ -143,10 +140,16 @@ func (cp *ControllerProxy) GetEventbusWithHumanFriendly(ctx context.Context,
 	return cp.eventbusCtrl.GetEventbusWithHumanFriendly(ctx, request)
 }
 
+func authUpdateEventbus(_ context.Context, req interface{},
+) (authorization.ResourceKind, vanus.ID, authorization.Action) {
+	id := vanus.NewIDFromUint64((req.(*ctrlpb.UpdateEventbusRequest)).GetId())
+	return authorization.ResourceEventbus, id, authorization.EventbusUpdate
+}
+
 func (cp *ControllerProxy) UpdateEventbus(
-	_ context.Context, _ *ctrlpb.UpdateEventbusRequest,
+	ctx context.Context, req *ctrlpb.UpdateEventbusRequest,
 ) (*metapb.Eventbus, error) {
-	return nil, errMethodNotImplemented
+	return cp.eventbusCtrl.UpdateEventbus(ctx, req)
 }
 
 func authListSegment(_ context.Context, req interface{}) (authorization.ResourceKind, vanus.ID, authorization.Action) {
@@ -167,6 +170,26 @@ func (cp *ControllerProxy) ValidateEventbus(
 	return &emptypb.Empty{}, err
 }
 
+func authRebalanceLeaders(_ context.Context, _ interface{}) (authorization.ResourceKind, vanus.ID, authorization.Action) {
+	return authorization.ResourceCluster, vanus.EmptyID(), authorization.ClusterRebalance
+}
+
+func (cp *ControllerProxy) RebalanceLeaders(
+	ctx context.Context, req *emptypb.Empty,
+) (*ctrlpb.RebalanceLeadersResponse, error) {
+	return cp.segmentCtrl.RebalanceLeaders(ctx, req)
+}
+
+func authDecommissionVolume(_ context.Context, _ interface{}) (authorization.ResourceKind, vanus.ID, authorization.Action) {
+	return authorization.ResourceCluster, vanus.EmptyID(), authorization.ClusterDecommission
+}
+
+func (cp *ControllerProxy) DecommissionVolume(
+	ctx context.Context, req *ctrlpb.DecommissionVolumeRequest,
+) (*ctrlpb.DecommissionVolumeResponse, error) {
+	return cp.segmentCtrl.DecommissionVolume(ctx, req)
+}
+
 func authCreateSubscription(_ context.Context, req interface{},
 ) (authorization.ResourceKind, vanus.ID, authorization.Action) {
 	id := vanus.NewIDFromUint64((req.(*ctrlpb.CreateSubscriptionRequest)).GetSubscription().GetNamespaceId())
@@ -215,6 +238,18 @@ func (cp *ControllerProxy) GetSubscription(
 	return cp.triggerCtrl.GetSubscription(ctx, req)
 }
 
+func authGetSubscriptionLag(_ context.Context, req interface{},
+) (authorization.ResourceKind, vanus.ID, authorization.Action) {
+	id := vanus.NewIDFromUint64((req.(*ctrlpb.GetSubscriptionLagRequest)).GetSubscriptionId())
+	return authorization.ResourceSubscription, id, authorization.SubscriptionGet
+}
+
+func (cp *ControllerProxy) GetSubscriptionLag(
+	ctx context.Context, req *ctrlpb.GetSubscriptionLagRequest,
+) (*ctrlpb.GetSubscriptionLagResponse, error) {
+	return cp.triggerCtrl.GetSubscriptionLag(ctx, req)
+}
+
 func (cp *ControllerProxy) ListSubscription(
 	ctx context.Context, req *ctrlpb.ListSubscriptionRequest,
 ) (*ctrlpb.ListSubscriptionResponse, error) {
@@ -7,6 +7,7 @@ import (
 	. "github.com/smartystreets/goconvey/convey"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	ctrlpb "github.com/vanus-labs/vanus/api/controller"
@@ -35,29 +36,38 @@ func TestControllerProxy_ProxyMethod(t *testing.T) {
 		eventbusCtrl.EXPECT().DeleteEventbus(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 		eventbusCtrl.EXPECT().GetEventbus(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 		eventbusCtrl.EXPECT().ListEventbus(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().UpdateEventbus(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 		_, _ = cp.CreateEventbus(stdCtx.Background(), &ctrlpb.CreateEventbusRequest{})
 		_, _ = cp.DeleteEventbus(stdCtx.Background(), &wrapperspb.UInt64Value{})
 		_, _ = cp.GetEventbus(stdCtx.Background(), &wrapperspb.UInt64Value{})
 		_, _ = cp.ListEventbus(stdCtx.Background(), &ctrlpb.ListEventbusRequest{})
-		_, err := cp.UpdateEventbus(stdCtx.Background(), &ctrlpb.UpdateEventbusRequest{})
-		So(err, ShouldEqual, errMethodNotImplemented)
+		_, _ = cp.UpdateEventbus(stdCtx.Background(), &ctrlpb.UpdateEventbusRequest{})
 
 		eventlogCtrl := ctrlpb.NewMockEventlogControllerClient(ctrl)
 		cp.eventlogCtrl = eventlogCtrl
 		eventlogCtrl.EXPECT().ListSegment(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 		_, _ = cp.ListSegment(stdCtx.Background(), &ctrlpb.ListSegmentRequest{})
 
+		segmentCtrl := ctrlpb.NewMockSegmentControllerClient(ctrl)
+		cp.segmentCtrl = segmentCtrl
+		segmentCtrl.EXPECT().RebalanceLeaders(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+		segmentCtrl.EXPECT().DecommissionVolume(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+		_, _ = cp.RebalanceLeaders(stdCtx.Background(), &emptypb.Empty{})
+		_, _ = cp.DecommissionVolume(stdCtx.Background(), &ctrlpb.DecommissionVolumeRequest{})
+
 		triggerCtrl := ctrlpb.NewMockTriggerControllerClient(ctrl)
 		cp.triggerCtrl = triggerCtrl
 		triggerCtrl.EXPECT().CreateSubscription(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 		triggerCtrl.EXPECT().UpdateSubscription(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 		triggerCtrl.EXPECT().DeleteSubscription(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 		triggerCtrl.EXPECT().GetSubscription(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+		triggerCtrl.EXPECT().GetSubscriptionLag(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 		triggerCtrl.EXPECT().ListSubscription(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 		_, _ = cp.CreateSubscription(stdCtx.Background(), &ctrlpb.CreateSubscriptionRequest{})
 		_, _ = cp.UpdateSubscription(stdCtx.Background(), &ctrlpb.UpdateSubscriptionRequest{})
 		_, _ = cp.DeleteSubscription(stdCtx.Background(), &ctrlpb.DeleteSubscriptionRequest{})
 		_, _ = cp.GetSubscription(stdCtx.Background(), &ctrlpb.GetSubscriptionRequest{})
+		_, _ = cp.GetSubscriptionLag(stdCtx.Background(), &ctrlpb.GetSubscriptionLagRequest{})
 		_, _ = cp.ListSubscription(stdCtx.Background(), &ctrlpb.ListSubscriptionRequest{})
 	})
 }
@@ -60,6 +60,7 @@ import (
 	errinterceptor "github.com/vanus-labs/vanus/api/grpc/interceptor/errors"
 	metapb "github.com/vanus-labs/vanus/api/meta"
 	proxypb "github.com/vanus-labs/vanus/api/proxy"
+	segpb "github.com/vanus-labs/vanus/api/segment"
 	vanus "github.com/vanus-labs/vanus/api/vsr"
 	eb "github.com/vanus-labs/vanus/client"
 	"github.com/vanus-labs/vanus/client/pkg/api"
@@ -145,6 +146,7 @@ type ControllerProxy struct {
 	client       eb.Client
 	eventbusCtrl ctrlpb.EventbusControllerClient
 	eventlogCtrl ctrlpb.EventlogControllerClient
+	segmentCtrl  ctrlpb.SegmentControllerClient
 	triggerCtrl  ctrlpb.TriggerControllerClient
 	nsCtrl       ctrlpb.NamespaceControllerClient
 	authCtrl     ctrlpb.AuthControllerClient
@@ -227,7 +229,7 @@ func (cp *ControllerProxy) Publish(ctx context.Context, req *proxypb.PublishRequ
 		}
 	}
 
-	err := cp.writeEvents(ctx, vanus.NewIDFromUint64(req.EventbusId), req.Events)
+	err := cp.writeEvents(ctx, vanus.NewIDFromUint64(req.EventbusId), req.Events, req.PartitionKeyAttribute)
 	if err != nil {
 		return nil, err
 	}
@@ -235,7 +237,7 @@ func (cp *ControllerProxy) Publish(ctx context.Context, req *proxypb.PublishRequ
 }
 
 func (cp *ControllerProxy) writeEvents(
-	ctx context.Context, eventbusID vanus.ID, events *cloudevents.CloudEventBatch,
+	ctx context.Context, eventbusID vanus.ID, events *cloudevents.CloudEventBatch, partitionKeyAttr string,
 ) error {
 	val, exist := cp.writerMap.Load(eventbusID)
 	if !exist {
@@ -243,16 +245,51 @@ func (cp *ControllerProxy) writeEvents(
 			cp.client.Eventbus(ctx, api.WithID(eventbusID.Uint64())).Writer())
 	}
 	w, _ := val.(api.BusWriter)
-	_, err := w.Append(ctx, events)
-	if err != nil {
-		log.Warn(ctx).Err(err).
-			Stringer("eventbus", eventbusID).
-			Msg("append to failed")
-		return err
+	if partitionKeyAttr == "" {
+		_, err := w.Append(ctx, events)
+		if err != nil {
+			log.Warn(ctx).Err(err).
+				Stringer("eventbus", eventbusID).
+				Msg("append to failed")
+			return err
+		}
+		return nil
+	}
+
+	// events with the same partition key are appended in one batch to keep their relative order.
+	bus := cp.client.Eventbus(ctx, api.WithID(eventbusID.Uint64()))
+	keys, batches := groupByPartitionKey(events, partitionKeyAttr)
+	for _, key := range keys {
+		_, err := w.Append(ctx, batches[key], option.WithWritePolicy(policy.NewPartitionKeyWritePolicy(bus, key)))
+		if err != nil {
+			log.Warn(ctx).Err(err).
+				Stringer("eventbus", eventbusID).
+				Str("partition_key", key).
+				Msg("append to failed")
+			return err
+		}
 	}
 	return nil
 }
 
+func groupByPartitionKey(
+	events *cloudevents.CloudEventBatch, attr string,
+) ([]string, map[string]*cloudevents.CloudEventBatch) {
+	keys := make([]string, 0)
+	batches := make(map[string]*cloudevents.CloudEventBatch)
+	for _, e := range events.GetEvents() {
+		key := policy.PartitionKey(e, attr)
+		batch, ok := batches[key]
+		if !ok {
+			batch = &cloudevents.CloudEventBatch{}
+			batches[key] = batch
+			keys = append(keys, key)
+		}
+		batch.Events = append(batch.Events, e)
+	}
+	return keys, batches
+}
+
 // Subscribe todo authentication
 func (cp *ControllerProxy) Subscribe(req *proxypb.SubscribeRequest, stream proxypb.StoreProxy_SubscribeServer) error {
 	_ctx, span := cp.tracer.Start(context.Background(), "Subscribe")
@@ -548,6 +585,7 @@ func NewControllerProxy(cfg Config) *ControllerProxy {
 		tracer:       tracing.NewTracer("controller-proxy", trace.SpanKindServer),
 		eventbusCtrl: ctrl.EventbusService().RawClient(),
 		eventlogCtrl: ctrl.EventlogService().RawClient(),
+		segmentCtrl:  ctrl.SegmentService().RawClient(),
 		triggerCtrl:  ctrl.TriggerService().RawClient(),
 		nsCtrl:       ctrl.NamespaceService().RawClient(),
 		authCtrl:     ctrl.AuthService().RawClient(),
@@ -759,6 +797,10 @@ func (cp *ControllerProxy) GetEvent(
 		return cp.getByEventID(ctx, req)
 	}
 
+	if req.CeId != "" {
+		return cp.getByCloudEventID(ctx, req)
+	}
+
 	var (
 		offset = req.Offset
 		num    = req.Number
@@ -888,6 +930,72 @@ func (cp *ControllerProxy) getByEventID(
 	}, nil
 }
 
+// getByCloudEventID looks up the event by the id index of blocks, so the eventlogs are not scanned.
+func (cp *ControllerProxy) getByCloudEventID(
+	ctx context.Context, req *proxypb.GetEventRequest,
+) (*proxypb.GetEventResponse, error) {
+	bus := cp.client.Eventbus(ctx, api.WithID(req.GetEventbusId()))
+
+	var ls []api.Eventlog
+	if req.EventlogId > 0 {
+		l, err := bus.GetLog(ctx, req.EventlogId)
+		if err != nil {
+			return nil, err
+		}
+		ls = append(ls, l)
+	} else {
+		var err error
+		if ls, err = bus.ListLog(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		lastErr error
+		skipped int
+	)
+	for _, l := range ls {
+		off, err := l.QueryOffsetByKey(ctx, segpb.BlockIndex_ID, req.CeId)
+		if err != nil {
+			if !errors.Is(err, errors.ErrBlockNotSupported) {
+				return nil, err
+			}
+			// the other eventlogs may have the index.
+			log.Info().Err(err).
+				Uint64("eventlog_id", l.ID()).
+				Msg("the id index isn't supported by eventlog, skip it")
+			lastErr = err
+			skipped++
+			continue
+		}
+		if off < 0 {
+			continue
+		}
+
+		reader := bus.Reader(
+			option.WithReadPolicy(policy.NewManuallyReadPolicy(l, off)),
+			option.WithDisablePolling(),
+			option.WithBatchSize(1),
+		)
+		events, _, _, err := api.Read(ctx, reader)
+		if err != nil {
+			return nil, err
+		}
+		results := make([]*wrapperspb.BytesValue, len(events))
+		for idx, v := range events {
+			data, _ := v.MarshalJSON()
+			results[idx] = wrapperspb.Bytes(data)
+		}
+		return &proxypb.GetEventResponse{
+			Events: results,
+		}, nil
+	}
+	if skipped > 0 && skipped == len(ls) {
+		return nil, lastErr
+	}
+	return nil, errors.ErrResourceNotFound.WithMessage("the event not found")
+}
+
 func decodeEventID(eventID string) (uint64, int64, error) {
 	decoded, err := base64.StdEncoding.DecodeString(eventID)
 	if err != nil {
@@ -37,8 +37,10 @@ import (
 	"github.com/vanus-labs/vanus/api/cluster"
 	ctrlpb "github.com/vanus-labs/vanus/api/controller"
 	"github.com/vanus-labs/vanus/api/credentials"
+	"github.com/vanus-labs/vanus/api/errors"
 	metapb "github.com/vanus-labs/vanus/api/meta"
 	proxypb "github.com/vanus-labs/vanus/api/proxy"
+	segpb "github.com/vanus-labs/vanus/api/segment"
 	"github.com/vanus-labs/vanus/client"
 	"github.com/vanus-labs/vanus/client/pkg/api"
 	"github.com/vanus-labs/vanus/client/pkg/policy"
@@ -237,6 +239,61 @@ func TestControllerProxy_GetEvent(t *testing.T) {
 			So(err, ShouldBeNil)
 			So(res.Events[0].Value, ShouldResemble, data)
 		})
+
+		Convey("test get events by CloudEvent id", func() {
+			el0 := api.NewMockEventlog(ctrl)
+			el1 := api.NewMockEventlog(ctrl)
+			utEB1.EXPECT().ListLog(gomock.Any()).Times(3).Return([]api.Eventlog{el0, el1}, nil)
+			el0.EXPECT().QueryOffsetByKey(gomock.Any(), segpb.BlockIndex_ID, "ut").Return(int64(-1),
+				errors.ErrBlockNotSupported.WithMessage("the index isn't enabled on this server"))
+			el0.EXPECT().ID().AnyTimes().Return(uint64(1))
+			el1.EXPECT().QueryOffsetByKey(gomock.Any(), segpb.BlockIndex_ID, "ut").Return(int64(10), nil)
+			utEB1.EXPECT().Reader(gomock.Any()).Times(1).DoAndReturn(func(
+				opts ...api.ReadOption,
+			) api.BusReader {
+				opt := &api.ReadOptions{}
+				opt.Apply(opts...)
+				So(opt.Policy, ShouldResemble, policy.NewManuallyReadPolicy(el1, 10))
+				So(opt.BatchSize, ShouldEqual, 1)
+				return reader
+			})
+
+			e := v2.NewEvent()
+			e.SetID("ut")
+			e.SetSource("ut")
+			e.SetType("ut")
+			e.SetSpecVersion("1.0")
+			epb, _ := cloudevents.ToProto(&e)
+			ret := &cloudevents.CloudEventBatch{
+				Events: []*cloudevents.CloudEvent{epb},
+			}
+			reader.EXPECT().Read(gomock.Any()).Times(1).Return(ret, int64(10), uint64(0), nil)
+			res, err := cp.GetEvent(stdCtx.Background(), &proxypb.GetEventRequest{
+				EventbusId: snowflake.NewTestID().Uint64(),
+				CeId:       "ut",
+			})
+			So(err, ShouldBeNil)
+			So(res.Events, ShouldHaveLength, 1)
+			data, err := e.MarshalJSON()
+			So(err, ShouldBeNil)
+			So(res.Events[0].Value, ShouldResemble, data)
+
+			el0.EXPECT().QueryOffsetByKey(gomock.Any(), segpb.BlockIndex_ID, "none").Return(int64(-1), nil)
+			el1.EXPECT().QueryOffsetByKey(gomock.Any(), segpb.BlockIndex_ID, "none").Return(int64(-1), nil)
+			_, err = cp.GetEvent(stdCtx.Background(), &proxypb.GetEventRequest{
+				EventbusId: snowflake.NewTestID().Uint64(),
+				CeId:       "none",
+			})
+			So(err, ShouldNotBeNil)
+
+			el0.EXPECT().QueryOffsetByKey(gomock.Any(), segpb.BlockIndex_ID, "failed").Return(int64(-1),
+				errors.ErrInternal.WithMessage("lookup key failed"))
+			_, err = cp.GetEvent(stdCtx.Background(), &proxypb.GetEventRequest{
+				EventbusId: snowflake.NewTestID().Uint64(),
+				CeId:       "failed",
+			})
+			So(errors.Is(err, errors.ErrInternal), ShouldBeTrue)
+		})
 	})
 }
 
@@ -415,3 +472,34 @@ func TestControllerProxy_ValidateSubscription(t *testing.T) {
 		})
 	})
 }
+
+func TestControllerProxy_GroupByPartitionKey(t *testing.T) {
+	Convey("test group events by partition key", t, func() {
+		newEvent := func(id, key string) *cloudevents.CloudEvent {
+			e := &cloudevents.CloudEvent{
+				Id:         id,
+				Attributes: map[string]*cloudevents.CloudEvent_CloudEventAttributeValue{},
+			}
+			if key != "" {
+				e.Attributes["partitionkey"] = &cloudevents.CloudEvent_CloudEventAttributeValue{
+					Attr: &cloudevents.CloudEvent_CloudEventAttributeValue_CeString{CeString: key},
+				}
+			}
+			return e
+		}
+		batch := &cloudevents.CloudEventBatch{
+			Events: []*cloudevents.CloudEvent{
+				newEvent("1", "a"), newEvent("2", "b"), newEvent("3", "a"), newEvent("4", ""), newEvent("5", "b"),
+			},
+		}
+		keys, batches := groupByPartitionKey(batch, "partitionkey")
+		So(keys, ShouldResemble, []string{"a", "b", ""})
+		So(batches["a"].Events, ShouldHaveLength, 2)
+		So(batches["a"].Events[0].Id, ShouldEqual, "1")
+		So(batches["a"].Events[1].Id, ShouldEqual, "3")
+		So(batches["b"].Events, ShouldHaveLength, 2)
+		So(batches["b"].Events[0].Id, ShouldEqual, "2")
+		So(batches["b"].Events[1].Id, ShouldEqual, "5")
+		So(batches[""].Events, ShouldHaveLength, 1)
+	})
+}
@@ -32,19 +32,24 @@ func (cp *ControllerProxy) registerAuthentication() {
 
 	cp.authService.RegisterAuthorizeFunc(proxypb.ControllerProxy_CreateEventbus_FullMethodName, authCreateEventbus)
 	cp.authService.RegisterAuthorizeFunc(proxypb.ControllerProxy_DeleteEventbus_FullMethodName, authDeleteEventbus)
+	cp.authService.RegisterAuthorizeFunc(proxypb.ControllerProxy_UpdateEventbus_FullMethodName, authUpdateEventbus)
 	cp.authService.RegisterAuthorizeFunc(proxypb.ControllerProxy_GetEventbus_FullMethodName, authGetEventbus)
 	cp.authService.RegisterAuthorizeFunc(proxypb.ControllerProxy_ListSegment_FullMethodName, authListSegment)
 	cp.authService.RegisterAuthorizeFunc(proxypb.ControllerProxy_LookupOffset_FullMethodName, authLookupOffset)
 	cp.authService.RegisterAuthorizeFunc(proxypb.ControllerProxy_GetEvent_FullMethodName, authGetEvent)
+	cp.authService.RegisterAuthorizeFunc(proxypb.ControllerProxy_RebalanceLeaders_FullMethodName, authRebalanceLeaders)
+	cp.authService.RegisterAuthorizeFunc(proxypb.ControllerProxy_DecommissionVolume_FullMethodName, authDecommissionVolume) //nolint:lll // ok
 
 	cp.authService.RegisterAuthorizeFunc(proxypb.ControllerProxy_CreateSubscription_FullMethodName, authCreateSubscription) //nolint:lll // ok
 	cp.authService.RegisterAuthorizeFunc(proxypb.ControllerProxy_DeleteSubscription_FullMethodName, authDeleteSubscription) //nolint:lll // ok
 	cp.authService.RegisterAuthorizeFunc(proxypb.ControllerProxy_UpdateSubscription_FullMethodName, authUpdateSubscription) //nolint:lll // ok
 	cp.authService.RegisterAuthorizeFunc(proxypb.ControllerProxy_GetSubscription_FullMethodName, authGetSubscription)
+	cp.authService.RegisterAuthorizeFunc(proxypb.ControllerProxy_GetSubscriptionLag_FullMethodName, authGetSubscriptionLag)             //nolint:lll // ok
 	cp.authService.RegisterAuthorizeFunc(proxypb.ControllerProxy_DisableSubscription_FullMethodName, authDisableSubscription)           //nolint:lll // ok
 	cp.authService.RegisterAuthorizeFunc(proxypb.ControllerProxy_ResumeSubscription_FullMethodName, authResumeSubscription)             //nolint:lll // ok
 	cp.authService.RegisterAuthorizeFunc(proxypb.ControllerProxy_ResetOffsetToTimestamp_FullMethodName, authResetOffsetSubscription)    //nolint:lll // ok
 	cp.authService.RegisterAuthorizeFunc(proxypb.ControllerProxy_GetDeadLetterEvent_FullMethodName, authGetDeadLetterEvent)             //nolint:lll // ok
+	cp.authService.RegisterAuthorizeFunc(proxypb.ControllerProxy_GetDeadLetterStats_FullMethodName, authGetDeadLetterStats)             //nolint:lll // ok
 	cp.authService.RegisterAuthorizeFunc(proxypb.ControllerProxy_SetDeadLetterEventOffset_FullMethodName, authSetDeadLetterEventOffset) //nolint:lll // ok
 	cp.authService.RegisterAuthorizeFunc(proxypb.ControllerProxy_ResendDeadLetterEvent_FullMethodName, authResendDeadLetterEvent)       //nolint:lll // ok
 }
@@ -0,0 +1,37 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package block
+
+// CompressAlgorithm is the algorithm used to compress the data of entries in a Block.
+// The values are consistent with meta.CompressAlgorithm.
+type CompressAlgorithm uint8
+
+const (
+	CompressNone CompressAlgorithm = iota
+	CompressLZ4
+	CompressZSTD
+)
+
+func (a CompressAlgorithm) String() string {
+	switch a {
+	case CompressNone:
+		return "none"
+	case CompressLZ4:
+		return "lz4"
+	case CompressZSTD:
+		return "zstd"
+	}
+	return "unknown"
+}
@@ -68,6 +68,8 @@ type Statistics struct {
 	FirstEntryStime int64
 	// LastEntryStime is the millisecond timestamp when the last Entry will be written to Block.
 	LastEntryStime int64
+	// Offloaded is true if the data of Block is moved to tiered storage, so it takes no local space.
+	Offloaded bool
 }
 
 type ArchivedListener interface {
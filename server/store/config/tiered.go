@@ -0,0 +1,86 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	// standard libraries.
+	"errors"
+
+	// this project.
+	"github.com/vanus-labs/vanus/server/store/tiered"
+)
+
+type TieredStoreType string
+
+const (
+	TieredStoreS3   TieredStoreType = "s3"
+	TieredStoreFile TieredStoreType = "file"
+)
+
+type S3 struct {
+	Endpoint        string `yaml:"endpoint"`
+	Region          string `yaml:"region"`
+	Bucket          string `yaml:"bucket"`
+	Prefix          string `yaml:"prefix"`
+	AccessKeyID     string `yaml:"access_key_id"`
+	SecretAccessKey string `yaml:"secret_access_key"`
+	UsePathStyle    bool   `yaml:"use_path_style"`
+}
+
+// Tiered is the configuration of object store which archived blocks are offloaded to.
+type Tiered struct {
+	Type TieredStoreType `yaml:"type"`
+	// Dir is the directory of objects, used by file store.
+	Dir string `yaml:"dir"`
+	S3  S3     `yaml:"s3"`
+}
+
+func (c *Tiered) Validate() error {
+	switch c.Type {
+	case "":
+		return nil
+	case TieredStoreS3:
+		if c.S3.Bucket == "" {
+			return errors.New("tiered: bucket of s3 is required")
+		}
+		return nil
+	case TieredStoreFile:
+		if c.Dir == "" {
+			return errors.New("tiered: dir of file store is required")
+		}
+		return nil
+	default:
+		return errors.New("tiered: unknown object store type")
+	}
+}
+
+func buildObjectStore(cfg Tiered) (tiered.ObjectStore, error) {
+	switch cfg.Type {
+	case TieredStoreS3:
+		return tiered.NewS3Store(tiered.S3Options{
+			Endpoint:        cfg.S3.Endpoint,
+			Region:          cfg.S3.Region,
+			Bucket:          cfg.S3.Bucket,
+			Prefix:          cfg.S3.Prefix,
+			AccessKeyID:     cfg.S3.AccessKeyID,
+			SecretAccessKey: cfg.S3.SecretAccessKey,
+			UsePathStyle:    cfg.S3.UsePathStyle,
+		})
+	case TieredStoreFile:
+		return tiered.NewFileStore(cfg.Dir)
+	default:
+		return nil, errors.New("tiered: unknown object store type")
+	}
+}
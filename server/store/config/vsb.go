@@ -26,15 +26,25 @@ type VSBExecutorParallel struct {
 	Callback int `yaml:"callback"`
 }
 
+// VSBIndexes configures optional attribute indexes of block.
+type VSBIndexes struct {
+	ID        bool   `yaml:"id"`
+	Extension string `yaml:"extension"`
+	// CacheSize is the memory limit of indexes of archived blocks in bytes.
+	CacheSize uint64 `yaml:"cache_size"`
+}
+
 type VSB struct {
 	FlushBatchSize int                 `yaml:"flush_batch_size"`
 	FlushDelayTime string              `yaml:"flush_delay_time"`
 	Parallel       VSBExecutorParallel `yaml:"parallel"`
 	IO             IO                  `yaml:"io"`
+	Indexes        VSBIndexes          `yaml:"indexes"`
+	Tiered         Tiered              `yaml:"tiered"`
 }
 
 func (c *VSB) Validate() error {
-	return nil
+	return c.Tiered.Validate()
 }
 
 func (c *VSB) Options() (opts []vsb.Option) {
@@ -54,5 +64,21 @@ func (c *VSB) Options() (opts []vsb.Option) {
 	if c.IO.Engine != "" {
 		opts = append(opts, vsb.WithIOEngine(buildIOEngine(c.IO)))
 	}
+	if c.Indexes.ID {
+		opts = append(opts, vsb.WithIDIndex())
+	}
+	if c.Indexes.Extension != "" {
+		opts = append(opts, vsb.WithExtensionIndex(c.Indexes.Extension))
+	}
+	if c.Indexes.CacheSize != 0 {
+		opts = append(opts, vsb.WithAttributeIndexCacheSize(int64(c.Indexes.CacheSize)))
+	}
+	if c.Tiered.Type != "" {
+		store, err := buildObjectStore(c.Tiered)
+		if err != nil {
+			panic(err)
+		}
+		opts = append(opts, vsb.WithObjectStore(store))
+	}
 	return opts
 }
@@ -63,6 +63,11 @@ func ResizeFile(f *os.File, size int64) error {
 	return resizeFile(f, size)
 }
 
+// PunchHole deallocates the space of range [off, off+size) in file, and keeps the file size.
+func PunchHole(f *os.File, off, size int64) error {
+	return punchHole(f, off, size)
+}
+
 func doCreateFile(path string, size int64, flag int, sync bool, direct bool) (*os.File, error) {
 	f, err := openFile(path, createFileFlag|flag, sync, direct)
 	if err != nil {
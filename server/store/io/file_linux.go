@@ -27,6 +27,10 @@ const (
 	openFileFlag   = syscall.O_NOATIME
 	createFileFlag = os.O_CREATE | os.O_EXCL | syscall.O_NOATIME
 	syncFlag       = syscall.O_DSYNC
+
+	// See linux/falloc.h.
+	fallocFlKeepSize  = 0x01
+	fallocFlPunchHole = 0x02
 )
 
 func createFile(path string, size int64, flag int, sync bool, direct bool) (*os.File, error) {
@@ -39,3 +43,7 @@ func createFile(path string, size int64, flag int, sync bool, direct bool) (*os.
 func resizeFile(f *os.File, size int64) error {
 	return syscall.Fallocate(int(f.Fd()), 0, 0, size)
 }
+
+func punchHole(f *os.File, off, size int64) error {
+	return syscall.Fallocate(int(f.Fd()), fallocFlPunchHole|fallocFlKeepSize, off, size)
+}
@@ -35,3 +35,8 @@ func createFile(path string, size int64, flag int, sync bool, direct bool) (*os.
 func resizeFile(f *os.File, size int64) error {
 	return f.Truncate(size)
 }
+
+func punchHole(_ *os.File, _, _ int64) error {
+	// Not supported, the space is not released.
+	return nil
+}
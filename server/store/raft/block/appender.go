@@ -65,6 +65,8 @@ type Appender interface {
 	Stop(ctx context.Context)
 	Delete(ctx context.Context)
 	Bootstrap(ctx context.Context, blocks []Peer) error
+	ChangeMembership(ctx context.Context, change MembershipChange) error
+	TransferLeadership(ctx context.Context, transferee vanus.ID) error
 	Status() ClusterStatus
 }
 
@@ -251,7 +253,7 @@ func (a *appender) changeConf(_ context.Context, pbEntry *raftpb.Entry) *raftpb.
 		a.transportExecutor.Execute(func() {
 			if cc.Type == raftpb.ConfChangeRemoveNode {
 				delete(a.hint, cc.NodeID)
-			} else {
+			} else if len(cc.Context) != 0 {
 				a.hint[cc.NodeID] = string(cc.Context)
 			}
 		})
@@ -267,7 +269,7 @@ func (a *appender) changeConf(_ context.Context, pbEntry *raftpb.Entry) *raftpb.
 			for _, ccs := range changes {
 				if ccs.Type == raftpb.ConfChangeRemoveNode {
 					delete(a.hint, ccs.NodeID)
-				} else {
+				} else if len(cc.Context) != 0 {
 					a.hint[ccs.NodeID] = string(cc.Context)
 				}
 			}
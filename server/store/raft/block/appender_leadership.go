@@ -0,0 +1,104 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package block
+
+import (
+	// standard libraries.
+	"context"
+	"errors"
+	"time"
+
+	// first-party libraries.
+	vanus "github.com/vanus-labs/vanus/api/vsr"
+	"github.com/vanus-labs/vanus/pkg/raft"
+	"github.com/vanus-labs/vanus/pkg/raft/tracker"
+
+	// this project.
+	"github.com/vanus-labs/vanus/server/store/block"
+)
+
+var ErrTransferToLearner = errors.New("can not transfer leadership to a learner")
+
+// TransferLeadership asks the leader to hand over leadership to the voter transferee, and returns
+// after transferee has been elected. The transfer is aborted by raft if it isn't finished in an
+// election timeout, in which case it is retried until ctx is done.
+func (a *appender) TransferLeadership(ctx context.Context, transferee vanus.ID) error {
+	ticker := time.NewTicker(defaultTickInterval)
+	defer ticker.Stop()
+
+	for {
+		done, err := a.stepLeadership(transferee)
+		if err != nil || done {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *appender) stepLeadership(transferee vanus.ID) (bool, error) {
+	type result struct {
+		done bool
+		err  error
+	}
+
+	ch := make(chan result, 1)
+	ok := a.raftExecutor.Execute(func() {
+		done, err := a.doStepLeadership(transferee.Uint64())
+		ch <- result{done: done, err: err}
+	})
+	if !ok {
+		return false, raft.ErrStopped
+	}
+	r := <-ch
+	return r.done, r.err
+}
+
+func (a *appender) doStepLeadership(id uint64) (bool, error) {
+	st := a.node.BasicStatus()
+	if st.Lead == id {
+		return true, nil
+	}
+	if st.RaftState != raft.StateLeader {
+		return false, block.ErrNotLeader
+	}
+	if st.LeadTransferee == id {
+		// In progress.
+		return false, nil
+	}
+
+	var (
+		pr    tracker.Progress
+		exist bool
+	)
+	a.node.WithProgress(func(pid uint64, _ raft.ProgressType, p tracker.Progress) {
+		if pid == id {
+			pr, exist = p, true
+		}
+	})
+	if !exist {
+		return false, ErrPeerNotFound
+	}
+	if pr.IsLearner {
+		return false, ErrTransferToLearner
+	}
+
+	a.node.TransferLeader(id)
+	return false, nil
+}
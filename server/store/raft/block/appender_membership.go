@@ -0,0 +1,150 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package block
+
+import (
+	// standard libraries.
+	"context"
+	"errors"
+	"time"
+
+	// first-party libraries.
+	"github.com/vanus-labs/vanus/pkg/raft"
+	"github.com/vanus-labs/vanus/pkg/raft/raftpb"
+	"github.com/vanus-labs/vanus/pkg/raft/tracker"
+
+	// this project.
+	"github.com/vanus-labs/vanus/server/store/block"
+)
+
+var (
+	ErrPeerNotFound       = errors.New("the peer isn't a member of the raft group")
+	ErrLearnerNotCaughtUp = errors.New("the learner hasn't caught up with the leader")
+	ErrRemoveLeader       = errors.New("can not remove the leader from the raft group")
+)
+
+type MembershipChangeType int8
+
+const (
+	// AddLearner adds a peer as learner, which receives the log (or a snapshot) but doesn't vote.
+	AddLearner MembershipChangeType = iota
+	// PromoteLearner promotes a learner to voter once it has caught up with the leader.
+	PromoteLearner
+	// RemovePeer removes a learner or voter from the raft group.
+	RemovePeer
+)
+
+type MembershipChange struct {
+	Type MembershipChangeType
+	Peer Peer
+}
+
+// ChangeMembership proposes change on the leader, and returns after the change has been applied.
+// It is idempotent, so the caller can retry it safely.
+func (a *appender) ChangeMembership(ctx context.Context, change MembershipChange) error {
+	ticker := time.NewTicker(defaultTickInterval)
+	defer ticker.Stop()
+
+	for propose := true; ; propose = false {
+		done, err := a.stepMembership(change, propose)
+		if err != nil || done {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *appender) stepMembership(change MembershipChange, propose bool) (bool, error) {
+	type result struct {
+		done bool
+		err  error
+	}
+
+	ch := make(chan result, 1)
+	ok := a.raftExecutor.Execute(func() {
+		done, err := a.doStepMembership(change, propose)
+		ch <- result{done: done, err: err}
+	})
+	if !ok {
+		return false, raft.ErrStopped
+	}
+	r := <-ch
+	return r.done, r.err
+}
+
+func (a *appender) doStepMembership(change MembershipChange, propose bool) (bool, error) {
+	st := a.node.BasicStatus()
+	if st.RaftState != raft.StateLeader {
+		return false, block.ErrNotLeader
+	}
+
+	id := change.Peer.ID.Uint64()
+	var (
+		pr    tracker.Progress
+		exist bool
+	)
+	a.node.WithProgress(func(pid uint64, _ raft.ProgressType, p tracker.Progress) {
+		if pid == id {
+			pr, exist = p, true
+		}
+	})
+
+	cc := raftpb.ConfChange{
+		NodeID:  id,
+		Context: []byte(change.Peer.Endpoint),
+	}
+	switch change.Type {
+	case AddLearner:
+		if exist {
+			return true, nil
+		}
+		cc.Type = raftpb.ConfChangeAddLearnerNode
+	case PromoteLearner:
+		if !exist {
+			return false, ErrPeerNotFound
+		}
+		if !pr.IsLearner {
+			return true, nil
+		}
+		if !propose {
+			return false, nil
+		}
+		if pr.Match < st.Commit {
+			return false, ErrLearnerNotCaughtUp
+		}
+		cc.Type = raftpb.ConfChangeAddNode
+	case RemovePeer:
+		if !exist {
+			return true, nil
+		}
+		if id == st.ID {
+			return false, ErrRemoveLeader
+		}
+		cc.Type = raftpb.ConfChangeRemoveNode
+		cc.Context = nil
+	default:
+		return false, block.ErrNotSupported
+	}
+
+	if !propose {
+		return false, nil
+	}
+	return false, a.node.ProposeConfChange(cc)
+}
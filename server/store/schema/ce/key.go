@@ -10,7 +10,11 @@
 
 package ce
 
-import "github.com/vanus-labs/vanus/server/store/block"
+import (
+	// this project.
+	"github.com/vanus-labs/vanus/server/store/block"
+	cetype "github.com/vanus-labs/vanus/server/store/schema/ce/typesystem"
+)
 
 type stimeKey struct {
 	block.EmptyEntry
@@ -27,3 +31,41 @@ func (e *stimeKey) GetInt64(ordinal int) int64 {
 func StimeKey(stime int64) block.Entry {
 	return &stimeKey{stime: stime}
 }
+
+// Indexes of block, used by block.Seeker.
+const (
+	StimeIndex int64 = iota
+	IDIndex
+	ExtensionIndex
+)
+
+type idKey struct {
+	block.EmptyEntry
+	id string
+}
+
+func (e *idKey) GetString(ordinal int) string {
+	if ordinal == IDOrdinal {
+		return e.id
+	}
+	return e.EmptyEntry.GetString(ordinal)
+}
+
+func IDKey(id string) block.Entry {
+	return &idKey{id: id}
+}
+
+type extensionKey struct {
+	block.EmptyEntry
+	val []byte
+}
+
+// GetExtensionAttribute returns the value for any attribute, because the indexed extension attribute is configured
+// by block engine.
+func (e *extensionKey) GetExtensionAttribute(_ []byte) []byte {
+	return e.val
+}
+
+func ExtensionKey(val string) block.Entry {
+	return &extensionKey{val: cetype.NewStringValue(val).Value()}
+}
@@ -200,3 +200,17 @@ func NewURIRefValue(uriRef string) block.ValueMarshaler {
 func NewTimestampValue(secs int64, nanos int32) block.ValueMarshaler {
 	return newTimestampValue(secs, nanos)
 }
+
+// StringOf returns the content of a marshaled string-like value, which is string, bytes, uri or uri-ref.
+func StringOf(raw []byte) (string, bool) {
+	sz := len(raw)
+	if sz == 0 {
+		return "", false
+	}
+	switch raw[sz-1] {
+	case AttrTypeString, AttrTypeBytes, AttrTypeURI, AttrTypeURIRef:
+		return string(raw[:sz-1]), true
+	default:
+		return "", false
+	}
+}
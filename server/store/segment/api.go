@@ -23,9 +23,14 @@ import (
 
 	// first-party libraries.
 	cepb "github.com/vanus-labs/vanus/api/cloudevents"
+	"github.com/vanus-labs/vanus/api/errors"
 	segpb "github.com/vanus-labs/vanus/api/segment"
 	vanus "github.com/vanus-labs/vanus/api/vsr"
+
 	// this project.
+	"github.com/vanus-labs/vanus/server/store/block"
+	raft "github.com/vanus-labs/vanus/server/store/raft/block"
+	ceschema "github.com/vanus-labs/vanus/server/store/schema/ce"
 )
 
 type segmentServer struct {
@@ -61,7 +66,8 @@ func (s *segmentServer) Status(_ context.Context, _ *emptypb.Empty) (*segpb.Stat
 
 func (s *segmentServer) CreateBlock(ctx context.Context, req *segpb.CreateBlockRequest) (*emptypb.Empty, error) {
 	blockID := vanus.NewIDFromUint64(req.Id)
-	if err := s.srv.CreateBlock(ctx, blockID, req.Size); err != nil {
+	compress := block.CompressAlgorithm(req.CompressAlgorithm)
+	if err := s.srv.CreateBlock(ctx, blockID, req.Size, compress); err != nil {
 		return nil, err
 	}
 
@@ -114,6 +120,44 @@ func (s *segmentServer) InactivateSegment(
 	return &emptypb.Empty{}, nil
 }
 
+func (s *segmentServer) ChangeMembership(
+	ctx context.Context, req *segpb.ChangeMembershipRequest,
+) (*emptypb.Empty, error) {
+	var typ raft.MembershipChangeType
+	switch req.Type {
+	case segpb.MembershipChangeType_ADD_LEARNER:
+		typ = raft.AddLearner
+	case segpb.MembershipChangeType_PROMOTE_LEARNER:
+		typ = raft.PromoteLearner
+	case segpb.MembershipChangeType_REMOVE_PEER:
+		typ = raft.RemovePeer
+	default:
+		return nil, errors.ErrInvalidRequest.WithMessage("unknown membership change type")
+	}
+
+	change := raft.MembershipChange{
+		Type: typ,
+		Peer: raft.Peer{
+			ID:       vanus.NewIDFromUint64(req.PeerId),
+			Endpoint: req.Endpoint,
+		},
+	}
+	if err := s.srv.ChangeMembership(ctx, vanus.NewIDFromUint64(req.BlockId), change); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *segmentServer) TransferLeadership(
+	ctx context.Context, req *segpb.TransferLeadershipRequest,
+) (*emptypb.Empty, error) {
+	err := s.srv.TransferLeadership(ctx, vanus.NewIDFromUint64(req.BlockId), vanus.NewIDFromUint64(req.TransfereeId))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (s *segmentServer) AppendToBlock(
 	ctx context.Context, req *segpb.AppendToBlockRequest,
 ) (*segpb.AppendToBlockResponse, error) {
@@ -131,13 +175,15 @@ func (s *segmentServer) ReadFromBlock(
 	ctx context.Context, req *segpb.ReadFromBlockRequest,
 ) (*segpb.ReadFromBlockResponse, error) {
 	blockID := vanus.NewIDFromUint64(req.BlockId)
-	events, err := s.srv.ReadFromBlock(ctx, blockID, req.Offset, int(req.Number), req.PollingTimeout)
+	events, next, err := s.srv.ReadFromBlock(
+		ctx, blockID, req.Offset, int(req.Number), req.PollingTimeout, req.Filter)
 	if err != nil {
 		return nil, err
 	}
 
 	return &segpb.ReadFromBlockResponse{
-		Events: &cepb.CloudEventBatch{Events: events},
+		Events:     &cepb.CloudEventBatch{Events: events},
+		NextOffset: next,
 	}, nil
 }
 
@@ -145,7 +191,40 @@ func (s *segmentServer) LookupOffsetInBlock(
 	ctx context.Context, req *segpb.LookupOffsetInBlockRequest,
 ) (*segpb.LookupOffsetInBlockResponse, error) {
 	blockID := vanus.NewIDFromUint64(req.BlockId)
-	off, err := s.srv.LookupOffsetInBlock(ctx, blockID, req.Stime)
+
+	if req.Index == segpb.BlockIndex_STIME {
+		off, err := s.srv.LookupOffsetInBlock(ctx, blockID, req.Stime)
+		if err != nil {
+			return nil, err
+		}
+		return &segpb.LookupOffsetInBlockResponse{Offset: off}, nil
+	}
+
+	var index int64
+	switch req.Index {
+	case segpb.BlockIndex_ID:
+		index = ceschema.IDIndex
+	case segpb.BlockIndex_EXTENSION:
+		index = ceschema.ExtensionIndex
+	default:
+		return nil, errors.ErrInvalidRequest.WithMessage("unknown block index")
+	}
+
+	var flag block.SeekKeyFlag
+	switch req.Flag {
+	case segpb.LookupKeyFlag_EXACT:
+		flag = block.SeekKeyExact
+	case segpb.LookupKeyFlag_PREFIX:
+		flag = block.SeekPrefix
+	case segpb.LookupKeyFlag_PREFIX_LAST:
+		flag = block.SeekPrefixLast
+	case segpb.LookupKeyFlag_PREFIX_LAST_OR_PREV:
+		flag = block.SeekPrefixLastOrPrev
+	default:
+		return nil, errors.ErrInvalidRequest.WithMessage("unknown lookup flag")
+	}
+
+	off, err := s.srv.LookupKeyInBlock(ctx, blockID, index, req.Key, flag)
 	if err != nil {
 		return nil, err
 	}
@@ -34,6 +34,9 @@ import (
 	// this project.
 	primitive "github.com/vanus-labs/vanus/pkg"
 	"github.com/vanus-labs/vanus/pkg/snowflake"
+	"github.com/vanus-labs/vanus/server/store/block"
+	raft "github.com/vanus-labs/vanus/server/store/raft/block"
+	ceschema "github.com/vanus-labs/vanus/server/store/schema/ce"
 )
 
 func TestSegmentServer(t *testing.T) {
@@ -74,13 +77,14 @@ func TestSegmentServer(t *testing.T) {
 		})
 
 		Convey("CreateBlock()", func() {
-			srv.EXPECT().CreateBlock(Any(), Not(vanus.EmptyID()), Not(0)).Return(nil)
-			srv.EXPECT().CreateBlock(Any(), Eq(vanus.EmptyID()), Any()).Return(errors.ErrInvalidRequest)
-			srv.EXPECT().CreateBlock(Any(), Any(), Eq(int64(0))).Return(errors.ErrInvalidRequest)
+			srv.EXPECT().CreateBlock(Any(), Not(vanus.EmptyID()), Not(0), Eq(block.CompressLZ4)).Return(nil)
+			srv.EXPECT().CreateBlock(Any(), Eq(vanus.EmptyID()), Any(), Any()).Return(errors.ErrInvalidRequest)
+			srv.EXPECT().CreateBlock(Any(), Any(), Eq(int64(0)), Any()).Return(errors.ErrInvalidRequest)
 
 			req := &segpb.CreateBlockRequest{
-				Id:   snowflake.NewTestID().Uint64(),
-				Size: 4 * 1024 * 1024,
+				Id:                snowflake.NewTestID().Uint64(),
+				Size:              4 * 1024 * 1024,
+				CompressAlgorithm: metapb.CompressAlgorithm_LZ4,
 			}
 			resp, err := ss.CreateBlock(context.Background(), req)
 			So(err, ShouldBeNil)
@@ -164,6 +168,49 @@ func TestSegmentServer(t *testing.T) {
 			So(err, ShouldBeNil)
 		})
 
+		Convey("ChangeMembership()", func() {
+			id := snowflake.NewTestID()
+			peer := snowflake.NewTestID()
+			srv.EXPECT().ChangeMembership(Any(), Eq(id), raft.MembershipChange{
+				Type: raft.AddLearner,
+				Peer: raft.Peer{ID: peer, Endpoint: "127.0.0.1:11811"},
+			}).Return(nil)
+
+			_, err := ss.ChangeMembership(context.Background(), &segpb.ChangeMembershipRequest{
+				BlockId:  id.Uint64(),
+				Type:     segpb.MembershipChangeType_ADD_LEARNER,
+				PeerId:   peer.Uint64(),
+				Endpoint: "127.0.0.1:11811",
+			})
+			So(err, ShouldBeNil)
+
+			_, err = ss.ChangeMembership(context.Background(), &segpb.ChangeMembershipRequest{
+				BlockId: id.Uint64(),
+				Type:    segpb.MembershipChangeType(100),
+				PeerId:  peer.Uint64(),
+			})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("TransferLeadership()", func() {
+			id := snowflake.NewTestID()
+			transferee := snowflake.NewTestID()
+			srv.EXPECT().TransferLeadership(Any(), Eq(id), Eq(transferee)).Return(nil)
+
+			_, err := ss.TransferLeadership(context.Background(), &segpb.TransferLeadershipRequest{
+				BlockId:      id.Uint64(),
+				TransfereeId: transferee.Uint64(),
+			})
+			So(err, ShouldBeNil)
+
+			srv.EXPECT().TransferLeadership(Any(), Eq(id), Eq(transferee)).Return(errors.ErrNotRaftLeader)
+			_, err = ss.TransferLeadership(context.Background(), &segpb.TransferLeadershipRequest{
+				BlockId:      id.Uint64(),
+				TransfereeId: transferee.Uint64(),
+			})
+			So(err, ShouldNotBeNil)
+		})
+
 		Convey("AppendToBlock()", func() {
 			srv.EXPECT().AppendToBlock(Any(), Not(vanus.EmptyID()), Not(Len(0))).Return([]int64{1}, nil)
 			srv.EXPECT().AppendToBlock(Any(), Eq(vanus.EmptyID()), Any()).Return(nil, errors.ErrInvalidRequest)
@@ -199,10 +246,11 @@ func TestSegmentServer(t *testing.T) {
 		Convey("ReadFromBlock()", func() {
 			id := snowflake.NewTestID()
 			srv.EXPECT().ReadFromBlock(Any(), Not(vanus.EmptyID()), Any(), Not(0),
-				Any()).Return(make([]*cepb.CloudEvent, 1), nil)
+				Any(), Any()).Return(make([]*cepb.CloudEvent, 1), int64(1), nil)
 			srv.EXPECT().ReadFromBlock(Any(), Eq(vanus.EmptyID()), Any(), Any(),
-				Any()).Return(nil, errors.ErrInvalidRequest)
-			srv.EXPECT().ReadFromBlock(Any(), Any(), Any(), Eq(0), Any()).Return(nil, errors.ErrResourceNotFound)
+				Any(), Any()).Return(nil, int64(0), errors.ErrInvalidRequest)
+			srv.EXPECT().ReadFromBlock(Any(), Any(), Any(), Eq(0), Any(),
+				Any()).Return(nil, int64(0), errors.ErrResourceNotFound)
 
 			req := &segpb.ReadFromBlockRequest{
 				BlockId: id.Uint64(),
@@ -211,6 +259,7 @@ func TestSegmentServer(t *testing.T) {
 			resp, err := ss.ReadFromBlock(context.Background(), req)
 			So(err, ShouldBeNil)
 			So(resp.GetEvents().GetEvents(), ShouldResemble, []*cepb.CloudEvent{nil})
+			So(resp.GetNextOffset(), ShouldEqual, 1)
 
 			req = &segpb.ReadFromBlockRequest{
 				BlockId: 0,
@@ -224,5 +273,61 @@ func TestSegmentServer(t *testing.T) {
 			_, err = ss.ReadFromBlock(context.Background(), req)
 			So(err, ShouldEqual, errors.ErrResourceNotFound)
 		})
+
+		Convey("LookupOffsetInBlock()", func() {
+			id := snowflake.NewTestID()
+			srv.EXPECT().LookupOffsetInBlock(Any(), Eq(id), int64(1000)).Return(int64(3), nil)
+			srv.EXPECT().LookupKeyInBlock(Any(), Eq(id), ceschema.IDIndex, "id",
+				block.SeekKeyExact).Return(int64(1), nil)
+			srv.EXPECT().LookupKeyInBlock(Any(), Eq(id), ceschema.ExtensionIndex, "ext",
+				block.SeekKeyExact).Return(int64(-1), nil)
+			srv.EXPECT().LookupKeyInBlock(Any(), Eq(id), ceschema.IDIndex, "order/",
+				block.SeekPrefixLast).Return(int64(5), nil)
+
+			resp, err := ss.LookupOffsetInBlock(context.Background(), &segpb.LookupOffsetInBlockRequest{
+				BlockId: id.Uint64(),
+				Stime:   1000,
+			})
+			So(err, ShouldBeNil)
+			So(resp.Offset, ShouldEqual, 3)
+
+			resp, err = ss.LookupOffsetInBlock(context.Background(), &segpb.LookupOffsetInBlockRequest{
+				BlockId: id.Uint64(),
+				Index:   segpb.BlockIndex_ID,
+				Key:     "id",
+			})
+			So(err, ShouldBeNil)
+			So(resp.Offset, ShouldEqual, 1)
+
+			resp, err = ss.LookupOffsetInBlock(context.Background(), &segpb.LookupOffsetInBlockRequest{
+				BlockId: id.Uint64(),
+				Index:   segpb.BlockIndex_EXTENSION,
+				Key:     "ext",
+			})
+			So(err, ShouldBeNil)
+			So(resp.Offset, ShouldEqual, -1)
+
+			resp, err = ss.LookupOffsetInBlock(context.Background(), &segpb.LookupOffsetInBlockRequest{
+				BlockId: id.Uint64(),
+				Index:   segpb.BlockIndex_ID,
+				Key:     "order/",
+				Flag:    segpb.LookupKeyFlag_PREFIX_LAST,
+			})
+			So(err, ShouldBeNil)
+			So(resp.Offset, ShouldEqual, 5)
+
+			_, err = ss.LookupOffsetInBlock(context.Background(), &segpb.LookupOffsetInBlockRequest{
+				BlockId: id.Uint64(),
+				Index:   segpb.BlockIndex(100),
+			})
+			So(err, ShouldNotBeNil)
+
+			_, err = ss.LookupOffsetInBlock(context.Background(), &segpb.LookupOffsetInBlockRequest{
+				BlockId: id.Uint64(),
+				Index:   segpb.BlockIndex_ID,
+				Flag:    segpb.LookupKeyFlag(100),
+			})
+			So(err, ShouldNotBeNil)
+		})
 	})
 }
@@ -47,7 +47,16 @@ func (c *Config) Validate() error {
 }
 
 type VolumeInfo struct {
-	ID       uint16 `json:"id"`
-	Dir      string `json:"dir"`
-	Capacity uint64 `json:"capacity"`
+	ID       uint16         `json:"id"`
+	Dir      string         `json:"dir"`
+	Capacity uint64         `json:"capacity"`
+	Topology VolumeTopology `json:"topology"`
+}
+
+// VolumeTopology is the failure domains the volume located in, controller spreads replicas of
+// a segment across them.
+type VolumeTopology struct {
+	Zone string `json:"zone"`
+	Rack string `json:"rack"`
+	Host string `json:"host"`
 }
@@ -43,6 +43,9 @@ volume:
   id: 1
   dir: /vanus/volume/store-1
   capacity: 536870912
+  topology:
+    zone: us-west-2a
+    rack: rack-1
 meta_store:
   wal:
     file_size: 4194304
@@ -56,6 +59,13 @@ raft:
   wal:
     io:
       engine: io_uring
+vsb:
+  tiered:
+    type: s3
+    s3:
+      endpoint: http://127.0.0.1:9000
+      bucket: vanus
+      use_path_style: true
 `)
 		So(err, ShouldBeNil)
 
@@ -71,6 +81,7 @@ raft:
 
 		So(cfg.Volume.ID, ShouldEqual, 1)
 		So(cfg.Volume.Dir, ShouldEqual, "/vanus/volume/store-1")
+		So(cfg.Volume.Topology, ShouldResemble, VolumeTopology{Zone: "us-west-2a", Rack: "rack-1"})
 		So(cfg.Volume.Capacity, ShouldEqual, 536870912)
 
 		So(cfg.MetaStore.WAL.FileSize, ShouldEqual, 4194304)
@@ -88,6 +99,11 @@ raft:
 				cfg.Raft.WAL.Options()
 			}, ShouldPanic)
 		}
+
+		So(cfg.VSB.Tiered.Type, ShouldEqual, config.TieredStoreS3)
+		So(cfg.VSB.Tiered.S3.Endpoint, ShouldEqual, "http://127.0.0.1:9000")
+		So(cfg.VSB.Tiered.S3.Bucket, ShouldEqual, "vanus")
+		So(cfg.VSB.Tiered.S3.UsePathStyle, ShouldBeTrue)
 	})
 
 	Convey("store config validation", t, func() {
@@ -120,5 +136,15 @@ raft:
 		}
 		err = cfg.Validate()
 		So(err, ShouldNotBeNil)
+
+		cfg = Config{
+			VSB: config.VSB{
+				Tiered: config.Tiered{
+					Type: config.TieredStoreFile,
+				},
+			},
+		}
+		err = cfg.Validate()
+		So(err, ShouldNotBeNil)
 	})
 }
@@ -0,0 +1,110 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"strings"
+
+	// first-party libraries.
+	segpb "github.com/vanus-labs/vanus/api/segment"
+
+	// this project.
+	"github.com/vanus-labs/vanus/server/store/block"
+	ceschema "github.com/vanus-labs/vanus/server/store/schema/ce"
+	cetype "github.com/vanus-labs/vanus/server/store/schema/ce/typesystem"
+)
+
+const extensionOrdinal = -1
+
+var attributeOrdinals = map[string]int{
+	"id":              ceschema.IDOrdinal,
+	"source":          ceschema.SourceOrdinal,
+	"specversion":     ceschema.SpecVersionOrdinal,
+	"type":            ceschema.TypeOrdinal,
+	"datacontenttype": ceschema.DataContentTypeOrdinal,
+	"dataschema":      ceschema.DataSchemaOrdinal,
+	"subject":         ceschema.SubjectOrdinal,
+}
+
+type meetCondition func(value, compareValue string) bool
+
+type condition struct {
+	ordinal int
+	attr    []byte
+	value   string
+	meet    meetCondition
+}
+
+func (c *condition) match(entry block.Entry) bool {
+	if c.ordinal != extensionOrdinal {
+		return c.meet(entry.GetString(c.ordinal), c.value)
+	}
+
+	v := entry.GetExtensionAttribute(c.attr)
+	n := len(v)
+	if n == 0 {
+		return false
+	}
+	switch v[n-1] {
+	case cetype.AttrTypeString, cetype.AttrTypeURI, cetype.AttrTypeURIRef:
+		return c.meet(string(v[:n-1]), c.value)
+	}
+	// The trigger compares the formatted value of other types, keep the entry for it.
+	return true
+}
+
+// attributeFilter is the compiled segpb.AttributeFilter evaluated on entries.
+type attributeFilter struct {
+	conditions []condition
+}
+
+func newAttributeFilter(f *segpb.AttributeFilter) *attributeFilter {
+	if f == nil {
+		return nil
+	}
+
+	var conditions []condition
+	add := func(m map[string]string, meet meetCondition) {
+		for attr, v := range m {
+			c := condition{ordinal: extensionOrdinal, value: v, meet: meet}
+			if ordinal, ok := attributeOrdinals[attr]; ok {
+				c.ordinal = ordinal
+			} else {
+				c.attr = []byte(attr)
+			}
+			conditions = append(conditions, c)
+		}
+	}
+	add(f.Exact, func(value, compareValue string) bool {
+		return value == compareValue
+	})
+	add(f.Prefix, strings.HasPrefix)
+	add(f.Suffix, strings.HasSuffix)
+
+	if len(conditions) == 0 {
+		return nil
+	}
+	return &attributeFilter{conditions: conditions}
+}
+
+func (f *attributeFilter) match(entry block.Entry) bool {
+	for i := range f.conditions {
+		if !f.conditions[i].match(entry) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// first-party libraries.
+	cepb "github.com/vanus-labs/vanus/api/cloudevents"
+	segpb "github.com/vanus-labs/vanus/api/segment"
+
+	// this project.
+	ceconv "github.com/vanus-labs/vanus/server/store/schema/ce/convert"
+)
+
+func TestAttributeFilter(t *testing.T) {
+	entry := ceconv.ToEntry(&cepb.CloudEvent{
+		Id:          "id-1",
+		Source:      "vanus.ai/source",
+		SpecVersion: "1.0",
+		Type:        "order.created",
+		Attributes: map[string]*cepb.CloudEvent_CloudEventAttributeValue{
+			"subject": {Attr: &cepb.CloudEvent_CloudEventAttributeValue_CeString{CeString: "orders/1"}},
+			"region":  {Attr: &cepb.CloudEvent_CloudEventAttributeValue_CeString{CeString: "us-west-1"}},
+			"link":    {Attr: &cepb.CloudEvent_CloudEventAttributeValue_CeUri{CeUri: "https://vanus.ai/orders/1"}},
+			"count":   {Attr: &cepb.CloudEvent_CloudEventAttributeValue_CeInteger{CeInteger: 3}},
+		},
+	})
+
+	Convey("test attribute filter", t, func() {
+		Convey("empty filter", func() {
+			So(newAttributeFilter(nil), ShouldBeNil)
+			So(newAttributeFilter(&segpb.AttributeFilter{}), ShouldBeNil)
+		})
+
+		Convey("context attributes", func() {
+			f := newAttributeFilter(&segpb.AttributeFilter{
+				Exact:  map[string]string{"type": "order.created", "specversion": "1.0"},
+				Prefix: map[string]string{"source": "vanus.ai/"},
+				Suffix: map[string]string{"subject": "/1"},
+			})
+			So(f.match(entry), ShouldBeTrue)
+
+			f = newAttributeFilter(&segpb.AttributeFilter{
+				Exact:  map[string]string{"type": "order.created"},
+				Suffix: map[string]string{"subject": "/2"},
+			})
+			So(f.match(entry), ShouldBeFalse)
+
+			f = newAttributeFilter(&segpb.AttributeFilter{Exact: map[string]string{"dataschema": ""}})
+			So(f.match(entry), ShouldBeTrue)
+		})
+
+		Convey("extension attributes", func() {
+			f := newAttributeFilter(&segpb.AttributeFilter{
+				Prefix: map[string]string{"region": "us-"},
+				Suffix: map[string]string{"link": "/orders/1"},
+			})
+			So(f.match(entry), ShouldBeTrue)
+
+			f = newAttributeFilter(&segpb.AttributeFilter{Exact: map[string]string{"region": "us-east-1"}})
+			So(f.match(entry), ShouldBeFalse)
+
+			f = newAttributeFilter(&segpb.AttributeFilter{Exact: map[string]string{"absent": "v"}})
+			So(f.match(entry), ShouldBeFalse)
+		})
+
+		Convey("leave values of other types to the trigger", func() {
+			f := newAttributeFilter(&segpb.AttributeFilter{Exact: map[string]string{"count": "4"}})
+			So(f.match(entry), ShouldBeTrue)
+		})
+	})
+}
@@ -69,6 +69,20 @@ func (mr *MockReplicaMockRecorder) Bootstrap(ctx, blocks any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bootstrap", reflect.TypeOf((*MockReplica)(nil).Bootstrap), ctx, blocks)
 }
 
+// ChangeMembership mocks base method.
+func (m *MockReplica) ChangeMembership(ctx context.Context, change block0.MembershipChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeMembership", ctx, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeMembership indicates an expected call of ChangeMembership.
+func (mr *MockReplicaMockRecorder) ChangeMembership(ctx, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeMembership", reflect.TypeOf((*MockReplica)(nil).ChangeMembership), ctx, change)
+}
+
 // Close mocks base method.
 func (m *MockReplica) Close(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -168,3 +182,17 @@ func (mr *MockReplicaMockRecorder) Status() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockReplica)(nil).Status))
 }
+
+// TransferLeadership mocks base method.
+func (m *MockReplica) TransferLeadership(ctx context.Context, transferee vsr.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferLeadership", ctx, transferee)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferLeadership indicates an expected call of TransferLeadership.
+func (mr *MockReplicaMockRecorder) TransferLeadership(ctx, transferee any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferLeadership", reflect.TypeOf((*MockReplica)(nil).TransferLeadership), ctx, transferee)
+}
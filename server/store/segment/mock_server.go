@@ -16,8 +16,11 @@ import (
 
 	cloudevents "github.com/vanus-labs/vanus/api/cloudevents"
 	meta "github.com/vanus-labs/vanus/api/meta"
+	segment "github.com/vanus-labs/vanus/api/segment"
 	vsr "github.com/vanus-labs/vanus/api/vsr"
 	pkg "github.com/vanus-labs/vanus/pkg"
+	block "github.com/vanus-labs/vanus/server/store/block"
+	block0 "github.com/vanus-labs/vanus/server/store/raft/block"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -73,18 +76,32 @@ func (mr *MockServerMockRecorder) AppendToBlock(ctx, id, events any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendToBlock", reflect.TypeOf((*MockServer)(nil).AppendToBlock), ctx, id, events)
 }
 
+// ChangeMembership mocks base method.
+func (m *MockServer) ChangeMembership(ctx context.Context, id vsr.ID, change block0.MembershipChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeMembership", ctx, id, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeMembership indicates an expected call of ChangeMembership.
+func (mr *MockServerMockRecorder) ChangeMembership(ctx, id, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeMembership", reflect.TypeOf((*MockServer)(nil).ChangeMembership), ctx, id, change)
+}
+
 // CreateBlock mocks base method.
-func (m *MockServer) CreateBlock(ctx context.Context, id vsr.ID, size int64) error {
+func (m *MockServer) CreateBlock(ctx context.Context, id vsr.ID, size int64, compress block.CompressAlgorithm) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBlock", ctx, id, size)
+	ret := m.ctrl.Call(m, "CreateBlock", ctx, id, size, compress)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateBlock indicates an expected call of CreateBlock.
-func (mr *MockServerMockRecorder) CreateBlock(ctx, id, size any) *gomock.Call {
+func (mr *MockServerMockRecorder) CreateBlock(ctx, id, size, compress any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlock", reflect.TypeOf((*MockServer)(nil).CreateBlock), ctx, id, size)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlock", reflect.TypeOf((*MockServer)(nil).CreateBlock), ctx, id, size, compress)
 }
 
 // DescribeBlock mocks base method.
@@ -130,6 +147,21 @@ func (mr *MockServerMockRecorder) Initialize(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockServer)(nil).Initialize), arg0)
 }
 
+// LookupKeyInBlock mocks base method.
+func (m *MockServer) LookupKeyInBlock(ctx context.Context, id vsr.ID, index int64, key string, flag block.SeekKeyFlag) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupKeyInBlock", ctx, id, index, key, flag)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupKeyInBlock indicates an expected call of LookupKeyInBlock.
+func (mr *MockServerMockRecorder) LookupKeyInBlock(ctx, id, index, key, flag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupKeyInBlock", reflect.TypeOf((*MockServer)(nil).LookupKeyInBlock), ctx, id, index, key, flag)
+}
+
 // LookupOffsetInBlock mocks base method.
 func (m *MockServer) LookupOffsetInBlock(ctx context.Context, id vsr.ID, stime int64) (int64, error) {
 	m.ctrl.T.Helper()
@@ -146,18 +178,19 @@ func (mr *MockServerMockRecorder) LookupOffsetInBlock(ctx, id, stime any) *gomoc
 }
 
 // ReadFromBlock mocks base method.
-func (m *MockServer) ReadFromBlock(ctx context.Context, id vsr.ID, seq int64, num int, pollingTimeout uint32) ([]*cloudevents.CloudEvent, error) {
+func (m *MockServer) ReadFromBlock(ctx context.Context, id vsr.ID, seq int64, num int, pollingTimeout uint32, filter *segment.AttributeFilter) ([]*cloudevents.CloudEvent, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadFromBlock", ctx, id, seq, num, pollingTimeout)
+	ret := m.ctrl.Call(m, "ReadFromBlock", ctx, id, seq, num, pollingTimeout, filter)
 	ret0, _ := ret[0].([]*cloudevents.CloudEvent)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // ReadFromBlock indicates an expected call of ReadFromBlock.
-func (mr *MockServerMockRecorder) ReadFromBlock(ctx, id, seq, num, pollingTimeout any) *gomock.Call {
+func (mr *MockServerMockRecorder) ReadFromBlock(ctx, id, seq, num, pollingTimeout, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFromBlock", reflect.TypeOf((*MockServer)(nil).ReadFromBlock), ctx, id, seq, num, pollingTimeout)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFromBlock", reflect.TypeOf((*MockServer)(nil).ReadFromBlock), ctx, id, seq, num, pollingTimeout, filter)
 }
 
 // RegisterToController mocks base method.
@@ -243,3 +276,17 @@ func (mr *MockServerMockRecorder) Stop(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockServer)(nil).Stop), ctx)
 }
+
+// TransferLeadership mocks base method.
+func (m *MockServer) TransferLeadership(ctx context.Context, id, transferee vsr.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferLeadership", ctx, id, transferee)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferLeadership indicates an expected call of TransferLeadership.
+func (mr *MockServerMockRecorder) TransferLeadership(ctx, id, transferee any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferLeadership", reflect.TypeOf((*MockServer)(nil).TransferLeadership), ctx, id, transferee)
+}
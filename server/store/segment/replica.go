@@ -35,6 +35,8 @@ type Replica interface {
 
 	IDStr() string
 	Bootstrap(ctx context.Context, blocks []raft.Peer) error
+	ChangeMembership(ctx context.Context, change raft.MembershipChange) error
+	TransferLeadership(ctx context.Context, transferee vanus.ID) error
 	Close(ctx context.Context) error
 	Delete(ctx context.Context) error
 	Status() *metapb.SegmentHealthInfo
@@ -61,6 +63,14 @@ func (r *replica) Bootstrap(ctx context.Context, peers []raft.Peer) error {
 	return r.appender.Bootstrap(ctx, peers)
 }
 
+func (r *replica) ChangeMembership(ctx context.Context, change raft.MembershipChange) error {
+	return r.appender.ChangeMembership(ctx, change)
+}
+
+func (r *replica) TransferLeadership(ctx context.Context, transferee vanus.ID) error {
+	return r.appender.TransferLeadership(ctx, transferee)
+}
+
 func (r *replica) Close(ctx context.Context) error {
 	r.appender.Stop(ctx)
 	return r.raw.Close(ctx)
@@ -97,6 +107,7 @@ func (r *replica) Status() *metapb.SegmentHealthInfo {
 		Leader:             cs.Leader.Uint64(),
 		Term:               cs.Term,
 		FirstEventBornTime: stat.FirstEntryStime,
+		Offloaded:          stat.Offloaded,
 	}
 	if stat.Archived {
 		info.LastEventBornTime = stat.LastEntryStime
@@ -104,10 +115,12 @@ func (r *replica) Status() *metapb.SegmentHealthInfo {
 	return info
 }
 
-func (s *server) createBlock(ctx context.Context, id vanus.ID, size int64) (Replica, error) {
+func (s *server) createBlock(
+	ctx context.Context, id vanus.ID, size int64, compress block.CompressAlgorithm,
+) (Replica, error) {
 	// Create block.
 	e, _ := s.rawEngines.Resolve(raw.VSB)
-	r, err := e.Create(ctx, id, size)
+	r, err := e.Create(ctx, id, size, compress)
 	if err != nil {
 		return nil, err
 	}
@@ -66,6 +66,8 @@ import (
 const (
 	defaultLeaderInfoBufferSize = 256
 	defaultForceStopTimeout     = 30 * time.Second
+	// maxFilterScanNumber is the max number of entries scanned by a filtered read.
+	maxFilterScanNumber = 4096
 )
 
 type Server interface {
@@ -77,16 +79,24 @@ type Server interface {
 	Stop(ctx context.Context) error
 	Status() primitive.ServerState
 
-	CreateBlock(ctx context.Context, id vanus.ID, size int64) error
+	CreateBlock(ctx context.Context, id vanus.ID, size int64, compress block.CompressAlgorithm) error
 	RemoveBlock(ctx context.Context, id vanus.ID) error
 	DescribeBlock(ctx context.Context, id vanus.ID) (*metapb.SegmentHealthInfo, error)
 
 	ActivateSegment(ctx context.Context, logID vanus.ID, segID vanus.ID, replicas map[vanus.ID]string) error
 	InactivateSegment(ctx context.Context) error
+	ChangeMembership(ctx context.Context, id vanus.ID, change raft.MembershipChange) error
+	TransferLeadership(ctx context.Context, id, transferee vanus.ID) error
 
 	AppendToBlock(ctx context.Context, id vanus.ID, events []*cepb.CloudEvent) ([]int64, error)
-	ReadFromBlock(ctx context.Context, id vanus.ID, seq int64, num int, pollingTimeout uint32) ([]*cepb.CloudEvent, error)
+	// ReadFromBlock returns the events matching filter and the offset next to the last scanned event.
+	ReadFromBlock(
+		ctx context.Context, id vanus.ID, seq int64, num int, pollingTimeout uint32, filter *segpb.AttributeFilter,
+	) ([]*cepb.CloudEvent, int64, error)
 	LookupOffsetInBlock(ctx context.Context, id vanus.ID, stime int64) (int64, error)
+	LookupKeyInBlock(
+		ctx context.Context, id vanus.ID, index int64, key string, flag block.SeekKeyFlag,
+	) (int64, error)
 }
 
 func NewServer(cfg Config, debug bool) (Server, error) {
@@ -393,7 +403,9 @@ func (s *server) Status() primitive.ServerState {
 	return s.state
 }
 
-func (s *server) CreateBlock(ctx context.Context, id vanus.ID, size int64) error {
+func (s *server) CreateBlock(
+	ctx context.Context, id vanus.ID, size int64, compress block.CompressAlgorithm,
+) error {
 	ctx, span := s.tracer.Start(ctx, "CreateBlock")
 	defer span.End()
 
@@ -402,6 +414,10 @@ func (s *server) CreateBlock(ctx context.Context, id vanus.ID, size int64) error
 		return errors.ErrInvalidRequest.WithMessage("can not create block with id(0)")
 	}
 
+	if compress > block.CompressZSTD {
+		return errors.ErrInvalidRequest.WithMessage("unknown compress algorithm")
+	}
+
 	if err := s.checkState(); err != nil {
 		return err
 	}
@@ -409,9 +425,10 @@ func (s *server) CreateBlock(ctx context.Context, id vanus.ID, size int64) error
 	log.Info(ctx).
 		Stringer("block_id", id).
 		Int64("size", size).
+		Stringer("compress", compress).
 		Msg("Create block.")
 
-	b, err := s.createBlock(ctx, id, size)
+	b, err := s.createBlock(ctx, id, size, compress)
 	if err != nil {
 		if stderr.Is(err, os.ErrExist) {
 			return errors.ErrResourceAlreadyExist.WithMessage("the block has already exist")
@@ -537,6 +554,87 @@ func (s *server) InactivateSegment(_ context.Context) error {
 	return s.checkState()
 }
 
+// ChangeMembership changes the membership of the replica group whose leader is the block.
+func (s *server) ChangeMembership(ctx context.Context, id vanus.ID, change raft.MembershipChange) error {
+	ctx, span := s.tracer.Start(ctx, "ChangeMembership")
+	defer span.End()
+
+	if err := s.checkState(); err != nil {
+		return err
+	}
+
+	var b Replica
+	if v, ok := s.replicas.Load(id); ok {
+		b, _ = v.(Replica)
+	} else {
+		return errors.ErrResourceNotFound.WithMessage("the block doesn't exist")
+	}
+
+	if change.Type == raft.AddLearner {
+		if change.Peer.Endpoint == "" {
+			return errors.ErrInvalidRequest.WithMessage("the endpoint of learner is required")
+		}
+		_ = s.raftEngine.RegisterNodeRecord(change.Peer.ID.Uint64(), change.Peer.Endpoint)
+	}
+
+	log.Info(ctx).
+		Stringer("block_id", id).
+		Int8("type", int8(change.Type)).
+		Stringer("peer_id", change.Peer.ID).
+		Str("endpoint", change.Peer.Endpoint).
+		Msg("Change membership of replica group.")
+
+	if err := b.ChangeMembership(ctx, change); err != nil {
+		switch {
+		case stderr.Is(err, block.ErrNotLeader):
+			return errors.ErrNotRaftLeader
+		case stderr.Is(err, raft.ErrLearnerNotCaughtUp):
+			return errors.ErrNotReady.WithMessage("the learner is catching up")
+		case stderr.Is(err, raft.ErrPeerNotFound):
+			return errors.ErrResourceNotFound.WithMessage("the peer isn't a member of the replica group")
+		case stderr.Is(err, raft.ErrRemoveLeader), stderr.Is(err, block.ErrNotSupported):
+			return errors.ErrInvalidRequest.WithMessage(err.Error())
+		}
+		return errors.ErrInternal.WithMessage("change membership failed").Wrap(err)
+	}
+	return nil
+}
+
+// TransferLeadership transfers the leadership of the replica group whose leader is the block to transferee.
+func (s *server) TransferLeadership(ctx context.Context, id, transferee vanus.ID) error {
+	ctx, span := s.tracer.Start(ctx, "TransferLeadership")
+	defer span.End()
+
+	if err := s.checkState(); err != nil {
+		return err
+	}
+
+	var b Replica
+	if v, ok := s.replicas.Load(id); ok {
+		b, _ = v.(Replica)
+	} else {
+		return errors.ErrResourceNotFound.WithMessage("the block doesn't exist")
+	}
+
+	log.Info(ctx).
+		Stringer("block_id", id).
+		Stringer("transferee", transferee).
+		Msg("Transfer leadership of replica group.")
+
+	if err := b.TransferLeadership(ctx, transferee); err != nil {
+		switch {
+		case stderr.Is(err, block.ErrNotLeader):
+			return errors.ErrNotRaftLeader
+		case stderr.Is(err, raft.ErrPeerNotFound):
+			return errors.ErrResourceNotFound.WithMessage("the transferee isn't a member of the replica group")
+		case stderr.Is(err, raft.ErrTransferToLearner):
+			return errors.ErrInvalidRequest.WithMessage(err.Error())
+		}
+		return errors.ErrInternal.WithMessage("transfer leadership failed").Wrap(err)
+	}
+	return nil
+}
+
 func (s *server) AppendToBlock(ctx context.Context, id vanus.ID, events []*cepb.CloudEvent) ([]int64, error) {
 	ctx, span := s.tracer.Start(ctx, "AppendToBlock")
 	defer span.End()
@@ -640,34 +738,36 @@ func (s *server) onBlockArchived(stat block.Statistics) {
 	}()
 }
 
-// ReadFromBlock returns at most num events from seq in Block id.
+// ReadFromBlock returns at most num events from seq in Block id. If filter is set, only matching
+// events are returned, and the returned offset may be greater than the one next to the last event.
 func (s *server) ReadFromBlock(
-	ctx context.Context, id vanus.ID, seq int64, num int, pollingTimeout uint32,
-) ([]*cepb.CloudEvent, error) {
+	ctx context.Context, id vanus.ID, seq int64, num int, pollingTimeout uint32, filter *segpb.AttributeFilter,
+) ([]*cepb.CloudEvent, int64, error) {
 	ctx, span := s.tracer.Start(ctx, "ReadFromBlock")
 	defer span.End()
 
 	if err := s.checkState(); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	var b Replica
 	if v, ok := s.replicas.Load(id); ok {
 		b, _ = v.(Replica)
 	} else {
-		return nil, errors.ErrResourceNotFound.WithMessage(
+		return nil, 0, errors.ErrResourceNotFound.WithMessage(
 			"the segment doesn't exist on this server")
 	}
 
-	if events, err := s.readEvents(ctx, b, seq, num); err == nil {
-		return events, nil
+	f := newAttributeFilter(filter)
+	if events, next, err := s.readEvents(ctx, b, seq, num, f); err == nil {
+		return events, next, nil
 	} else if !stderr.Is(err, block.ErrOnEnd) || pollingTimeout == 0 {
-		return nil, s.processReadError(ctx, b, err)
+		return nil, 0, s.processReadError(ctx, b, err)
 	}
 
 	doneC := s.pm.Add(ctx, id)
 	if doneC == nil {
-		return nil, errors.ErrOffsetOnEnd
+		return nil, 0, errors.ErrOffsetOnEnd
 	}
 
 	t := time.NewTimer(time.Duration(pollingTimeout) * time.Millisecond)
@@ -676,24 +776,59 @@ func (s *server) ReadFromBlock(
 	select {
 	case <-doneC:
 		// FIXME(james.yin) It can't read message immediately because of async apply.
-		events, err := s.readEvents(ctx, b, seq, num)
+		events, next, err := s.readEvents(ctx, b, seq, num, f)
 		if err != nil {
-			return nil, s.processReadError(ctx, b, err)
+			return nil, 0, s.processReadError(ctx, b, err)
 		}
-		return events, nil
+		return events, next, nil
 	case <-t.C:
-		return nil, errors.ErrOffsetOnEnd
+		return nil, 0, errors.ErrOffsetOnEnd
 	case <-ctx.Done():
-		return nil, ctx.Err()
+		return nil, 0, ctx.Err()
 	}
 }
 
-func (s *server) readEvents(ctx context.Context, b Replica, seq int64, num int) ([]*cepb.CloudEvent, error) {
-	entries, err := b.Read(ctx, seq, num)
-	if err != nil {
-		return nil, err
+func (s *server) readEvents(
+	ctx context.Context, b Replica, seq int64, num int, f *attributeFilter,
+) ([]*cepb.CloudEvent, int64, error) {
+	if f == nil {
+		entries, err := b.Read(ctx, seq, num)
+		if err != nil {
+			return nil, 0, err
+		}
+		return s.toEvents(b, entries), seq + int64(len(entries)), nil
 	}
 
+	// Scan entries until some of them match, so that a selective filter doesn't return lots of
+	// empty batches, but stop scanning after maxFilterScanNumber entries to keep the latency.
+	var matched []block.Entry
+	next := seq
+	for next-seq < maxFilterScanNumber {
+		entries, err := b.Read(ctx, next, num)
+		if err != nil {
+			if next != seq && (stderr.Is(err, block.ErrOnEnd) || stderr.Is(err, block.ErrExceeded)) {
+				break
+			}
+			return nil, 0, err
+		}
+		for _, entry := range entries {
+			if f.match(entry) {
+				matched = append(matched, entry)
+			}
+		}
+		next += int64(len(entries))
+		if len(matched) != 0 || len(entries) < num {
+			break
+		}
+	}
+
+	metrics.ReadFilteredCounterVec.WithLabelValues(s.volumeIDStr, b.IDStr()).
+		Add(float64(next - seq - int64(len(matched))))
+
+	return s.toEvents(b, matched), next, nil
+}
+
+func (s *server) toEvents(b Replica, entries []block.Entry) []*cepb.CloudEvent {
 	var size int
 	events := make([]*cepb.CloudEvent, len(entries))
 	for i, entry := range entries {
@@ -705,7 +840,7 @@ func (s *server) readEvents(ctx context.Context, b Replica, seq int64, num int)
 	metrics.ReadTPSCounterVec.WithLabelValues(s.volumeIDStr, b.IDStr()).Add(float64(len(events)))
 	metrics.ReadThroughputCounterVec.WithLabelValues(s.volumeIDStr, b.IDStr()).Add(float64(size))
 
-	return events, nil
+	return events
 }
 
 func (s *server) processReadError(ctx context.Context, b Replica, err error) error {
@@ -749,13 +884,59 @@ func (s *server) LookupOffsetInBlock(ctx context.Context, id vanus.ID, stime int
 			"the segment doesn't exist on this server")
 	}
 
-	off, err := b.Seek(ctx, 0, ceschema.StimeKey(stime), block.SeekBeforeKey)
+	off, err := b.Seek(ctx, ceschema.StimeIndex, ceschema.StimeKey(stime), block.SeekBeforeKey)
 	if err != nil {
 		return -1, errors.ErrInternal.WithMessage("lookup offset failed").Wrap(err)
 	}
 	return off + 1, nil
 }
 
+// LookupKeyInBlock returns the offset of the event in block whose indexed attribute matches key by flag,
+// or -1 if not found.
+func (s *server) LookupKeyInBlock(
+	ctx context.Context, id vanus.ID, index int64, key string, flag block.SeekKeyFlag,
+) (int64, error) {
+	ctx, span := s.tracer.Start(ctx, "LookupKeyInBlock")
+	defer span.End()
+
+	if err := s.checkState(); err != nil {
+		return -1, err
+	}
+
+	var b Replica
+	if v, ok := s.replicas.Load(id); ok {
+		b, _ = v.(Replica)
+	} else {
+		return -1, errors.ErrResourceNotFound.WithMessage(
+			"the segment doesn't exist on this server")
+	}
+
+	var k block.Entry
+	switch index {
+	case ceschema.IDIndex:
+		k = ceschema.IDKey(key)
+	case ceschema.ExtensionIndex:
+		k = ceschema.ExtensionKey(key)
+	default:
+		return -1, errors.ErrInvalidRequest.WithMessage("unknown index")
+	}
+
+	switch flag {
+	case block.SeekKeyExact, block.SeekPrefix, block.SeekPrefixLast, block.SeekPrefixLastOrPrev:
+	default:
+		return -1, errors.ErrInvalidRequest.WithMessage("unsupported lookup flag")
+	}
+
+	off, err := b.Seek(ctx, index, k, flag)
+	if err != nil {
+		if stderr.Is(err, block.ErrNotSupported) {
+			return -1, errors.ErrBlockNotSupported.WithMessage("the index isn't enabled on this server")
+		}
+		return -1, errors.ErrInternal.WithMessage("lookup key failed").Wrap(err)
+	}
+	return off, nil
+}
+
 func (s *server) checkState() error {
 	if s.state != primitive.ServerStateRunning {
 		return errors.ErrServiceState.WithMessage(fmt.Sprintf(
@@ -158,6 +158,11 @@ func (s *server) registerSelf(ctx context.Context) error {
 		Address:  s.localAddr,
 		VolumeId: s.volumeID,
 		Capacity: s.cfg.Volume.Capacity,
+		Topology: &ctrlpb.VolumeTopology{
+			Zone: s.cfg.Volume.Topology.Zone,
+			Rack: s.cfg.Volume.Topology.Rack,
+			Host: s.cfg.Volume.Topology.Host,
+		},
 	})
 	if err != nil {
 		return err
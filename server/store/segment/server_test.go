@@ -27,12 +27,15 @@ import (
 
 	// first-party libraries.
 	"github.com/vanus-labs/vanus/api/errors"
+	segpb "github.com/vanus-labs/vanus/api/segment"
 	"github.com/vanus-labs/vanus/lib/sync"
 
 	// this project.
 	primitive "github.com/vanus-labs/vanus/pkg"
 	"github.com/vanus-labs/vanus/pkg/snowflake"
 	"github.com/vanus-labs/vanus/server/store/block"
+	raft "github.com/vanus-labs/vanus/server/store/raft/block"
+	ceschema "github.com/vanus-labs/vanus/server/store/schema/ce"
 	cetest "github.com/vanus-labs/vanus/server/store/schema/ce/testing"
 )
 
@@ -86,13 +89,148 @@ func TestServer_RemoveBlock(t *testing.T) {
 	})
 }
 
+func TestServer_LookupKeyInBlock(t *testing.T) {
+	Convey("not found block", t, func() {
+		srv := &server{
+			state: primitive.ServerStateRunning,
+		}
+
+		_, err := srv.LookupKeyInBlock(context.Background(), snowflake.NewTestID(), ceschema.IDIndex, "id",
+			block.SeekKeyExact)
+		So(err, ShouldNotBeNil)
+		So(err.(*errors.ErrorType).Code, ShouldEqual, errors.ErrorCodeResourceNotFound)
+	})
+
+	Convey("lookup key in block", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+
+		srv := &server{
+			state: primitive.ServerStateRunning,
+		}
+
+		id := snowflake.NewTestID()
+		b := NewMockReplica(ctrl)
+		srv.replicas.Store(id, b)
+
+		b.EXPECT().Seek(Any(), ceschema.IDIndex, Any(), block.SeekKeyExact).DoAndReturn(
+			func(_ context.Context, _ int64, key block.Entry, _ block.SeekKeyFlag) (int64, error) {
+				So(key.GetString(ceschema.IDOrdinal), ShouldEqual, "id")
+				return 2, nil
+			})
+		off, err := srv.LookupKeyInBlock(context.Background(), id, ceschema.IDIndex, "id", block.SeekKeyExact)
+		So(err, ShouldBeNil)
+		So(off, ShouldEqual, 2)
+
+		b.EXPECT().Seek(Any(), ceschema.ExtensionIndex, Any(), block.SeekKeyExact).Return(int64(-1), block.ErrNotSupported)
+		_, err = srv.LookupKeyInBlock(context.Background(), id, ceschema.ExtensionIndex, "ext", block.SeekKeyExact)
+		So(err, ShouldNotBeNil)
+		So(err.(*errors.ErrorType).Code, ShouldEqual, errors.ErrorCodeBlockNotSupported)
+
+		_, err = srv.LookupKeyInBlock(context.Background(), id, ceschema.StimeIndex, "stime", block.SeekKeyExact)
+		So(err, ShouldNotBeNil)
+
+		b.EXPECT().Seek(Any(), ceschema.IDIndex, Any(), block.SeekPrefix).Return(int64(3), nil)
+		off, err = srv.LookupKeyInBlock(context.Background(), id, ceschema.IDIndex, "order/", block.SeekPrefix)
+		So(err, ShouldBeNil)
+		So(off, ShouldEqual, 3)
+
+		_, err = srv.LookupKeyInBlock(context.Background(), id, ceschema.IDIndex, "id", block.SeekAfterKey)
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestServer_ChangeMembership(t *testing.T) {
+	Convey("change membership of replica group", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+
+		srv := &server{
+			state: primitive.ServerStateRunning,
+		}
+
+		id := snowflake.NewTestID()
+		peer := raft.Peer{ID: snowflake.NewTestID(), Endpoint: "127.0.0.1:11811"}
+
+		err := srv.ChangeMembership(context.Background(), id, raft.MembershipChange{
+			Type: raft.PromoteLearner, Peer: peer,
+		})
+		So(err, ShouldNotBeNil)
+		So(err.(*errors.ErrorType).Code, ShouldEqual, errors.ErrorCodeResourceNotFound)
+
+		b := NewMockReplica(ctrl)
+		srv.replicas.Store(id, b)
+
+		err = srv.ChangeMembership(context.Background(), id, raft.MembershipChange{
+			Type: raft.AddLearner, Peer: raft.Peer{ID: peer.ID},
+		})
+		So(err, ShouldNotBeNil)
+		So(err.(*errors.ErrorType).Code, ShouldEqual, errors.ErrorCodeInvalidRequest)
+
+		change := raft.MembershipChange{Type: raft.PromoteLearner, Peer: peer}
+		b.EXPECT().ChangeMembership(Any(), change).Return(raft.ErrLearnerNotCaughtUp)
+		err = srv.ChangeMembership(context.Background(), id, change)
+		So(err, ShouldNotBeNil)
+		So(err.(*errors.ErrorType).Code, ShouldEqual, errors.ErrorCodeNotReady)
+
+		b.EXPECT().ChangeMembership(Any(), change).Return(block.ErrNotLeader)
+		err = srv.ChangeMembership(context.Background(), id, change)
+		So(err, ShouldNotBeNil)
+		So(err.(*errors.ErrorType).Code, ShouldEqual, errors.ErrorCodeNotRaftLeader)
+
+		b.EXPECT().ChangeMembership(Any(), change).Return(nil)
+		err = srv.ChangeMembership(context.Background(), id, change)
+		So(err, ShouldBeNil)
+
+		change = raft.MembershipChange{Type: raft.RemovePeer, Peer: raft.Peer{ID: peer.ID}}
+		b.EXPECT().ChangeMembership(Any(), change).Return(nil)
+		err = srv.ChangeMembership(context.Background(), id, change)
+		So(err, ShouldBeNil)
+	})
+}
+
+func TestServer_TransferLeadership(t *testing.T) {
+	Convey("transfer leadership of replica group", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+
+		srv := &server{
+			state: primitive.ServerStateRunning,
+		}
+
+		id := snowflake.NewTestID()
+		transferee := snowflake.NewTestID()
+
+		err := srv.TransferLeadership(context.Background(), id, transferee)
+		So(err, ShouldNotBeNil)
+		So(err.(*errors.ErrorType).Code, ShouldEqual, errors.ErrorCodeResourceNotFound)
+
+		b := NewMockReplica(ctrl)
+		srv.replicas.Store(id, b)
+
+		b.EXPECT().TransferLeadership(Any(), transferee).Return(block.ErrNotLeader)
+		err = srv.TransferLeadership(context.Background(), id, transferee)
+		So(err, ShouldNotBeNil)
+		So(err.(*errors.ErrorType).Code, ShouldEqual, errors.ErrorCodeNotRaftLeader)
+
+		b.EXPECT().TransferLeadership(Any(), transferee).Return(raft.ErrTransferToLearner)
+		err = srv.TransferLeadership(context.Background(), id, transferee)
+		So(err, ShouldNotBeNil)
+		So(err.(*errors.ErrorType).Code, ShouldEqual, errors.ErrorCodeInvalidRequest)
+
+		b.EXPECT().TransferLeadership(Any(), transferee).Return(nil)
+		err = srv.TransferLeadership(context.Background(), id, transferee)
+		So(err, ShouldBeNil)
+	})
+}
+
 func TestServer_ReadFromBlock(t *testing.T) {
 	Convey("not found block", t, func() {
 		srv := &server{
 			state: primitive.ServerStateRunning,
 		}
 
-		_, err := srv.ReadFromBlock(context.Background(), snowflake.NewTestID(), 0, 3, uint32(0))
+		_, _, err := srv.ReadFromBlock(context.Background(), snowflake.NewTestID(), 0, 3, uint32(0), nil)
 		So(err, ShouldNotBeNil)
 		So(err.(*errors.ErrorType).Code, ShouldEqual, errors.ErrorCodeResourceNotFound)
 	})
@@ -118,10 +256,11 @@ func TestServer_ReadFromBlock(t *testing.T) {
 			b.EXPECT().Read(Any(), int64(0), 3).Return([]block.Entry{ent0, ent1}, nil)
 
 			start := time.Now()
-			events, err := srv.ReadFromBlock(context.Background(), id, 0, 3,
-				uint32(shortDelayInTest.Milliseconds()))
+			events, next, err := srv.ReadFromBlock(context.Background(), id, 0, 3,
+				uint32(shortDelayInTest.Milliseconds()), nil)
 			So(time.Now(), ShouldHappenBefore, start.Add(shortDelayInTest))
 			So(err, ShouldBeNil)
+			So(next, ShouldEqual, 2)
 			So(events, ShouldHaveLength, 2)
 			cetest.CheckEvent0(events[0])
 			cetest.CheckEvent1(events[1])
@@ -142,10 +281,11 @@ func TestServer_ReadFromBlock(t *testing.T) {
 				close(ch)
 			}()
 
-			events, err := srv.ReadFromBlock(context.Background(), id, 0, 3,
-				uint32(longDelayInTest.Milliseconds()))
+			events, next, err := srv.ReadFromBlock(context.Background(), id, 0, 3,
+				uint32(longDelayInTest.Milliseconds()), nil)
 			So(time.Now(), ShouldHappenBetween, start.Add(shortDelayInTest), start.Add(longDelayInTest))
 			So(err, ShouldBeNil)
+			So(next, ShouldEqual, 2)
 			So(events, ShouldHaveLength, 2)
 			cetest.CheckEvent0(events[0])
 			cetest.CheckEvent1(events[1])
@@ -160,8 +300,8 @@ func TestServer_ReadFromBlock(t *testing.T) {
 			srv.pm = mgr
 
 			start := time.Now()
-			_, err := srv.ReadFromBlock(context.Background(), id, 0, 3,
-				uint32(shortDelayInTest.Milliseconds()))
+			_, _, err := srv.ReadFromBlock(context.Background(), id, 0, 3,
+				uint32(shortDelayInTest.Milliseconds()), nil)
 			So(time.Now(), ShouldHappenAfter, start.Add(shortDelayInTest))
 			So(err, ShouldBeError, errors.ErrOffsetOnEnd)
 		})
@@ -182,9 +322,49 @@ func TestServer_ReadFromBlock(t *testing.T) {
 				cancel()
 			}()
 
-			_, err := srv.ReadFromBlock(ctx, id, 0, 3, uint32(longDelayInTest.Milliseconds()))
+			_, _, err := srv.ReadFromBlock(ctx, id, 0, 3, uint32(longDelayInTest.Milliseconds()), nil)
 			So(time.Now(), ShouldHappenBetween, start.Add(shortDelayInTest), start.Add(longDelayInTest))
 			So(err, ShouldBeError, context.Canceled)
 		})
+
+		Convey("read with filter", func() {
+			Convey("return matched events", func() {
+				b.EXPECT().Read(Any(), int64(0), 3).Return([]block.Entry{ent0, ent1}, nil)
+
+				filter := &segpb.AttributeFilter{
+					Exact:  map[string]string{"source": "ce-source"},
+					Suffix: map[string]string{"id": "id1"},
+				}
+				events, next, err := srv.ReadFromBlock(context.Background(), id, 0, 3, uint32(0), filter)
+				So(err, ShouldBeNil)
+				So(next, ShouldEqual, 2)
+				So(events, ShouldHaveLength, 1)
+				cetest.CheckEvent1(events[0])
+			})
+
+			Convey("scan until matched", func() {
+				b.EXPECT().Read(Any(), int64(0), 2).Return([]block.Entry{ent0, ent0}, nil)
+				b.EXPECT().Read(Any(), int64(2), 2).Return([]block.Entry{ent0, ent1}, nil)
+
+				filter := &segpb.AttributeFilter{Prefix: map[string]string{"id": "ce-id1"}}
+				events, next, err := srv.ReadFromBlock(context.Background(), id, 0, 2, uint32(0), filter)
+				So(err, ShouldBeNil)
+				So(next, ShouldEqual, 4)
+				So(events, ShouldHaveLength, 1)
+				cetest.CheckEvent1(events[0])
+			})
+
+			Convey("return scanned offset without matched events", func() {
+				b.EXPECT().Read(Any(), int64(0), 2).Return([]block.Entry{ent0, ent1}, nil)
+				b.EXPECT().Read(Any(), int64(2), 2).Return(nil, block.ErrOnEnd)
+
+				filter := &segpb.AttributeFilter{Exact: map[string]string{"type": "other"}}
+				events, next, err := srv.ReadFromBlock(context.Background(), id, 0, 2,
+					uint32(longDelayInTest.Milliseconds()), filter)
+				So(err, ShouldBeNil)
+				So(next, ShouldEqual, 2)
+				So(events, ShouldBeEmpty)
+			})
+		})
 	})
 }
@@ -0,0 +1,92 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tiered
+
+import (
+	// standard libraries.
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const (
+	defaultDirPerm  = 0o755
+	defaultFilePerm = 0o644
+)
+
+// fileStore stores objects in a local directory, it is mainly used for testing.
+type fileStore struct {
+	dir string
+}
+
+// Make sure fileStore implements ObjectStore.
+var _ ObjectStore = (*fileStore)(nil)
+
+func NewFileStore(dir string) (ObjectStore, error) {
+	if err := os.MkdirAll(dir, defaultDirPerm); err != nil {
+		return nil, err
+	}
+	return &fileStore{dir: dir}, nil
+}
+
+func (s *fileStore) Put(_ context.Context, key string, r io.ReadSeeker, size int64) error {
+	path := s.resolvePath(key)
+	if err := os.MkdirAll(filepath.Dir(path), defaultDirPerm); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first, so a partial object is never visible.
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, defaultFilePerm)
+	if err != nil {
+		return err
+	}
+	if _, err = io.CopyN(f, r, size); err == nil {
+		err = f.Sync()
+	}
+	if err2 := f.Close(); err == nil {
+		err = err2
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (s *fileStore) ReadAt(_ context.Context, key string, p []byte, off int64) (int, error) {
+	f, err := os.Open(s.resolvePath(key))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, ErrObjectNotFound
+		}
+		return 0, err
+	}
+	defer f.Close()
+	return f.ReadAt(p, off)
+}
+
+func (s *fileStore) Delete(_ context.Context, key string) error {
+	if err := os.Remove(s.resolvePath(key)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *fileStore) resolvePath(key string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(key))
+}
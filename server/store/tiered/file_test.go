@@ -0,0 +1,68 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tiered
+
+import (
+	// standard libraries.
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func testObjectStore(s ObjectStore) {
+	ctx := context.Background()
+	data := []byte("0123456789abcdef")
+
+	So(s.Put(ctx, "a/b.vsb", bytes.NewReader(data), int64(len(data))), ShouldBeNil)
+
+	buf := make([]byte, 6)
+	n, err := s.ReadAt(ctx, "a/b.vsb", buf, 4)
+	So(err, ShouldBeNil)
+	So(n, ShouldEqual, 6)
+	So(string(buf), ShouldEqual, "456789")
+
+	// Read the tail of object.
+	n, err = s.ReadAt(ctx, "a/b.vsb", buf, 12)
+	So(err, ShouldEqual, io.EOF)
+	So(n, ShouldEqual, 4)
+	So(string(buf[:n]), ShouldEqual, "cdef")
+
+	r := NewReaderAt(s, "a/b.vsb")
+	n, err = r.ReadAt(buf[:2], 0)
+	So(err, ShouldBeNil)
+	So(string(buf[:n]), ShouldEqual, "01")
+
+	_, err = s.ReadAt(ctx, "a/c.vsb", buf, 0)
+	So(err, ShouldEqual, ErrObjectNotFound)
+
+	So(s.Delete(ctx, "a/b.vsb"), ShouldBeNil)
+	_, err = s.ReadAt(ctx, "a/b.vsb", buf, 0)
+	So(err, ShouldEqual, ErrObjectNotFound)
+
+	// Delete nonexistent object.
+	So(s.Delete(ctx, "a/b.vsb"), ShouldBeNil)
+}
+
+func TestFileStore(t *testing.T) {
+	Convey("file object store", t, func() {
+		s, err := NewFileStore(t.TempDir())
+		So(err, ShouldBeNil)
+		testObjectStore(s)
+	})
+}
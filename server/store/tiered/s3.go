@@ -0,0 +1,131 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tiered
+
+import (
+	// standard libraries.
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+
+	// third-party libraries.
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+type S3Options struct {
+	// Endpoint is the URL of an S3-compatible service, use AWS S3 if it's empty.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	Prefix          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// UsePathStyle is required by most S3-compatible services, such as MinIO.
+	UsePathStyle bool
+}
+
+type s3Store struct {
+	client *s3.Client
+	bucket string
+	prefix string
+}
+
+// Make sure s3Store implements ObjectStore.
+var _ ObjectStore = (*s3Store)(nil)
+
+func NewS3Store(opts S3Options) (ObjectStore, error) {
+	if opts.Bucket == "" {
+		return nil, errors.New("tiered: bucket of s3 is required")
+	}
+	o := s3.Options{
+		Region:       opts.Region,
+		UsePathStyle: opts.UsePathStyle,
+	}
+	if opts.AccessKeyID != "" {
+		o.Credentials = aws.NewCredentialsCache(
+			credentials.NewStaticCredentialsProvider(opts.AccessKeyID, opts.SecretAccessKey, ""))
+	}
+	if opts.Endpoint != "" {
+		o.EndpointResolver = s3.EndpointResolverFromURL(opts.Endpoint)
+	}
+	return &s3Store{
+		client: s3.New(o),
+		bucket: opts.Bucket,
+		prefix: opts.Prefix,
+	}, nil
+}
+
+func (s *s3Store) Put(ctx context.Context, key string, r io.ReadSeeker, size int64) error {
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(s.bucket),
+		Key:           aws.String(s.resolveKey(key)),
+		Body:          r,
+		ContentLength: size,
+	})
+	return err
+}
+
+func (s *s3Store) ReadAt(ctx context.Context, key string, p []byte, off int64) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.resolveKey(key)),
+		Range:  aws.String(fmt.Sprintf("bytes=%d-%d", off, off+int64(len(p))-1)),
+	})
+	if err != nil {
+		var nsk *types.NoSuchKey
+		if errors.As(err, &nsk) {
+			return 0, ErrObjectNotFound
+		}
+		var re *smithyhttp.ResponseError
+		if errors.As(err, &re) && re.HTTPStatusCode() == http.StatusRequestedRangeNotSatisfiable {
+			// Offset is beyond the end of object.
+			return 0, io.EOF
+		}
+		return 0, err
+	}
+	defer out.Body.Close()
+
+	n, err := io.ReadFull(out.Body, p)
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		// Reach the end of object.
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (s *s3Store) Delete(ctx context.Context, key string) error {
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.resolveKey(key)),
+	})
+	return err
+}
+
+func (s *s3Store) resolveKey(key string) string {
+	if s.prefix == "" {
+		return key
+	}
+	return path.Join(s.prefix, key)
+}
@@ -0,0 +1,112 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tiered
+
+import (
+	// standard libraries.
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeS3 is an in-process stand-in of S3, which supports a subset of object APIs in path style.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := r.URL.Path
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		s.objects[key] = data
+		w.Header().Set("ETag", `"fake"`)
+	case http.MethodGet:
+		data, ok := s.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>` +
+				`<Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`))
+			return
+		}
+		var start, end int
+		if _, err := fmt.Sscanf(r.Header.Get("Range"), "bytes=%d-%d", &start, &end); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if start >= len(data) {
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>` +
+				`<Error><Code>InvalidRange</Code></Error>`))
+			return
+		}
+		if end >= len(data) {
+			end = len(data) - 1
+		}
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(data)))
+		w.WriteHeader(http.StatusPartialContent)
+		_, _ = w.Write(data[start : end+1])
+	case http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestS3Store(t *testing.T) {
+	Convey("s3 object store", t, func() {
+		fake := &fakeS3{objects: make(map[string][]byte)}
+		srv := httptest.NewServer(fake)
+		defer srv.Close()
+
+		s, err := NewS3Store(S3Options{
+			Endpoint:        srv.URL,
+			Region:          "us-east-1",
+			Bucket:          "vanus",
+			Prefix:          "blocks",
+			AccessKeyID:     "ak",
+			SecretAccessKey: "sk",
+			UsePathStyle:    true,
+		})
+		So(err, ShouldBeNil)
+		testObjectStore(s)
+
+		// Objects are stored with prefix in bucket.
+		So(s.Put(context.Background(), "c.vsb", strings.NewReader("vsb"), 3), ShouldBeNil)
+		So(fake.objects, ShouldContainKey, "/vanus/blocks/c.vsb")
+	})
+
+	Convey("s3 object store without bucket", t, func() {
+		_, err := NewS3Store(S3Options{})
+		So(err, ShouldNotBeNil)
+	})
+}
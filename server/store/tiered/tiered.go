@@ -0,0 +1,50 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tiered
+
+import (
+	// standard libraries.
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrObjectNotFound = errors.New("tiered: object not found")
+
+// ObjectStore is a remote storage tier, where archived blocks are offloaded to.
+type ObjectStore interface {
+	// Put uploads size bytes read from r as the object key.
+	Put(ctx context.Context, key string, r io.ReadSeeker, size int64) error
+	// ReadAt reads len(p) bytes of the object key starting at offset off.
+	ReadAt(ctx context.Context, key string, p []byte, off int64) (int, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type objectReaderAt struct {
+	s   ObjectStore
+	key string
+}
+
+// Make sure objectReaderAt implements io.ReaderAt.
+var _ io.ReaderAt = (*objectReaderAt)(nil)
+
+func (r *objectReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	return r.s.ReadAt(context.Background(), r.key, p, off)
+}
+
+// NewReaderAt returns an io.ReaderAt which reads the object key by range requests.
+func NewReaderAt(s ObjectStore, key string) io.ReaderAt {
+	return &objectReaderAt{s: s, key: key}
+}
@@ -17,6 +17,7 @@ package vsb
 import (
 	// standard libraries.
 	"context"
+	stdio "io"
 	"os"
 	"sync"
 	"sync/atomic"
@@ -27,6 +28,7 @@ import (
 	"github.com/vanus-labs/vanus/server/store/block"
 	"github.com/vanus-labs/vanus/server/store/io/stream"
 	"github.com/vanus-labs/vanus/server/store/io/zone"
+	"github.com/vanus-labs/vanus/server/store/tiered"
 	"github.com/vanus-labs/vanus/server/store/vsb/codec"
 	"github.com/vanus-labs/vanus/server/store/vsb/index"
 )
@@ -51,6 +53,7 @@ type vsBlock struct {
 
 	dataOffset int64
 	indexSize  uint16
+	compress   block.CompressAlgorithm
 
 	indexOffset int64
 	indexLength int
@@ -60,10 +63,20 @@ type vsBlock struct {
 	indexes []index.Index
 	mu      sync.RWMutex
 
+	ai  attributeIndexes
+	aic *attributeIndexCache
+
 	enc codec.EntryEncoder
 	dec codec.EntryDecoder
 	lis block.ArchivedListener
 
+	// store is the object store which archived block is offloaded to, nil if tiered storage is disabled.
+	store     tiered.ObjectStore
+	offloaded bool
+	// r is the reader of block data, it is switched from f to store after block is offloaded.
+	r   stdio.ReaderAt
+	rmu sync.RWMutex
+
 	f  *os.File
 	z  zone.Interface
 	s  stream.Stream
@@ -104,11 +117,23 @@ func (b *vsBlock) Close(ctx context.Context) error {
 		}
 	}
 
+	if b.aic != nil {
+		b.aic.remove(b)
+	}
+
 	return b.f.Close()
 }
 
-func (b *vsBlock) Delete(context.Context) error {
+func (b *vsBlock) Delete(ctx context.Context) error {
 	// FIXME(james.yin): make sure block is closed.
+	if b.aic != nil {
+		b.aic.remove(b)
+	}
+	if b.isOffloaded() {
+		if err := b.store.Delete(ctx, b.objectKey()); err != nil {
+			return err
+		}
+	}
 	return os.Remove(b.path)
 }
 
@@ -125,6 +150,7 @@ func (b *vsBlock) stat(m meta, indexes []index.Index) block.Statistics {
 		EntrySize:       uint64(m.entryLength),
 		FirstEntryStime: -1,
 		LastEntryStime:  -1,
+		Offloaded:       b.isOffloaded(),
 	}
 	if sz := len(indexes); sz != 0 {
 		s.FirstEntryStime = indexes[0].Stime()
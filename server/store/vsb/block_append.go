@@ -29,6 +29,7 @@ import (
 	"github.com/vanus-labs/vanus/server/store/block"
 	"github.com/vanus-labs/vanus/server/store/io"
 	ceschema "github.com/vanus-labs/vanus/server/store/schema/ce"
+	"github.com/vanus-labs/vanus/server/store/vsb/codec"
 	"github.com/vanus-labs/vanus/server/store/vsb/entry"
 	"github.com/vanus-labs/vanus/server/store/vsb/index"
 )
@@ -94,7 +95,11 @@ func (b *vsBlock) PrepareAppend(
 	now := time.Now().UnixMilli()
 	for i := int64(0); i < num; i++ {
 		seq := actx.seq + i
-		ents[i] = entry.Wrap(entries[i], ceschema.CloudEvent, seq, now)
+		ent, err := codec.Compress(entry.Wrap(entries[i], ceschema.CloudEvent, seq, now), b.compress)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		ents[i] = ent
 		seqs[i] = seq
 	}
 
@@ -161,7 +166,7 @@ func (b *vsBlock) CommitAppend(ctx context.Context, frag block.Fragment, cb bloc
 		return
 	}
 
-	indexes, seq, archived, _ := b.buildIndexes(ctx, b.actx.seq, frag)
+	indexes, keys, seq, archived, _ := b.buildIndexes(ctx, b.actx.seq, frag)
 
 	b.actx.seq = seq
 	b.actx.offset = frag.EndOffset()
@@ -172,6 +177,10 @@ func (b *vsBlock) CommitAppend(ctx context.Context, frag block.Fragment, cb bloc
 			b.indexes = append(b.indexes, indexes...)
 			b.mu.Unlock()
 
+			if len(keys) != 0 {
+				b.ai.add(keys...)
+			}
+
 			cb()
 		})
 		return
@@ -190,13 +199,20 @@ func (b *vsBlock) CommitAppend(ctx context.Context, frag block.Fragment, cb bloc
 
 		cb()
 
+		// Attribute indexes of archived block are bounded by cache.
+		if b.aic != nil {
+			b.aic.touch(b)
+		}
+
 		m, i := makeSnapshot(b.actx, b.indexes)
 
 		go b.appendIndexEntry(ctx, i, func(n int, err error) {
 			defer b.wg.Done()
 			b.indexOffset = m.writeOffset
 			b.indexLength = n
-			_ = b.persistHeader(ctx, m)
+			if err = b.persistHeader(ctx, m); err == nil && b.needOffload() {
+				b.startOffload()
+			}
 		})
 
 		if b.lis != nil {
@@ -209,33 +225,38 @@ func (b *vsBlock) CommitAppend(ctx context.Context, frag block.Fragment, cb bloc
 
 func (b *vsBlock) buildIndexes(
 	_ context.Context, expected int64, frag block.Fragment,
-) ([]index.Index, int64, bool, error) {
+) ([]index.Index, []attributeKey, int64, bool, error) {
 	base := frag.StartOffset()
 	data := frag.Payload()
 
 	var indexes []index.Index
+	var keys []attributeKey
 	for off, sz := 0, len(data); off < sz; {
 		n, entry, _ := b.dec.Unmarshal(data[off:])
-		if seq := ceschema.SequenceNumber(entry); seq != expected {
-			return nil, 0, false, errCorruptedFragment
+		seq := ceschema.SequenceNumber(entry)
+		if seq != expected {
+			return nil, nil, 0, false, errCorruptedFragment
 		}
 		expected++
 
 		if ceschema.EntryType(entry) == ceschema.End {
 			// End entry must be the last.
 			if off+n != sz {
-				return nil, 0, false, errCorruptedFragment
+				return nil, nil, 0, false, errCorruptedFragment
 			}
-			return indexes, expected, true, nil
+			return indexes, keys, expected, true, nil
 		}
 
 		idx := index.NewIndex(base+int64(off), int32(n), index.WithEntry(entry))
 		indexes = append(indexes, idx)
+		if b.ai.enabled() {
+			keys = append(keys, b.ai.makeKey(entry, seq))
+		}
 
 		off += n
 	}
 
-	return indexes, expected, false, nil
+	return indexes, keys, expected, false, nil
 }
 
 func (b *vsBlock) appendIndexEntry(_ context.Context, indexes []index.Index, cb io.WriteCallback) {
@@ -247,6 +268,8 @@ func (b *vsBlock) appendIndexEntry(_ context.Context, indexes []index.Index, cb
 		return
 	}
 
-	b.s.Append(bytes.NewReader(data), cb)
-	// return sz, nil
+	// NOTE: stream does not pass the number of written bytes to callback, so pass sz instead.
+	b.s.Append(bytes.NewReader(data), func(_ int, err error) {
+		cb(sz, err)
+	})
 }
@@ -107,6 +107,7 @@ func TestVSBlock_Append(t *testing.T) {
 			enc: codec.NewEncoder(),
 			dec: dec,
 			f:   f,
+			r:   f,
 			s:   s,
 		}
 		ch := make(chan struct{}, 1)
@@ -258,6 +259,7 @@ func TestVSBlock_Append(t *testing.T) {
 			enc: codec.NewEncoder(),
 			dec: dec,
 			f:   f,
+			r:   f,
 			s:   s,
 		}
 		ch := make(chan struct{}, 2)
@@ -22,6 +22,7 @@ import (
 	"os"
 
 	// this project.
+	"github.com/vanus-labs/vanus/server/store/block"
 	"github.com/vanus-labs/vanus/server/store/block/raw"
 )
 
@@ -40,6 +41,9 @@ const (
 	entryLengthOffset = 32
 	entryNumOffset    = 40
 	indexOffsetOffset = 44
+
+	compressFlagsMask uint32 = 0xFF
+	offloadedFlag     uint32 = 1 << 8
 )
 
 var (
@@ -78,6 +82,7 @@ func LoadHeader(f *os.File) (hdr Header, err error) {
 		return hdr, errIncomplete
 	}
 
+	hdr.Flags = binary.LittleEndian.Uint32(buf[flagsOffset:])
 	hdr.DataOffset = binary.LittleEndian.Uint32(buf[dataOffsetOffset:])
 	hdr.State = buf[stateOffset]
 	hdr.IndexSize = binary.LittleEndian.Uint16(buf[indexSizeOffset:])
@@ -96,9 +101,14 @@ func LoadHeader(f *os.File) (hdr Header, err error) {
 }
 
 func (b *vsBlock) persistHeader(_ context.Context, m meta) error {
+	flags := uint32(b.compress)
+	if b.isOffloaded() {
+		flags |= offloadedFlag
+	}
+
 	var buf [headerSize]byte
 	binary.LittleEndian.PutUint32(buf[magicOffset:], FormatMagic)               // magic
-	binary.LittleEndian.PutUint32(buf[flagsOffset:], 0)                         // flags
+	binary.LittleEndian.PutUint32(buf[flagsOffset:], flags)                     // flags
 	binary.LittleEndian.PutUint32(buf[breakFlagsOffset:], 0)                    // break flags
 	binary.LittleEndian.PutUint32(buf[dataOffsetOffset:], uint32(b.dataOffset)) // data offset
 	if m.archived {                                                             // state
@@ -133,6 +143,8 @@ func (b *vsBlock) loadHeader(_ context.Context) error {
 		return err
 	}
 
+	b.compress = block.CompressAlgorithm(hdr.Flags & compressFlagsMask)
+	b.offloaded = hdr.Flags&offloadedFlag != 0
 	b.dataOffset = int64(hdr.DataOffset)
 	b.fm.archived = hdr.State != 0
 	b.indexSize = hdr.IndexSize
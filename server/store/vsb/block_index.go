@@ -0,0 +1,277 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"container/list"
+	"context"
+	"sync"
+
+	// this project.
+	"github.com/vanus-labs/vanus/server/store/block"
+	ceschema "github.com/vanus-labs/vanus/server/store/schema/ce"
+	cetype "github.com/vanus-labs/vanus/server/store/schema/ce/typesystem"
+	"github.com/vanus-labs/vanus/server/store/vsb/index"
+)
+
+const attributeIndexLoadBatchSize = 4 * 1024 * 1024
+
+// attributeIndexes are optional secondary indexes of block, which are kept in memory only.
+type attributeIndexes struct {
+	idEnabled bool
+	extAttr   []byte
+	// id and ext are nil if they are disabled or not loaded.
+	id  *index.AttributeIndex
+	ext *index.AttributeIndex
+	// loaded is the flag indicating all entries of block are indexed.
+	loaded bool
+	mu     sync.Mutex
+}
+
+// attributeKey is the indexed attribute values of an entry.
+type attributeKey struct {
+	seq    int64
+	id     string
+	ext    string
+	hasExt bool
+}
+
+func (ai *attributeIndexes) enabled() bool {
+	return ai.idEnabled || len(ai.extAttr) != 0
+}
+
+func (ai *attributeIndexes) makeKey(entry block.Entry, seq int64) attributeKey {
+	k := attributeKey{seq: seq}
+	if ai.idEnabled {
+		k.id = entry.GetString(ceschema.IDOrdinal)
+	}
+	if len(ai.extAttr) != 0 {
+		k.ext, k.hasExt = ai.extensionValue(entry)
+	}
+	return k
+}
+
+// extensionValue returns the value of indexed extension attribute, only string-like value is supported.
+func (ai *attributeIndexes) extensionValue(entry block.Entry) (string, bool) {
+	return cetype.StringOf(entry.GetExtensionAttribute(ai.extAttr))
+}
+
+func (ai *attributeIndexes) add(keys ...attributeKey) {
+	ai.mu.Lock()
+	defer ai.mu.Unlock()
+
+	// Entries will be indexed when loading.
+	if !ai.loaded {
+		return
+	}
+
+	ai.addLocked(keys...)
+}
+
+func (ai *attributeIndexes) addLocked(keys ...attributeKey) {
+	for _, k := range keys {
+		if ai.id != nil && k.id != "" {
+			ai.id.Add(k.id, k.seq)
+		}
+		if ai.ext != nil && k.hasExt {
+			ai.ext.Add(k.ext, k.seq)
+		}
+	}
+}
+
+// resetLocked replaces indexes with empty ones, and marks them as loaded.
+func (ai *attributeIndexes) resetLocked() {
+	if ai.idEnabled {
+		ai.id = index.NewAttributeIndex()
+	}
+	if len(ai.extAttr) != 0 {
+		ai.ext = index.NewAttributeIndex()
+	}
+	ai.loaded = true
+}
+
+// unload drops indexes, they will be loaded again when looking up.
+func (ai *attributeIndexes) unload() {
+	ai.mu.Lock()
+	defer ai.mu.Unlock()
+	ai.unloadLocked()
+}
+
+func (ai *attributeIndexes) unloadLocked() {
+	ai.id, ai.ext = nil, nil
+	ai.loaded = false
+}
+
+// size returns the approximate memory size of loaded indexes.
+func (ai *attributeIndexes) size() int64 {
+	ai.mu.Lock()
+	defer ai.mu.Unlock()
+
+	var sz int64
+	if ai.id != nil {
+		sz += ai.id.Size()
+	}
+	if ai.ext != nil {
+		sz += ai.ext.Size()
+	}
+	return sz
+}
+
+// attributeIndex returns the attribute index and the value of key to lookup.
+func (b *vsBlock) attributeIndex(ctx context.Context, idx int64, key block.Entry) (*index.AttributeIndex, string, error) {
+	var val string
+	switch idx {
+	case ceschema.IDIndex:
+		if !b.ai.idEnabled {
+			return nil, "", block.ErrNotSupported
+		}
+		val = key.GetString(ceschema.IDOrdinal)
+	case ceschema.ExtensionIndex:
+		if len(b.ai.extAttr) == 0 {
+			return nil, "", block.ErrNotSupported
+		}
+		val, _ = b.ai.extensionValue(key)
+	default:
+		return nil, "", block.ErrNotSupported
+	}
+
+	b.ai.mu.Lock()
+	if err := b.loadAttributeIndexesLocked(ctx); err != nil {
+		b.ai.mu.Unlock()
+		return nil, "", err
+	}
+	ai := b.ai.id
+	if idx == ceschema.ExtensionIndex {
+		ai = b.ai.ext
+	}
+	b.ai.mu.Unlock()
+
+	// Indexes of archived block are immutable, so they can be dropped and loaded again.
+	if b.full() && b.aic != nil {
+		b.aic.touch(b)
+	}
+
+	return ai, val, nil
+}
+
+// loadAttributeIndexes builds attribute indexes by scanning entries of block.
+func (b *vsBlock) loadAttributeIndexes(ctx context.Context) error {
+	b.ai.mu.Lock()
+	defer b.ai.mu.Unlock()
+	return b.loadAttributeIndexesLocked(ctx)
+}
+
+func (b *vsBlock) loadAttributeIndexesLocked(_ context.Context) error {
+	if b.ai.loaded {
+		return nil
+	}
+
+	b.mu.RLock()
+	indexes := b.indexes
+	b.mu.RUnlock()
+
+	b.ai.resetLocked()
+	for i := 0; i < len(indexes); {
+		// Read entries in batch.
+		so := indexes[i].StartOffset()
+		j := i + 1
+		for j < len(indexes) && indexes[j].EndOffset()-so <= attributeIndexLoadBatchSize {
+			j++
+		}
+		data := make([]byte, indexes[j-1].EndOffset()-so)
+		if _, err := b.readAt(data, so); err != nil {
+			b.ai.unloadLocked()
+			return err
+		}
+
+		for ; i < j; i++ {
+			off := indexes[i].StartOffset() - so
+			_, entry, err := b.dec.Unmarshal(data[off : off+int64(indexes[i].Length())])
+			if err != nil {
+				b.ai.unloadLocked()
+				return err
+			}
+			b.ai.addLocked(b.ai.makeKey(entry, int64(i)))
+		}
+	}
+
+	return nil
+}
+
+// attributeIndexCache bounds the memory of attribute indexes of archived blocks. The least recently used indexes
+// are dropped if the total size exceeds capacity, and they are loaded again when looking up.
+type attributeIndexCache struct {
+	capacity int64
+	size     int64
+	lru      *list.List
+	elems    map[*vsBlock]*list.Element
+	mu       sync.Mutex
+}
+
+type cachedAttributeIndexes struct {
+	b    *vsBlock
+	size int64
+}
+
+func newAttributeIndexCache(capacity int64) *attributeIndexCache {
+	return &attributeIndexCache{
+		capacity: capacity,
+		lru:      list.New(),
+		elems:    make(map[*vsBlock]*list.Element),
+	}
+}
+
+// touch marks indexes of block b as recently used, and drops the least recently used indexes of other blocks if
+// the total size exceeds capacity.
+func (c *attributeIndexCache) touch(b *vsBlock) {
+	size := b.ai.size()
+
+	c.mu.Lock()
+	if e, ok := c.elems[b]; ok {
+		ci, _ := e.Value.(*cachedAttributeIndexes)
+		c.size += size - ci.size
+		ci.size = size
+		c.lru.MoveToFront(e)
+	} else {
+		c.elems[b] = c.lru.PushFront(&cachedAttributeIndexes{b: b, size: size})
+		c.size += size
+	}
+
+	var evicted []*vsBlock
+	for c.size > c.capacity && c.lru.Len() > 1 {
+		ci, _ := c.lru.Remove(c.lru.Back()).(*cachedAttributeIndexes)
+		delete(c.elems, ci.b)
+		c.size -= ci.size
+		evicted = append(evicted, ci.b)
+	}
+	c.mu.Unlock()
+
+	for _, eb := range evicted {
+		eb.ai.unload()
+	}
+}
+
+// remove forgets block b, which is closed or deleted.
+func (c *attributeIndexCache) remove(b *vsBlock) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.elems[b]; ok {
+		ci, _ := c.lru.Remove(e).(*cachedAttributeIndexes)
+		delete(c.elems, b)
+		c.size -= ci.size
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.uber.org/mock/gomock"
+
+	// this project.
+	"github.com/vanus-labs/vanus/pkg/snowflake"
+	"github.com/vanus-labs/vanus/server/store/block"
+	ceschema "github.com/vanus-labs/vanus/server/store/schema/ce"
+	cetest "github.com/vanus-labs/vanus/server/store/schema/ce/testing"
+)
+
+func TestVSBlock_SeekAttribute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	checkSeek := func(b *vsBlock) {
+		seq, err := b.Seek(ctx, ceschema.IDIndex, ceschema.IDKey("ce-id0"), block.SeekKeyExact)
+		So(err, ShouldBeNil)
+		So(seq, ShouldEqual, 0)
+
+		seq, err = b.Seek(ctx, ceschema.IDIndex, ceschema.IDKey("ce-id1"), block.SeekKeyExact)
+		So(err, ShouldBeNil)
+		So(seq, ShouldEqual, 1)
+
+		seq, err = b.Seek(ctx, ceschema.IDIndex, ceschema.IDKey("ce-id2"), block.SeekKeyExact)
+		So(err, ShouldBeNil)
+		So(seq, ShouldEqual, -1)
+
+		seq, err = b.Seek(ctx, ceschema.ExtensionIndex, ceschema.ExtensionKey("value3"), block.SeekKeyExact)
+		So(err, ShouldBeNil)
+		So(seq, ShouldEqual, 1)
+
+		seq, err = b.Seek(ctx, ceschema.IDIndex, ceschema.IDKey("ce-id"), block.SeekPrefix)
+		So(err, ShouldBeNil)
+		So(seq, ShouldEqual, 0)
+
+		seq, err = b.Seek(ctx, ceschema.IDIndex, ceschema.IDKey("ce-id"), block.SeekPrefixLast)
+		So(err, ShouldBeNil)
+		So(seq, ShouldEqual, 1)
+
+		seq, err = b.Seek(ctx, ceschema.IDIndex, ceschema.IDKey("ce-idx"), block.SeekPrefixLast)
+		So(err, ShouldBeNil)
+		So(seq, ShouldEqual, -1)
+
+		seq, err = b.Seek(ctx, ceschema.IDIndex, ceschema.IDKey("ce-idx"), block.SeekPrefixLastOrPrev)
+		So(err, ShouldBeNil)
+		So(seq, ShouldEqual, 1)
+
+		seq, err = b.Seek(ctx, ceschema.ExtensionIndex, ceschema.ExtensionKey("value"), block.SeekPrefix)
+		So(err, ShouldBeNil)
+		So(seq, ShouldEqual, 1)
+
+		_, err = b.Seek(ctx, ceschema.IDIndex, ceschema.IDKey("ce-id0"), block.SeekKeyOrNext)
+		So(err, ShouldEqual, block.ErrNotSupported)
+
+		_, err = b.Seek(ctx, ceschema.StimeIndex, ceschema.StimeKey(0), block.SeekPrefix)
+		So(err, ShouldEqual, block.ErrNotSupported)
+	}
+
+	Convey("seek block by attribute indexes", t, func() {
+		dir := t.TempDir()
+		e, err := NewEngine(dir, WithIDIndex(), WithExtensionIndex("attr3"))
+		So(err, ShouldBeNil)
+		defer e.Close()
+
+		id := snowflake.NewTestID()
+		r, err := e.Create(ctx, id, 64*1024, block.CompressNone)
+		So(err, ShouldBeNil)
+		b, _ := r.(*vsBlock)
+
+		actx := b.NewAppendContext(nil)
+		_, frag, _, err := b.PrepareAppend(ctx, actx, cetest.MakeEntry0(ctrl), cetest.MakeEntry1(ctrl))
+		So(err, ShouldBeNil)
+
+		ch := make(chan struct{})
+		b.CommitAppend(ctx, frag, func() {
+			close(ch)
+		})
+		<-ch
+
+		checkSeek(b)
+		So(b.Close(ctx), ShouldBeNil)
+
+		Convey("reopen block", func() {
+			r2, err := e.(*engine).Open(ctx, id)
+			So(err, ShouldBeNil)
+			b2, _ := r2.(*vsBlock)
+			So(b2.ai.loaded, ShouldBeTrue)
+
+			checkSeek(b2)
+
+			// Dropped indexes are loaded again when looking up.
+			b2.ai.unload()
+			checkSeek(b2)
+			So(b2.Close(ctx), ShouldBeNil)
+		})
+
+		Convey("reopen block without attribute indexes", func() {
+			e2, err := NewEngine(dir)
+			So(err, ShouldBeNil)
+			defer e2.Close()
+
+			r2, err := e2.(*engine).Open(ctx, id)
+			So(err, ShouldBeNil)
+
+			_, err = r2.Seek(ctx, ceschema.IDIndex, ceschema.IDKey("ce-id0"), block.SeekKeyExact)
+			So(err, ShouldEqual, block.ErrNotSupported)
+			So(r2.Close(ctx), ShouldBeNil)
+		})
+	})
+}
+
+func TestAttributeIndexCache(t *testing.T) {
+	Convey("attribute index cache", t, func() {
+		newBlock := func(c *attributeIndexCache, ids ...string) *vsBlock {
+			b := &vsBlock{aic: c}
+			b.ai.idEnabled = true
+			b.ai.resetLocked()
+			for i, id := range ids {
+				b.ai.add(attributeKey{seq: int64(i), id: id})
+			}
+			return b
+		}
+
+		c := newAttributeIndexCache(100)
+		b1 := newBlock(c, "a", "b")
+		b2 := newBlock(c, "c")
+		b3 := newBlock(c, "d", "e")
+
+		c.touch(b1)
+		c.touch(b2)
+		So(c.size, ShouldEqual, 75)
+		So(b1.ai.loaded, ShouldBeTrue)
+
+		// b2 is the least recently used one after touching b1 again.
+		c.touch(b1)
+		c.touch(b3)
+		So(c.size, ShouldEqual, 100)
+		So(b1.ai.loaded, ShouldBeTrue)
+		So(b2.ai.loaded, ShouldBeFalse)
+		So(b2.ai.id, ShouldBeNil)
+		So(b3.ai.loaded, ShouldBeTrue)
+
+		c.remove(b1)
+		So(c.size, ShouldEqual, 50)
+		So(c.lru.Len(), ShouldEqual, 1)
+
+		// The most recently used indexes are kept even if exceeding capacity.
+		b4 := newBlock(c, "f", "g", "h", "i", "j")
+		c.touch(b4)
+		So(c.size, ShouldEqual, 125)
+		So(b3.ai.loaded, ShouldBeFalse)
+		So(b4.ai.loaded, ShouldBeTrue)
+	})
+}
@@ -0,0 +1,108 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	stdio "io"
+
+	// this project.
+	"github.com/vanus-labs/vanus/pkg/observability/log"
+	"github.com/vanus-labs/vanus/server/store/io"
+	"github.com/vanus-labs/vanus/server/store/tiered"
+)
+
+func (b *vsBlock) objectKey() string {
+	return b.id.String() + vsbExt
+}
+
+func (b *vsBlock) isOffloaded() bool {
+	b.rmu.RLock()
+	defer b.rmu.RUnlock()
+	return b.offloaded
+}
+
+// readAt reads data from local file, or from object store if block is offloaded.
+func (b *vsBlock) readAt(p []byte, off int64) (int, error) {
+	b.rmu.RLock()
+	defer b.rmu.RUnlock()
+	return b.r.ReadAt(p, off)
+}
+
+// needOffload returns true if block is archived, its index entry is persisted, and it is not offloaded yet.
+func (b *vsBlock) needOffload() bool {
+	if b.store == nil || b.indexLength == 0 || b.isOffloaded() {
+		return false
+	}
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.fm.archived
+}
+
+func (b *vsBlock) startOffload() {
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		ctx := context.Background()
+		if err := b.offload(ctx); err != nil {
+			log.Warn(ctx).Err(err).
+				Stringer("block_id", b.id).
+				Msg("vsb: offload block failed, keep it in local.")
+		}
+	}()
+}
+
+// offload uploads the whole block to object store, then frees the local space of data and indexes.
+// Only the header is kept in local file, which records that the block is offloaded.
+func (b *vsBlock) offload(ctx context.Context) error {
+	key := b.objectKey()
+	size := b.indexOffset + int64(b.indexLength)
+	if err := b.store.Put(ctx, key, stdio.NewSectionReader(b.f, 0, size), size); err != nil {
+		return err
+	}
+
+	b.rmu.Lock()
+	b.offloaded = true
+	b.r = tiered.NewReaderAt(b.store, key)
+	b.rmu.Unlock()
+
+	b.mu.RLock()
+	m := b.fm
+	b.mu.RUnlock()
+	if err := b.persistHeader(ctx, m); err != nil {
+		return err
+	}
+
+	log.Info(ctx).
+		Stringer("block_id", b.id).
+		Int64("size", size).
+		Msg("vsb: block is offloaded to object store.")
+
+	return b.releaseLocalData()
+}
+
+// releaseLocalData deallocates the space of data and indexes in local file. The file size is kept, so the layout of
+// block is not changed.
+func (b *vsBlock) releaseLocalData() error {
+	fi, err := b.f.Stat()
+	if err != nil {
+		return err
+	}
+	if fi.Size() <= b.dataOffset {
+		return nil
+	}
+	return io.PunchHole(b.f, b.dataOffset, fi.Size()-b.dataOffset)
+}
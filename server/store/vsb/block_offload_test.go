@@ -0,0 +1,127 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.uber.org/mock/gomock"
+
+	// this project.
+	"github.com/vanus-labs/vanus/pkg/snowflake"
+	"github.com/vanus-labs/vanus/server/store/block"
+	cetest "github.com/vanus-labs/vanus/server/store/schema/ce/testing"
+	"github.com/vanus-labs/vanus/server/store/tiered"
+)
+
+func TestVSBlock_Offload(t *testing.T) {
+	ctx := context.Background()
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	Convey("offload archived block to object store", t, func() {
+		objDir := t.TempDir()
+		store, err := tiered.NewFileStore(objDir)
+		So(err, ShouldBeNil)
+
+		dir := t.TempDir()
+		e, err := NewEngine(dir, WithObjectStore(store))
+		So(err, ShouldBeNil)
+		defer e.Close()
+
+		id := snowflake.NewTestID()
+		r, err := e.Create(ctx, id, 64*1024, block.CompressNone)
+		So(err, ShouldBeNil)
+		b, _ := r.(*vsBlock)
+
+		actx := b.NewAppendContext(nil)
+		_, frag, _, err := b.PrepareAppend(ctx, actx, cetest.MakeEntry1(ctrl))
+		So(err, ShouldBeNil)
+		frag1, err := b.PrepareArchive(ctx, actx)
+		So(err, ShouldBeNil)
+
+		ch := make(chan struct{}, 2)
+		b.CommitAppend(ctx, frag, func() {
+			ch <- struct{}{}
+		})
+		b.CommitAppend(ctx, frag1, func() {
+			ch <- struct{}{}
+		})
+		<-ch
+		<-ch
+
+		// Wait for index entry and offloading.
+		b.wg.Wait()
+		So(b.isOffloaded(), ShouldBeTrue)
+
+		fi, err := os.Stat(filepath.Join(objDir, b.objectKey()))
+		So(err, ShouldBeNil)
+		So(fi.Size(), ShouldEqual, b.indexOffset+int64(b.indexLength))
+
+		if runtime.GOOS == "linux" {
+			// Local data is released.
+			buf := make([]byte, b.indexOffset-b.dataOffset)
+			_, err = b.f.ReadAt(buf, b.dataOffset)
+			So(err, ShouldBeNil)
+			So(bytes.Count(buf, []byte{0}), ShouldEqual, len(buf))
+		}
+
+		entries, err := b.Read(ctx, 0, 1)
+		So(err, ShouldBeNil)
+		So(entries, ShouldHaveLength, 1)
+		cetest.CheckEntry1(entries[0], true, true)
+
+		So(b.Close(ctx), ShouldBeNil)
+
+		Convey("reopen offloaded block", func() {
+			r2, err := e.(*engine).Open(ctx, id)
+			So(err, ShouldBeNil)
+			b2, _ := r2.(*vsBlock)
+			So(b2.isOffloaded(), ShouldBeTrue)
+
+			stat := b2.Status()
+			So(stat.Archived, ShouldBeTrue)
+			So(stat.EntryNum, ShouldEqual, 1)
+
+			entries, err := b2.Read(ctx, 0, 1)
+			So(err, ShouldBeNil)
+			So(entries, ShouldHaveLength, 1)
+			cetest.CheckEntry1(entries[0], true, true)
+
+			So(b2.Close(ctx), ShouldBeNil)
+			So(b2.Delete(ctx), ShouldBeNil)
+
+			_, err = os.Stat(filepath.Join(objDir, b2.objectKey()))
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+
+		Convey("reopen without object store", func() {
+			e2, err := NewEngine(dir)
+			So(err, ShouldBeNil)
+			defer e2.Close()
+
+			_, err = e2.(*engine).Open(ctx, id)
+			So(err, ShouldEqual, errObjectStoreRequired)
+		})
+	})
+}
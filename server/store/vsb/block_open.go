@@ -29,6 +29,7 @@ import (
 	"github.com/vanus-labs/vanus/server/store/block"
 	"github.com/vanus-labs/vanus/server/store/io"
 	ceschema "github.com/vanus-labs/vanus/server/store/schema/ce"
+	"github.com/vanus-labs/vanus/server/store/tiered"
 	"github.com/vanus-labs/vanus/server/store/vsb/codec"
 	"github.com/vanus-labs/vanus/server/store/vsb/index"
 )
@@ -36,6 +37,8 @@ import (
 var (
 	errCorrupted  = stderr.New("corrupted vsb")
 	errIncomplete = stderr.New("incomplete vsb")
+
+	errObjectStoreRequired = stderr.New("vsb: object store is required by offloaded block")
 )
 
 func (b *vsBlock) Open(ctx context.Context) error {
@@ -66,6 +69,10 @@ func (b *vsBlock) init(ctx context.Context) error {
 		return err
 	}
 
+	if err := b.initReader(); err != nil {
+		return err
+	}
+
 	b.enc = codec.NewEncoder()
 	if dec, err := codec.NewDecoder(false, int(b.indexSize)); err == nil {
 		b.dec = dec
@@ -80,6 +87,21 @@ func (b *vsBlock) init(ctx context.Context) error {
 	return b.validate(ctx)
 }
 
+func (b *vsBlock) initReader() error {
+	if !b.offloaded {
+		b.r = b.f
+		return nil
+	}
+
+	if b.store == nil {
+		return errObjectStoreRequired
+	}
+	b.r = tiered.NewReaderAt(b.store, b.objectKey())
+
+	// The local data may be left behind if crashed during offloading.
+	return b.releaseLocalData()
+}
+
 func (b *vsBlock) repairMeta() error {
 	off := b.dataOffset + b.fm.entryLength
 	seq := b.fm.entryNum
@@ -92,7 +114,7 @@ func (b *vsBlock) repairMeta() error {
 	// Scan entries.
 	indexes := make([]index.Index, 0)
 	// Note: use math.MaxInt64-off to avoid overflow.
-	r := stdio.NewSectionReader(b.f, off, math.MaxInt64-off)
+	r := stdio.NewSectionReader(b.r, off, math.MaxInt64-off)
 	if full {
 		n, entry, err = b.dec.UnmarshalReader(r)
 		if err != nil || ceschema.EntryType(entry) != ceschema.End {
@@ -169,7 +191,7 @@ func (b *vsBlock) rebuildIndexes(num int, tail []index.Index) error {
 
 	// Scan entries.
 	off := b.dataOffset
-	r := stdio.NewSectionReader(b.f, off, b.fm.entryLength)
+	r := stdio.NewSectionReader(b.r, off, b.fm.entryLength)
 	for {
 		n, entry, err := b.dec.UnmarshalReader(r)
 		if err != nil {
@@ -25,6 +25,7 @@ import (
 
 	// this project.
 	"github.com/vanus-labs/vanus/server/store/block"
+	"github.com/vanus-labs/vanus/server/store/vsb/codec"
 )
 
 // Make sure block implements block.Reader.
@@ -43,13 +44,16 @@ func (b *vsBlock) Read(ctx context.Context, seq int64, num int) ([]block.Entry,
 
 	length := int(to - from)
 	data := make([]byte, length)
-	if _, err = b.f.ReadAt(data, from); err != nil {
+	if _, err = b.readAt(data, from); err != nil {
 		return nil, err
 	}
 
 	entries := make([]block.Entry, 0, num)
 	for so := 0; so < length; {
 		n, entry, _ := b.dec.Unmarshal(data[so:])
+		if entry, err = codec.Decompress(entry, b.compress); err != nil {
+			return nil, err
+		}
 		entries = append(entries, entry)
 		so += n
 	}
@@ -36,6 +36,10 @@ func (b *vsBlock) Seek(ctx context.Context, index int64, key block.Entry, flag b
 	span.AddEvent("store.vsb.vsBlock.Seek() Start")
 	defer span.AddEvent("store.vsb.vsBlock.Seek() End")
 
+	if index != ceschema.StimeIndex {
+		return b.seekAttribute(ctx, index, key, flag)
+	}
+
 	b.mu.RLock()
 	indexes := b.indexes
 	b.mu.RUnlock()
@@ -90,6 +94,26 @@ func (b *vsBlock) seekBeforeKey(_ context.Context, idx int64, key block.Entry, i
 	return int64(len(indexes)) - 1, nil
 }
 
+func (b *vsBlock) seekAttribute(ctx context.Context, idx int64, key block.Entry, flag block.SeekKeyFlag) (int64, error) {
+	ai, val, err := b.attributeIndex(ctx, idx, key)
+	if err != nil {
+		return -1, err
+	}
+
+	switch flag {
+	case block.SeekKeyExact:
+		return ai.Lookup(val), nil
+	case block.SeekPrefix:
+		return ai.SeekPrefix(val), nil
+	case block.SeekPrefixLast:
+		return ai.SeekPrefixLast(val), nil
+	case block.SeekPrefixLastOrPrev:
+		return ai.SeekPrefixLastOrPrev(val), nil
+	default:
+		return -1, block.ErrNotSupported
+	}
+}
+
 func (b *vsBlock) selectComparer(_ int64, key block.Entry) func(index.Index) int {
 	// TODO(james.yin): support non-stime index.
 	val := ceschema.Stime(key)
@@ -59,7 +59,7 @@ func (b *vsBlock) Snapshot(_ context.Context) (block.Fragment, error) {
 	data := make([]byte, m.writeOffset-b.dataOffset+8)
 	binary.LittleEndian.PutUint64(data, uint64(b.dataOffset))
 
-	if _, err := b.f.ReadAt(data[8:], b.dataOffset); err != nil {
+	if _, err := b.readAt(data[8:], b.dataOffset); err != nil {
 		return nil, err
 	}
 
@@ -97,6 +97,9 @@ func (b *vsBlock) ApplySnapshot(_ context.Context, snap block.Fragment) error {
 
 		idx := index.NewIndex(off, int32(n), index.WithEntry(entry))
 		b.indexes = append(b.indexes, idx)
+		if b.ai.enabled() {
+			b.ai.add(b.ai.makeKey(entry, int64(len(b.indexes)-1)))
+		}
 
 		off += int64(n)
 	}
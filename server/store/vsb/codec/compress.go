@@ -0,0 +1,198 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	// standard libraries.
+	"encoding/binary"
+	"errors"
+
+	// third-party libraries.
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+
+	// this project.
+	"github.com/vanus-labs/vanus/server/store/block"
+	ceschema "github.com/vanus-labs/vanus/server/store/schema/ce"
+)
+
+// compressedHeaderSize is the size of the original data length prefixed to compressed data.
+const compressedHeaderSize = 4
+
+var (
+	ErrUnknownCompressAlgorithm = errors.New("vsb.codec: unknown compress algorithm")
+	ErrCorruptedData            = errors.New("vsb.codec: corrupted data")
+)
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+)
+
+// compress compresses src, and returns the compressed data with original length prefixed.
+func compress(algo block.CompressAlgorithm, src []byte) ([]byte, error) {
+	buf := make([]byte, compressedHeaderSize, compressedHeaderSize+compressBound(algo, len(src)))
+	binary.LittleEndian.PutUint32(buf, uint32(len(src)))
+
+	switch algo {
+	case block.CompressLZ4:
+		n, err := lz4.CompressBlock(src, buf[compressedHeaderSize:cap(buf)], nil)
+		if err != nil {
+			return nil, err
+		}
+		buf = buf[:compressedHeaderSize+n]
+	case block.CompressZSTD:
+		buf = zstdEncoder.EncodeAll(src, buf)
+	default:
+		return nil, ErrUnknownCompressAlgorithm
+	}
+
+	// Incompressible data, store it directly.
+	if n := len(buf) - compressedHeaderSize; n == 0 || n >= len(src) {
+		buf = append(buf[:compressedHeaderSize], src...)
+	}
+	return buf, nil
+}
+
+func compressBound(algo block.CompressAlgorithm, n int) int {
+	if algo == block.CompressLZ4 {
+		return lz4.CompressBlockBound(n)
+	}
+	return n
+}
+
+// decompress is the inverse of compress.
+func decompress(algo block.CompressAlgorithm, src []byte) ([]byte, error) {
+	if len(src) < compressedHeaderSize {
+		return nil, ErrCorruptedData
+	}
+	sz := int(binary.LittleEndian.Uint32(src))
+	src = src[compressedHeaderSize:]
+	if len(src) == sz {
+		return src, nil
+	}
+
+	switch algo {
+	case block.CompressLZ4:
+		dst := make([]byte, sz)
+		n, err := lz4.UncompressBlock(src, dst)
+		if err != nil || n != sz {
+			return nil, ErrCorruptedData
+		}
+		return dst, nil
+	case block.CompressZSTD:
+		dst, err := zstdDecoder.DecodeAll(src, make([]byte, 0, sz))
+		if err != nil || len(dst) != sz {
+			return nil, ErrCorruptedData
+		}
+		return dst, nil
+	default:
+		return nil, ErrUnknownCompressAlgorithm
+	}
+}
+
+type compressedEntry struct {
+	block.EntryExtWrapper
+	data []byte
+}
+
+// Make sure compressedEntry implements block.EntryExt.
+var _ block.EntryExt = (*compressedEntry)(nil)
+
+func (e *compressedEntry) GetBytes(ordinal int) []byte {
+	if ordinal == ceschema.DataOrdinal {
+		return e.data
+	}
+	return e.EntryExtWrapper.GetBytes(ordinal)
+}
+
+func (e *compressedEntry) RangeOptionalAttributes(cb block.OptionalAttributeCallback) {
+	e.EntryExtWrapper.RangeOptionalAttributes(&compressedDataCallback{
+		OptionalAttributeCallback: cb,
+		data:                      e.data,
+	})
+}
+
+type compressedDataCallback struct {
+	block.OptionalAttributeCallback
+	data []byte
+}
+
+func (cb *compressedDataCallback) OnBytes(ordinal int, val []byte) {
+	if ordinal == ceschema.DataOrdinal {
+		val = cb.data
+	}
+	cb.OptionalAttributeCallback.OnBytes(ordinal, val)
+}
+
+// Compress returns an entry whose data is compressed by algo, so that the data region is stored
+// compressed when the entry is encoded. Entries without data are returned as is.
+func Compress(entry block.Entry, algo block.CompressAlgorithm) (block.Entry, error) {
+	if algo == block.CompressNone || ceschema.EntryType(entry) != ceschema.CloudEvent {
+		return entry, nil
+	}
+	ext, ok := entry.(block.EntryExt)
+	if !ok {
+		return entry, nil
+	}
+	data := ext.GetBytes(ceschema.DataOrdinal)
+	if data == nil {
+		return entry, nil
+	}
+
+	compressed, err := compress(algo, data)
+	if err != nil {
+		return nil, err
+	}
+	return &compressedEntry{
+		EntryExtWrapper: block.EntryExtWrapper{E: ext},
+		data:            compressed,
+	}, nil
+}
+
+type decompressedEntry struct {
+	block.Entry
+	data []byte
+}
+
+// Make sure decompressedEntry implements block.Entry.
+var _ block.Entry = (*decompressedEntry)(nil)
+
+func (e *decompressedEntry) GetBytes(ordinal int) []byte {
+	if ordinal == ceschema.DataOrdinal {
+		return e.data
+	}
+	return e.Entry.GetBytes(ordinal)
+}
+
+// Decompress returns an entry whose data is decompressed by algo. It is the inverse of Compress.
+func Decompress(entry block.Entry, algo block.CompressAlgorithm) (block.Entry, error) {
+	if algo == block.CompressNone || ceschema.EntryType(entry) != ceschema.CloudEvent {
+		return entry, nil
+	}
+	data := entry.GetBytes(ceschema.DataOrdinal)
+	if data == nil {
+		return entry, nil
+	}
+
+	decompressed, err := decompress(algo, data)
+	if err != nil {
+		return nil, err
+	}
+	return &decompressedEntry{
+		Entry: entry,
+		data:  decompressed,
+	}, nil
+}
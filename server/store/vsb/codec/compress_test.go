@@ -0,0 +1,131 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	// standard libraries.
+	"bytes"
+	"math/rand"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.uber.org/mock/gomock"
+
+	// this project.
+	"github.com/vanus-labs/vanus/server/store/block"
+	ceschema "github.com/vanus-labs/vanus/server/store/schema/ce"
+	cetest "github.com/vanus-labs/vanus/server/store/schema/ce/testing"
+)
+
+func TestCompress(t *testing.T) {
+	algos := []block.CompressAlgorithm{block.CompressLZ4, block.CompressZSTD}
+
+	Convey("compress data", t, func() {
+		for _, algo := range algos {
+			Convey(algo.String(), func() {
+				Convey("compressible data", func() {
+					src := bytes.Repeat([]byte("vanus-cloudevent-"), 1024)
+					compressed, err := compress(algo, src)
+					So(err, ShouldBeNil)
+					So(len(compressed), ShouldBeLessThan, len(src))
+
+					data, err := decompress(algo, compressed)
+					So(err, ShouldBeNil)
+					So(data, ShouldResemble, src)
+				})
+
+				Convey("incompressible data", func() {
+					src := make([]byte, 64)
+					_, _ = rand.New(rand.NewSource(1)).Read(src) //nolint:gosec // it's ok
+					compressed, err := compress(algo, src)
+					So(err, ShouldBeNil)
+					So(compressed, ShouldHaveLength, compressedHeaderSize+len(src))
+
+					data, err := decompress(algo, compressed)
+					So(err, ShouldBeNil)
+					So(data, ShouldResemble, src)
+				})
+
+				Convey("empty data", func() {
+					compressed, err := compress(algo, []byte{})
+					So(err, ShouldBeNil)
+					data, err := decompress(algo, compressed)
+					So(err, ShouldBeNil)
+					So(data, ShouldHaveLength, 0)
+				})
+
+				Convey("corrupted data", func() {
+					src := bytes.Repeat([]byte("vanus-cloudevent-"), 1024)
+					compressed, _ := compress(algo, src)
+					_, err := decompress(algo, compressed[:len(compressed)/2])
+					So(err, ShouldEqual, ErrCorruptedData)
+					_, err = decompress(algo, compressed[:2])
+					So(err, ShouldEqual, ErrCorruptedData)
+				})
+			})
+		}
+
+		Convey("unknown algorithm", func() {
+			_, err := compress(block.CompressAlgorithm(0xff), []byte("data"))
+			So(err, ShouldEqual, ErrUnknownCompressAlgorithm)
+		})
+	})
+}
+
+func TestCompressEntry(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	Convey("compress entry", t, func() {
+		enc := NewEncoder()
+		dec, _ := NewDecoder(false, IndexSize)
+
+		Convey("without compression", func() {
+			entry0 := cetest.MakeStoredEntry1(ctrl, false)
+			entry, err := Compress(entry0, block.CompressNone)
+			So(err, ShouldBeNil)
+			So(entry, ShouldEqual, entry0)
+		})
+
+		Convey("entry without data", func() {
+			entry0 := cetest.MakeStoredEntry0(ctrl)
+			entry, err := Compress(entry0, block.CompressZSTD)
+			So(err, ShouldBeNil)
+			So(entry, ShouldEqual, entry0)
+		})
+
+		for _, algo := range []block.CompressAlgorithm{block.CompressLZ4, block.CompressZSTD} {
+			Convey(algo.String(), func() {
+				entry1 := cetest.MakeStoredEntry1(ctrl, false)
+				entry, err := Compress(entry1, algo)
+				So(err, ShouldBeNil)
+
+				buf := make([]byte, enc.Size(entry))
+				n, err := enc.MarshalTo(entry, buf)
+				So(err, ShouldBeNil)
+				So(n, ShouldEqual, len(buf))
+
+				_, stored, err := dec.Unmarshal(buf)
+				So(err, ShouldBeNil)
+				So(stored.GetBytes(ceschema.DataOrdinal), ShouldResemble, entry.GetBytes(ceschema.DataOrdinal))
+
+				decompressed, err := Decompress(stored, algo)
+				So(err, ShouldBeNil)
+				cetest.CheckEntry1(decompressed, false, false)
+			})
+		}
+	})
+}
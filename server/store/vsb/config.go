@@ -23,14 +23,21 @@ import (
 	ioengine "github.com/vanus-labs/vanus/server/store/io/engine"
 	"github.com/vanus-labs/vanus/server/store/io/engine/psync"
 	"github.com/vanus-labs/vanus/server/store/io/stream"
+	"github.com/vanus-labs/vanus/server/store/tiered"
 )
 
+const defaultAttributeIndexCacheSize = 256 * 1024 * 1024
+
 type config struct {
-	engine           ioengine.Interface
-	flushBatchSize   int           // default: 16 * 1024
-	flushDelayTime   time.Duration // default: 3 * time.Millisecond
-	callbackParallel int           // default: 1
-	lis              block.ArchivedListener
+	engine                  ioengine.Interface
+	flushBatchSize          int           // default: 16 * 1024
+	flushDelayTime          time.Duration // default: 3 * time.Millisecond
+	callbackParallel        int           // default: 1
+	lis                     block.ArchivedListener
+	store                   tiered.ObjectStore
+	idIndex                 bool
+	extensionIndex          string
+	attributeIndexCacheSize int64 // default: 256 MiB
 }
 
 func (cfg *config) streamSchedulerOptions() (opts []stream.Option) {
@@ -47,7 +54,9 @@ func (cfg *config) streamSchedulerOptions() (opts []stream.Option) {
 }
 
 func defaultConfig() config {
-	cfg := config{}
+	cfg := config{
+		attributeIndexCacheSize: defaultAttributeIndexCacheSize,
+	}
 	return cfg
 }
 
@@ -97,3 +106,31 @@ func WithArchivedListener(lis block.ArchivedListener) Option {
 		cfg.lis = lis
 	}
 }
+
+// WithIDIndex enables the index on id attribute of CloudEvent.
+func WithIDIndex() Option {
+	return func(cfg *config) {
+		cfg.idIndex = true
+	}
+}
+
+// WithExtensionIndex enables the index on the extension attribute attr of CloudEvent.
+func WithExtensionIndex(attr string) Option {
+	return func(cfg *config) {
+		cfg.extensionIndex = attr
+	}
+}
+
+// WithAttributeIndexCacheSize sets the memory limit of attribute indexes of archived blocks in bytes.
+func WithAttributeIndexCacheSize(size int64) Option {
+	return func(cfg *config) {
+		cfg.attributeIndexCacheSize = size
+	}
+}
+
+// WithObjectStore enables offloading archived blocks to store.
+func WithObjectStore(store tiered.ObjectStore) Option {
+	return func(cfg *config) {
+		cfg.store = store
+	}
+}
@@ -35,7 +35,8 @@
 //
 //	+00 4B Magic number (0x00627376, "vsb" in ASCII)
 //	+04 4B CRC-32c of header block
-//	+08 4B Flags
+//	+08 4B Flags (bit 0-7: compress algorithm of data, 0: none, 1: lz4, 2: zstd;
+//	              bit 8: offloaded, data and indexes are read from object store)
 //	+0C 4B Break Flags
 //	+10 4B Data Offset (in bytes, currently 4096)
 //	+14 1B State (0: working, 1: archived)
@@ -92,6 +93,14 @@
 //	+00 2B: Extension Attribute Count
 //	+02 6B: Non-Null Attributes Bitmap
 //
+// If the block is compressed, the value of data is stored as:
+//
+//	┌─────────────────┬─────────────────────────────────────────────────────┐
+//	│ Data Length(4)  │                Compressed Data ...                  │
+//	└─────────────────┴─────────────────────────────────────────────────────┘
+//
+// The data is stored without compression if its compressed length is not less than Data Length.
+//
 // The layout of `Optional Attributes Vectors` is:
 //
 //	┌─────────────────┬─────────────────┬───────────────────────────────────┐
@@ -22,6 +22,7 @@ import (
 	"github.com/vanus-labs/vanus/server/store/block"
 	"github.com/vanus-labs/vanus/server/store/block/raw"
 	"github.com/vanus-labs/vanus/server/store/io/stream"
+	"github.com/vanus-labs/vanus/server/store/tiered"
 )
 
 const (
@@ -32,6 +33,13 @@ type engine struct {
 	dir string
 	s   stream.Scheduler
 	lis block.ArchivedListener
+
+	store tiered.ObjectStore
+
+	idIndex        bool
+	extensionIndex []byte
+	// aic is the cache of attribute indexes of archived blocks, nil if indexes are disabled.
+	aic *attributeIndexCache
 }
 
 // Make sure engine implements raw.Engine.
@@ -55,9 +63,27 @@ func newEngine(dir string, cfg config) (raw.Engine, error) {
 
 	s := stream.NewScheduler(cfg.engine, cfg.streamSchedulerOptions()...)
 
-	return &engine{
-		dir: dir,
-		s:   s,
-		lis: cfg.lis,
-	}, nil
+	e := &engine{
+		dir:            dir,
+		s:              s,
+		lis:            cfg.lis,
+		store:          cfg.store,
+		idIndex:        cfg.idIndex,
+		extensionIndex: []byte(cfg.extensionIndex),
+	}
+	if e.idIndex || len(e.extensionIndex) != 0 {
+		e.aic = newAttributeIndexCache(cfg.attributeIndexCacheSize)
+	}
+	return e, nil
+}
+
+func (e *engine) initAttributeIndexes(b *vsBlock, loaded bool) {
+	b.ai.idEnabled = e.idIndex
+	b.ai.extAttr = e.extensionIndex
+	if b.ai.enabled() {
+		b.aic = e.aic
+	}
+	if loaded {
+		b.ai.resetLocked()
+	}
 }
@@ -38,7 +38,7 @@ const (
 	defaultFilePerm = 0o644
 )
 
-func (e *engine) Create(ctx context.Context, id vanus.ID, capacity int64) (block.Raw, error) {
+func (e *engine) Create(ctx context.Context, id vanus.ID, capacity int64, compress block.CompressAlgorithm) (block.Raw, error) {
 	path := e.resolvePath(id)
 
 	f, err := io.CreateFile(path, capacity, os.O_RDWR, true, false)
@@ -53,18 +53,23 @@ func (e *engine) Create(ctx context.Context, id vanus.ID, capacity int64) (block
 		capacity:   capacity,
 		dataOffset: headerBlockSize,
 		indexSize:  codec.IndexSize,
+		compress:   compress,
 		fm: meta{
 			writeOffset: headerBlockSize,
 		},
 		actx: appendContext{
 			offset: headerBlockSize,
 		},
-		enc: codec.NewEncoder(),
-		dec: dec,
-		lis: e.lis,
-		f:   f,
+		enc:   codec.NewEncoder(),
+		dec:   dec,
+		lis:   e.lis,
+		store: e.store,
+		f:     f,
+		r:     f,
 	}
 
+	e.initAttributeIndexes(b, true)
+
 	if err := b.persistHeader(ctx, b.fm); err != nil {
 		return nil, processError(err, f, path)
 	}
@@ -94,15 +99,24 @@ func (e *engine) Open(ctx context.Context, id vanus.ID) (block.Raw, error) {
 	path := e.resolvePath(id)
 
 	b := &vsBlock{
-		id:   id,
-		path: path,
-		lis:  e.lis,
+		id:    id,
+		path:  path,
+		lis:   e.lis,
+		store: e.store,
 	}
+	e.initAttributeIndexes(b, false)
 
 	if err := b.Open(ctx); err != nil {
 		return nil, err
 	}
 
+	// Attribute indexes of archived block are loaded lazily, because it is immutable.
+	if b.ai.enabled() && !b.full() {
+		if err := b.loadAttributeIndexes(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	if z, err := file.New(b.f); err == nil {
 		b.z = z
 	} else {
@@ -111,6 +125,11 @@ func (e *engine) Open(ctx context.Context, id vanus.ID) (block.Raw, error) {
 
 	b.s = e.s.Register(b.z, b.actx.offset, false)
 
+	// Resume offloading which is interrupted by restart.
+	if b.needOffload() {
+		b.startOffload()
+	}
+
 	return b, nil
 }
 
@@ -16,6 +16,7 @@ package vsb
 
 import (
 	// standard libraries.
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -23,12 +24,15 @@ import (
 
 	// third-party libraries.
 	. "github.com/smartystreets/goconvey/convey"
+	. "go.uber.org/mock/gomock"
 
 	// first-party libraries.
 	vanus "github.com/vanus-labs/vanus/api/vsr"
 
 	// this project.
 	"github.com/vanus-labs/vanus/pkg/snowflake"
+	"github.com/vanus-labs/vanus/server/store/block"
+	cetest "github.com/vanus-labs/vanus/server/store/schema/ce/testing"
 )
 
 func TestEngine_ResolvePath(t *testing.T) {
@@ -51,3 +55,51 @@ func TestEngine_ResolvePath(t *testing.T) {
 		So(id2, ShouldEqual, id)
 	})
 }
+
+func TestEngine_CreateCompressed(t *testing.T) {
+	ctx := context.Background()
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	Convey("create block with compression", t, func() {
+		dir := t.TempDir()
+		e, err := NewEngine(dir)
+		So(err, ShouldBeNil)
+		defer e.Close()
+
+		id := snowflake.NewTestID()
+		r, err := e.Create(ctx, id, 64*1024, block.CompressZSTD)
+		So(err, ShouldBeNil)
+
+		b, _ := r.(*vsBlock)
+		actx := b.NewAppendContext(nil)
+		_, frag, _, err := b.PrepareAppend(ctx, actx, cetest.MakeEntry1(ctrl))
+		So(err, ShouldBeNil)
+
+		ch := make(chan struct{})
+		b.CommitAppend(ctx, frag, func() {
+			close(ch)
+		})
+		<-ch
+
+		entries, err := b.Read(ctx, 0, 1)
+		So(err, ShouldBeNil)
+		So(entries, ShouldHaveLength, 1)
+		cetest.CheckEntry1(entries[0], true, true)
+
+		So(b.Close(ctx), ShouldBeNil)
+
+		Convey("reopen block", func() {
+			r2, err := e.(*engine).Open(ctx, id)
+			So(err, ShouldBeNil)
+			b2, _ := r2.(*vsBlock)
+			So(b2.compress, ShouldEqual, block.CompressZSTD)
+
+			entries, err := b2.Read(ctx, 0, 1)
+			So(err, ShouldBeNil)
+			So(entries, ShouldHaveLength, 1)
+			cetest.CheckEntry1(entries[0], true, true)
+			So(b2.Close(ctx), ShouldBeNil)
+		})
+	})
+}
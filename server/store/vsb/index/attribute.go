@@ -0,0 +1,166 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package index
+
+import (
+	// standard libraries.
+	"sort"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+type attributeKey struct {
+	val string
+	seq int64
+}
+
+const attributeKeySize = int64(unsafe.Sizeof(attributeKey{}))
+
+// AttributeIndex is a secondary index of block, which maps the value of an attribute to the sequence numbers of
+// entries. Keys are appended in order of sequence number, and sorted by value lazily when looking up.
+type AttributeIndex struct {
+	keys []attributeKey
+	// sorted is the number of sorted keys.
+	sorted int
+	// size is the approximate memory size of keys.
+	size int64
+	mu   sync.Mutex
+}
+
+func NewAttributeIndex() *AttributeIndex {
+	return &AttributeIndex{}
+}
+
+func (ai *AttributeIndex) Add(val string, seq int64) {
+	ai.mu.Lock()
+	defer ai.mu.Unlock()
+	ai.keys = append(ai.keys, attributeKey{val: val, seq: seq})
+	ai.size += attributeKeySize + int64(len(val))
+}
+
+func (ai *AttributeIndex) Len() int {
+	ai.mu.Lock()
+	defer ai.mu.Unlock()
+	return len(ai.keys)
+}
+
+// Size returns the approximate memory size of the index in bytes.
+func (ai *AttributeIndex) Size() int64 {
+	ai.mu.Lock()
+	defer ai.mu.Unlock()
+	return ai.size
+}
+
+// Lookup returns the sequence number of the first entry whose attribute is val, or -1 if not found.
+func (ai *AttributeIndex) Lookup(val string) int64 {
+	ai.mu.Lock()
+	defer ai.mu.Unlock()
+
+	ai.sort()
+
+	i := ai.searchGE(val)
+	if i < len(ai.keys) && ai.keys[i].val == val {
+		return ai.keys[i].seq
+	}
+	return -1
+}
+
+// SeekPrefix returns the sequence number of the first entry in key order whose attribute has prefix, or -1 if not
+// found. Entries with same attribute are ordered by sequence number.
+func (ai *AttributeIndex) SeekPrefix(prefix string) int64 {
+	ai.mu.Lock()
+	defer ai.mu.Unlock()
+
+	ai.sort()
+
+	i := ai.searchGE(prefix)
+	if i < len(ai.keys) && strings.HasPrefix(ai.keys[i].val, prefix) {
+		return ai.keys[i].seq
+	}
+	return -1
+}
+
+// SeekPrefixLast returns the sequence number of the last entry in key order whose attribute has prefix, or -1 if
+// not found.
+func (ai *AttributeIndex) SeekPrefixLast(prefix string) int64 {
+	ai.mu.Lock()
+	defer ai.mu.Unlock()
+
+	ai.sort()
+
+	i := ai.searchAfterPrefix(prefix) - 1
+	if i >= 0 && strings.HasPrefix(ai.keys[i].val, prefix) {
+		return ai.keys[i].seq
+	}
+	return -1
+}
+
+// SeekPrefixLastOrPrev is like SeekPrefixLast, but returns the sequence number of the last entry whose attribute is
+// less than prefix if no attribute has prefix.
+func (ai *AttributeIndex) SeekPrefixLastOrPrev(prefix string) int64 {
+	ai.mu.Lock()
+	defer ai.mu.Unlock()
+
+	ai.sort()
+
+	if i := ai.searchAfterPrefix(prefix) - 1; i >= 0 {
+		return ai.keys[i].seq
+	}
+	return -1
+}
+
+// searchGE returns the position of the first key which is not less than val.
+func (ai *AttributeIndex) searchGE(val string) int {
+	return sort.Search(len(ai.keys), func(i int) bool {
+		return ai.keys[i].val >= val
+	})
+}
+
+// searchAfterPrefix returns the position of the first key which is greater than all keys with prefix.
+func (ai *AttributeIndex) searchAfterPrefix(prefix string) int {
+	return sort.Search(len(ai.keys), func(i int) bool {
+		v := ai.keys[i].val
+		return v >= prefix && !strings.HasPrefix(v, prefix)
+	})
+}
+
+func (ai *AttributeIndex) sort() {
+	if ai.sorted == len(ai.keys) {
+		return
+	}
+
+	// Sort new keys, then merge them with sorted keys. Keys with same value keep the order of sequence number.
+	head, tail := ai.keys[:ai.sorted], ai.keys[ai.sorted:]
+	sort.SliceStable(tail, func(i, j int) bool {
+		return tail[i].val < tail[j].val
+	})
+	if len(head) != 0 {
+		merged := make([]attributeKey, 0, len(ai.keys))
+		for len(head) != 0 && len(tail) != 0 {
+			if tail[0].val < head[0].val {
+				merged = append(merged, tail[0])
+				tail = tail[1:]
+			} else {
+				merged = append(merged, head[0])
+				head = head[1:]
+			}
+		}
+		merged = append(merged, head...)
+		merged = append(merged, tail...)
+		ai.keys = merged
+	}
+	ai.sorted = len(ai.keys)
+}
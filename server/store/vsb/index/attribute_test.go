@@ -0,0 +1,80 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package index_test
+
+import (
+	// standard libraries.
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	"github.com/vanus-labs/vanus/server/store/vsb/index"
+)
+
+func TestAttributeIndex(t *testing.T) {
+	Convey("attribute index", t, func() {
+		ai := index.NewAttributeIndex()
+		So(ai.Lookup("a"), ShouldEqual, -1)
+
+		ai.Add("c", 0)
+		ai.Add("a", 1)
+		ai.Add("b", 2)
+		So(ai.Len(), ShouldEqual, 3)
+		So(ai.Size(), ShouldEqual, 3*(24+1))
+		So(ai.Lookup("a"), ShouldEqual, 1)
+		So(ai.Lookup("b"), ShouldEqual, 2)
+		So(ai.Lookup("c"), ShouldEqual, 0)
+		So(ai.Lookup("d"), ShouldEqual, -1)
+
+		Convey("add keys after lookup", func() {
+			ai.Add("a", 3)
+			ai.Add("0", 4)
+			ai.Add("d", 5)
+			So(ai.Len(), ShouldEqual, 6)
+			// Return the first entry of duplicate values.
+			So(ai.Lookup("a"), ShouldEqual, 1)
+			So(ai.Lookup("0"), ShouldEqual, 4)
+			So(ai.Lookup("d"), ShouldEqual, 5)
+			So(ai.Lookup("c"), ShouldEqual, 0)
+		})
+	})
+
+	Convey("seek prefix", t, func() {
+		ai := index.NewAttributeIndex()
+		ai.Add("order/2", 0)
+		ai.Add("user/1", 1)
+		ai.Add("order/1", 2)
+		ai.Add("order/2", 3)
+		ai.Add("user/2", 4)
+
+		So(ai.SeekPrefix("order/"), ShouldEqual, 2)
+		So(ai.SeekPrefix("order/2"), ShouldEqual, 0)
+		So(ai.SeekPrefix("user/"), ShouldEqual, 1)
+		So(ai.SeekPrefix("zone/"), ShouldEqual, -1)
+		So(ai.SeekPrefix(""), ShouldEqual, 2)
+
+		So(ai.SeekPrefixLast("order/"), ShouldEqual, 3)
+		So(ai.SeekPrefixLast("user/"), ShouldEqual, 4)
+		So(ai.SeekPrefixLast("product/"), ShouldEqual, -1)
+		So(ai.SeekPrefixLast("a"), ShouldEqual, -1)
+
+		So(ai.SeekPrefixLastOrPrev("order/"), ShouldEqual, 3)
+		So(ai.SeekPrefixLastOrPrev("product/"), ShouldEqual, 3)
+		So(ai.SeekPrefixLastOrPrev("zone/"), ShouldEqual, 4)
+		So(ai.SeekPrefixLastOrPrev("a"), ShouldEqual, -1)
+	})
+}
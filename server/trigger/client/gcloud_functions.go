@@ -24,8 +24,33 @@ import (
 	ce "github.com/cloudevents/sdk-go/v2"
 	"google.golang.org/api/idtoken"
 	"google.golang.org/api/option"
+
+	primitive "github.com/vanus-labs/vanus/pkg"
 )
 
+func init() {
+	Register(primitive.GCloudFunctions, gcloudFunctionsPlugin{})
+}
+
+type gcloudFunctionsPlugin struct{}
+
+func (gcloudFunctionsPlugin) Validate(_ context.Context, cfg Config) error {
+	if _, ok := cfg.Credential.(*primitive.GCloudSinkCredential); !ok {
+		return newInvalidErr(
+			"protocol is gcloud functions, sink credential can not be nil and credential type is gcloud", nil)
+	}
+	return nil
+}
+
+func (gcloudFunctionsPlugin) NewClient(cfg Config) EventClient {
+	credential, _ := cfg.Credential.(*primitive.GCloudSinkCredential)
+	return NewGCloudFunctionClient(cfg.Sink, credential.CredentialJSON)
+}
+
+func (gcloudFunctionsPlugin) Batch() bool {
+	return false
+}
+
 type gcloudFunctions struct {
 	client         *nethttp.Client
 	url            string
@@ -54,6 +79,10 @@ func (c *gcloudFunctions) init(ctx context.Context) error {
 	return nil
 }
 
+func (c *gcloudFunctions) Close() error {
+	return nil
+}
+
 func (c *gcloudFunctions) Send(ctx context.Context, events ...*ce.Event) Result {
 	event := events[0]
 	if c.client == nil {
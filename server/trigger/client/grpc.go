@@ -25,8 +25,29 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/vanus-labs/vanus/api/cloudevents"
+
+	primitive "github.com/vanus-labs/vanus/pkg"
 )
 
+func init() {
+	Register(primitive.GRPC, grpcPlugin{})
+}
+
+type grpcPlugin struct{}
+
+func (grpcPlugin) Validate(_ context.Context, _ Config) error {
+	return nil
+}
+
+func (grpcPlugin) NewClient(cfg Config) EventClient {
+	return NewGRPCClient(cfg.Sink)
+}
+
+// Batch is true, all events of a delivery are sent in one request.
+func (grpcPlugin) Batch() bool {
+	return true
+}
+
 type grpc struct {
 	client cloudevents.CloudEventsClient
 	url    string
@@ -60,6 +81,10 @@ func (c *grpc) init() error {
 	return nil
 }
 
+func (c *grpc) Close() error {
+	return nil
+}
+
 func (c *grpc) Send(ctx context.Context, events ...*ce.Event) Result {
 	if c.client == nil {
 		err := c.init()
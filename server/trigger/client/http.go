@@ -17,29 +17,69 @@ package client
 import (
 	"context"
 	"errors"
+	"net/url"
 
 	ce "github.com/cloudevents/sdk-go/v2"
 	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+
+	primitive "github.com/vanus-labs/vanus/pkg"
 )
 
+func init() {
+	Register(primitive.HTTPProtocol, httpPlugin{})
+}
+
+type httpPlugin struct{}
+
+func (httpPlugin) Validate(_ context.Context, cfg Config) error {
+	if _, err := url.Parse(cfg.Sink); err != nil {
+		return newInvalidErr("protocol is http, sink is url,url parse error", err)
+	}
+	if cfg.Setting != nil {
+		for name := range cfg.Setting.Headers {
+			if name == "" {
+				return newInvalidErr("protocol is http, header name can not be empty", nil)
+			}
+		}
+	}
+	return validateHTTPCredential(cfg.Credential)
+}
+
+func (httpPlugin) NewClient(cfg Config) EventClient {
+	if cfg.GatewayAddress != "" {
+		return NewHTTPClientWithGateway(cfg.Sink, cfg.GatewayAddress, cfg.GatewayHeader, cfg.Credential)
+	}
+	return NewHTTPClient(cfg.Sink, cfg.Credential)
+}
+
+func (httpPlugin) Batch() bool {
+	return false
+}
+
 type http struct {
 	client ce.Client
 }
 
-func NewHTTPClient(url string) EventClient {
-	c, _ := ce.NewClientHTTP(ce.WithTarget(url))
+// NewHTTPClient creates a client sending events to the url, the requests are signed
+// or authorized by the credential if it is HMAC or OAuth2.
+func NewHTTPClient(url string, credential primitive.SinkCredential) EventClient {
+	c, _ := ce.NewClientHTTP(append(httpAuthOptions(credential), ce.WithTarget(url))...)
 	return &http{
 		client: c,
 	}
 }
 
-func NewHTTPClientWithGateway(url, gateway, headerKey string) EventClient {
-	c, _ := ce.NewClientHTTP(ce.WithTarget(gateway), ce.WithHeader(headerKey, url))
+func NewHTTPClientWithGateway(url, gateway, headerKey string, credential primitive.SinkCredential) EventClient {
+	c, _ := ce.NewClientHTTP(append(httpAuthOptions(credential), ce.WithTarget(gateway), ce.WithHeader(headerKey, url))...)
 	return &http{
 		client: c,
 	}
 }
 
+func (c *http) Close() error {
+	return nil
+}
+
 func (c *http) Send(ctx context.Context, events ...*ce.Event) Result {
 	event := events[0]
 	res := c.client.Send(ctx, *event)
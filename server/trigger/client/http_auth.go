@@ -0,0 +1,144 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io"
+	nethttp "net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/google/uuid"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	primitive "github.com/vanus-labs/vanus/pkg"
+)
+
+// Headers of Standard Webhooks, see https://www.standardwebhooks.com.
+const (
+	webhookIDHeader        = "webhook-id"
+	webhookTimestampHeader = "webhook-timestamp"
+	webhookSignatureHeader = "webhook-signature"
+
+	webhookSecretPrefix    = "whsec_"
+	webhookSignatureScheme = "v1"
+	cloudEventsIDHeader    = "ce-id"
+)
+
+// oauth2TokenTimeout bounds the request fetching the OAuth2 token.
+const oauth2TokenTimeout = 10 * time.Second
+
+// validateHTTPCredential validates the hmac secret, other credentials, e.g. plain, were allowed before hmac and
+// oauth2 are supported, so they are accepted and ignored.
+func validateHTTPCredential(credential primitive.SinkCredential) error {
+	if c, ok := credential.(*primitive.HMACSinkCredential); ok {
+		if _, err := decodeWebhookSecret(c.Secret); err != nil {
+			return newInvalidErr("protocol is http, hmac secret is invalid", err)
+		}
+	}
+	return nil
+}
+
+// httpAuthOptions returns the options authenticating requests by the credential.
+func httpAuthOptions(credential primitive.SinkCredential) []cehttp.Option {
+	transport := newHTTPTransport(credential)
+	if transport == nil {
+		return nil
+	}
+	// don't use the option WithRoundTripper, it modifies the http.DefaultClient.
+	return []cehttp.Option{cehttp.WithClient(nethttp.Client{Transport: transport})}
+}
+
+func newHTTPTransport(credential primitive.SinkCredential) nethttp.RoundTripper {
+	base := nethttp.DefaultTransport
+	switch c := credential.(type) {
+	case *primitive.HMACSinkCredential:
+		// the secret has been validated.
+		key, _ := decodeWebhookSecret(c.Secret)
+		return &hmacTransport{key: key, base: base, now: time.Now}
+	case *primitive.OAuth2SinkCredential:
+		cfg := clientcredentials.Config{
+			ClientID:     c.ClientID,
+			ClientSecret: c.ClientSecret,
+			TokenURL:     c.TokenURL,
+			Scopes:       c.Scopes,
+		}
+		// the token source caches the token and fetches a new one before it expires.
+		ctx := context.WithValue(context.Background(), oauth2.HTTPClient, &nethttp.Client{Timeout: oauth2TokenTimeout})
+		return &oauth2.Transport{Source: cfg.TokenSource(ctx), Base: base}
+	}
+	return nil
+}
+
+// decodeWebhookSecret decodes the base64 secret with the prefix whsec_, other secrets are used as is.
+func decodeWebhookSecret(secret string) ([]byte, error) {
+	if secret == "" {
+		return nil, errors.New("secret is empty")
+	}
+	if !strings.HasPrefix(secret, webhookSecretPrefix) {
+		return []byte(secret), nil
+	}
+	return base64.StdEncoding.DecodeString(strings.TrimPrefix(secret, webhookSecretPrefix))
+}
+
+// hmacTransport signs requests with HMAC-SHA256 like Standard Webhooks.
+type hmacTransport struct {
+	key  []byte
+	base nethttp.RoundTripper
+	now  func() time.Time
+}
+
+func (t *hmacTransport) RoundTrip(req *nethttp.Request) (*nethttp.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+	}
+	// the RoundTripper should not modify the request.
+	signed := req.Clone(req.Context())
+	signed.Body = io.NopCloser(bytes.NewReader(body))
+	signed.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	id := req.Header.Get(cloudEventsIDHeader)
+	if id == "" {
+		id = uuid.NewString()
+	}
+	timestamp := strconv.FormatInt(t.now().Unix(), 10)
+	signed.Header.Set(webhookIDHeader, id)
+	signed.Header.Set(webhookTimestampHeader, timestamp)
+	signed.Header.Set(webhookSignatureHeader, webhookSignatureScheme+","+signWebhook(t.key, id, timestamp, body))
+	return t.base.RoundTrip(signed)
+}
+
+func signWebhook(key []byte, id, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(id + "." + timestamp + "."))
+	mac.Write(body)
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,127 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+
+	primitive "github.com/vanus-labs/vanus/pkg"
+)
+
+func newHTTPTestEvent() *ce.Event {
+	e := ce.NewEvent()
+	e.SetID("msg_p5jXN8AQM9LWM0D4loKWxJek")
+	e.SetSource("source")
+	e.SetType("type")
+	_ = e.SetData(ce.ApplicationJSON, map[string]string{"k": "v"})
+	return &e
+}
+
+func TestHTTP_HMAC(t *testing.T) {
+	ctx := context.Background()
+	Convey("test http sink with hmac credential", t, func() {
+		key := []byte("test_key")
+		secret := webhookSecretPrefix + base64.StdEncoding.EncodeToString(key)
+		var header nethttp.Header
+		var body []byte
+		server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			header = r.Header
+			body, _ = io.ReadAll(r.Body)
+		}))
+		defer server.Close()
+
+		c := NewHTTPClient(server.URL, primitive.NewHMACSinkCredential(secret))
+		res := c.Send(ctx, newHTTPTestEvent())
+		So(res.Err, ShouldBeNil)
+		id := header.Get(webhookIDHeader)
+		timestamp := header.Get(webhookTimestampHeader)
+		So(id, ShouldEqual, "msg_p5jXN8AQM9LWM0D4loKWxJek")
+		So(timestamp, ShouldNotBeEmpty)
+		So(header.Get(webhookSignatureHeader), ShouldEqual, "v1,"+signWebhook(key, id, timestamp, body))
+	})
+
+	Convey("test decode webhook secret", t, func() {
+		key, err := decodeWebhookSecret("whsec_MfKQ9r8GKYqrTwjUPD8ILPZIo2LaLaSw")
+		So(err, ShouldBeNil)
+		So(key, ShouldHaveLength, 24)
+		key, err = decodeWebhookSecret("raw")
+		So(err, ShouldBeNil)
+		So(key, ShouldResemble, []byte("raw"))
+		_, err = decodeWebhookSecret("whsec_!!")
+		So(err, ShouldNotBeNil)
+		_, err = decodeWebhookSecret("")
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("test signature of Standard Webhooks example", t, func() {
+		key, _ := decodeWebhookSecret("whsec_MfKQ9r8GKYqrTwjUPD8ILPZIo2LaLaSw")
+		sig := signWebhook(key, "msg_p5jXN8AQM9LWM0D4loKWxJek", "1614265330", []byte(`{"test": 2432232314}`))
+		So(sig, ShouldEqual, "g0hM9SsE+OTPJTGt/tmIKtSyZlE3uFJELVlNIOLJ1OE=")
+	})
+}
+
+func TestHTTP_OAuth2(t *testing.T) {
+	ctx := context.Background()
+	Convey("test http sink with oauth2 credential", t, func() {
+		var tokenRequests int32
+		tokenServer := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			atomic.AddInt32(&tokenRequests, 1)
+			_ = r.ParseForm()
+			if r.Form.Get("grant_type") != "client_credentials" || r.Form.Get("scope") != "events" {
+				w.WriteHeader(nethttp.StatusBadRequest)
+				return
+			}
+			id, secret, _ := r.BasicAuth()
+			if id != "client" || secret != "secret" {
+				w.WriteHeader(nethttp.StatusUnauthorized)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "token",
+				"token_type":   "Bearer",
+				"expires_in":   3600,
+			})
+		}))
+		defer tokenServer.Close()
+		server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			if r.Header.Get("Authorization") != "Bearer token" {
+				w.WriteHeader(nethttp.StatusUnauthorized)
+			}
+		}))
+		defer server.Close()
+
+		c := NewHTTPClient(server.URL, primitive.NewOAuth2SinkCredential(tokenServer.URL,
+			"client", "secret", []string{"events"}))
+		So(c.Send(ctx, newHTTPTestEvent()).Err, ShouldBeNil)
+		So(c.Send(ctx, newHTTPTestEvent()).Err, ShouldBeNil)
+		// the token is cached.
+		So(atomic.LoadInt32(&tokenRequests), ShouldEqual, 1)
+
+		c = NewHTTPClient(server.URL, primitive.NewOAuth2SinkCredential(tokenServer.URL,
+			"client", "wrong", nil))
+		So(c.Send(ctx, newHTTPTestEvent()).Err, ShouldNotBeNil)
+	})
+}
@@ -30,6 +30,8 @@ type Sender interface {
 
 type EventClient interface {
 	Sender
+	// Close releases the resources of the client, it can't send events after closed.
+	Close() error
 }
 
 type Result struct {
@@ -0,0 +1,327 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/IBM/sarama"
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+
+	primitive "github.com/vanus-labs/vanus/pkg"
+)
+
+const (
+	kafkaScheme = "kafka"
+
+	kafkaModeBinary     = "binary"
+	kafkaModeStructured = "structured"
+
+	// kafkaHeaderPrefix is the prefix of headers carrying attributes in binary mode.
+	kafkaHeaderPrefix      = "ce_"
+	kafkaHeaderContentType = "content-type"
+	kafkaStructuredType    = "application/cloudevents+json"
+	// defaultKafkaKeyAttribute is the extension of the CloudEvents partitioning which maps to the key.
+	defaultKafkaKeyAttribute = "partitionkey"
+	kafkaClientID            = "vanus-trigger"
+)
+
+func init() {
+	Register(primitive.KafkaProtocol, kafkaPlugin{})
+}
+
+type kafkaPlugin struct{}
+
+func (kafkaPlugin) Validate(_ context.Context, cfg Config) error {
+	if _, err := parseKafkaSink(cfg.Sink); err != nil {
+		return newInvalidErr("protocol is kafka, sink is invalid", err)
+	}
+	switch cfg.Credential.(type) {
+	case nil, *primitive.PlainSinkCredential:
+	default:
+		return newInvalidErr("protocol is kafka, sink credential type must be plain", nil)
+	}
+	return nil
+}
+
+func (kafkaPlugin) NewClient(cfg Config) EventClient {
+	credential, _ := cfg.Credential.(*primitive.PlainSinkCredential)
+	return NewKafkaClient(cfg.Sink, credential)
+}
+
+func (kafkaPlugin) Batch() bool {
+	return true
+}
+
+// kafkaSink is parsed from the sink like
+// kafka://broker1:9092,broker2:9092/topic?mode=binary&topic_attribute=xxx&key_attribute=xxx&tls=true.
+type kafkaSink struct {
+	brokers []string
+	// topic is used if the event hasn't the topic attribute.
+	topic          string
+	topicAttribute string
+	keyAttribute   string
+	structured     bool
+	tls            bool
+}
+
+func parseKafkaSink(sink string) (*kafkaSink, error) {
+	u, err := url.Parse(sink)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != kafkaScheme {
+		return nil, fmt.Errorf("scheme must be %s", kafkaScheme)
+	}
+	s := &kafkaSink{
+		topic:          strings.TrimPrefix(u.Path, "/"),
+		topicAttribute: u.Query().Get("topic_attribute"),
+		keyAttribute:   u.Query().Get("key_attribute"),
+		tls:            u.Query().Get("tls") == "true",
+	}
+	for _, broker := range strings.Split(u.Host, ",") {
+		if broker != "" {
+			s.brokers = append(s.brokers, broker)
+		}
+	}
+	if len(s.brokers) == 0 {
+		return nil, errors.New("brokers is empty")
+	}
+	if s.topic == "" && s.topicAttribute == "" {
+		return nil, errors.New("topic and topic_attribute are both empty")
+	}
+	if s.keyAttribute == "" {
+		s.keyAttribute = defaultKafkaKeyAttribute
+	}
+	switch mode := u.Query().Get("mode"); mode {
+	case "", kafkaModeBinary:
+	case kafkaModeStructured:
+		s.structured = true
+	default:
+		return nil, fmt.Errorf("mode %s is invalid", mode)
+	}
+	return s, nil
+}
+
+var errKafkaClientClosed = errors.New("kafka client is closed")
+
+type kafka struct {
+	sink     *kafkaSink
+	err      error
+	config   *sarama.Config
+	producer sarama.SyncProducer
+	closed   bool
+	lock     sync.Mutex
+	// sending counts the messages being produced, the producer is closed after them.
+	sending sync.WaitGroup
+}
+
+// NewKafkaClient creates a client producing events to the kafka, the credential is used by SASL/PLAIN.
+func NewKafkaClient(sink string, credential *primitive.PlainSinkCredential) EventClient {
+	s, err := parseKafkaSink(sink)
+	if err != nil {
+		return &kafka{err: err}
+	}
+	config := sarama.NewConfig()
+	config.ClientID = kafkaClientID
+	// record headers require 0.11 at least.
+	config.Version = sarama.V1_0_0_0
+	config.Producer.Return.Successes = true
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Net.TLS.Enable = s.tls
+	if credential != nil {
+		config.Net.SASL.Enable = true
+		config.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+		config.Net.SASL.User = credential.Identifier
+		config.Net.SASL.Password = credential.Secret
+	}
+	return &kafka{
+		sink:   s,
+		config: config,
+	}
+}
+
+// acquire returns the producer, which is created at the first time, the caller must call c.sending.Done
+// after producing.
+func (c *kafka) acquire() (sarama.SyncProducer, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.closed {
+		return nil, errKafkaClientClosed
+	}
+	if c.producer == nil {
+		producer, err := sarama.NewSyncProducer(c.sink.brokers, c.config)
+		if err != nil {
+			return nil, err
+		}
+		c.producer = producer
+	}
+	c.sending.Add(1)
+	return c.producer, nil
+}
+
+func (c *kafka) Send(ctx context.Context, events ...*ce.Event) Result {
+	if c.err != nil {
+		return Result{StatusCode: errStatusCode, Err: c.err}
+	}
+	msgs := make([]*sarama.ProducerMessage, len(events))
+	for idx, event := range events {
+		msg, err := c.toMessage(event)
+		if err != nil {
+			return Result{StatusCode: errStatusCode, Err: err}
+		}
+		msgs[idx] = msg
+	}
+	producer, err := c.acquire()
+	if err != nil {
+		return newUnknownErr(err)
+	}
+	// the sync producer doesn't support context, the events may be produced after timeout.
+	errCh := make(chan error, 1)
+	go func() {
+		defer c.sending.Done()
+		errCh <- producer.SendMessages(msgs)
+	}()
+	select {
+	case <-ctx.Done():
+		return DeliveryTimeout
+	case err := <-errCh:
+		if err == nil {
+			return Success
+		}
+		if isKafkaInvalidMessage(err) {
+			return Result{StatusCode: errStatusCode, Err: err}
+		}
+		return newUnknownErr(err)
+	}
+}
+
+// Close closes the producer after the messages being produced, the client can't send anymore.
+func (c *kafka) Close() error {
+	c.lock.Lock()
+	if c.closed {
+		c.lock.Unlock()
+		return nil
+	}
+	c.closed = true
+	producer := c.producer
+	c.lock.Unlock()
+	if producer == nil {
+		return nil
+	}
+	c.sending.Wait()
+	return producer.Close()
+}
+
+// isKafkaInvalidMessage reports whether the messages are rejected by the kafka, so retrying is pointless.
+func isKafkaInvalidMessage(err error) bool {
+	var errs sarama.ProducerErrors
+	if !errors.As(err, &errs) {
+		return errors.Is(err, sarama.ErrMessageSizeTooLarge) || errors.Is(err, sarama.ErrInvalidMessage)
+	}
+	for _, e := range errs {
+		if !isKafkaInvalidMessage(e.Err) {
+			return false
+		}
+	}
+	return len(errs) > 0
+}
+
+func (c *kafka) toMessage(event *ce.Event) (*sarama.ProducerMessage, error) {
+	msg := &sarama.ProducerMessage{
+		Topic: c.sink.topic,
+	}
+	if c.sink.topicAttribute != "" {
+		if topic := eventAttribute(event, c.sink.topicAttribute); topic != "" {
+			msg.Topic = topic
+		}
+	}
+	if msg.Topic == "" {
+		return nil, fmt.Errorf("event has no attribute %s as the topic", c.sink.topicAttribute)
+	}
+	if key := eventAttribute(event, c.sink.keyAttribute); key != "" {
+		msg.Key = sarama.StringEncoder(key)
+	}
+	if c.sink.structured {
+		payload, err := event.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		msg.Value = sarama.ByteEncoder(payload)
+		msg.Headers = []sarama.RecordHeader{
+			{Key: []byte(kafkaHeaderContentType), Value: []byte(kafkaStructuredType)},
+		}
+		return msg, nil
+	}
+	msg.Headers = binaryHeaders(event)
+	if data := event.Data(); data != nil {
+		msg.Value = sarama.ByteEncoder(data)
+	}
+	return msg, nil
+}
+
+// binaryHeaders maps attributes to headers with the prefix ce_, but the datacontenttype to content-type.
+func binaryHeaders(event *ce.Event) []sarama.RecordHeader {
+	headers := make([]sarama.RecordHeader, 0, 8+len(event.Extensions()))
+	add := func(name, value string) {
+		if value != "" {
+			headers = append(headers, sarama.RecordHeader{Key: []byte(name), Value: []byte(value)})
+		}
+	}
+	add(kafkaHeaderPrefix+"specversion", event.SpecVersion())
+	add(kafkaHeaderPrefix+"id", event.ID())
+	add(kafkaHeaderPrefix+"source", event.Source())
+	add(kafkaHeaderPrefix+"type", event.Type())
+	add(kafkaHeaderPrefix+"subject", event.Subject())
+	add(kafkaHeaderPrefix+"dataschema", event.DataSchema())
+	if !event.Time().IsZero() {
+		add(kafkaHeaderPrefix+"time", types.FormatTime(event.Time()))
+	}
+	add(kafkaHeaderContentType, event.DataContentType())
+	for name, value := range event.Extensions() {
+		v, _ := types.Format(value)
+		add(kafkaHeaderPrefix+name, v)
+	}
+	return headers
+}
+
+func eventAttribute(event *ce.Event, name string) string {
+	switch name {
+	case "id":
+		return event.ID()
+	case "source":
+		return event.Source()
+	case "type":
+		return event.Type()
+	case "subject":
+		return event.Subject()
+	case "dataschema":
+		return event.DataSchema()
+	case "datacontenttype":
+		return event.DataContentType()
+	}
+	value, exist := event.Extensions()[name]
+	if !exist {
+		return ""
+	}
+	v, _ := types.Format(value)
+	return v
+}
@@ -0,0 +1,157 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+
+	primitive "github.com/vanus-labs/vanus/pkg"
+)
+
+func headerMap(headers []sarama.RecordHeader) map[string]string {
+	m := make(map[string]string, len(headers))
+	for _, h := range headers {
+		m[string(h.Key)] = string(h.Value)
+	}
+	return m
+}
+
+func newKafkaTestEvent() *ce.Event {
+	e := ce.NewEvent()
+	e.SetID("id")
+	e.SetSource("source")
+	e.SetType("type")
+	e.SetTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	e.SetExtension("partitionkey", "key")
+	e.SetExtension("topic", "events")
+	_ = e.SetData(ce.ApplicationJSON, map[string]string{"k": "v"})
+	return &e
+}
+
+func TestParseKafkaSink(t *testing.T) {
+	Convey("test parse kafka sink", t, func() {
+		s, err := parseKafkaSink("kafka://127.0.0.1:9092,127.0.0.2:9092/topic")
+		So(err, ShouldBeNil)
+		So(s.brokers, ShouldResemble, []string{"127.0.0.1:9092", "127.0.0.2:9092"})
+		So(s.topic, ShouldEqual, "topic")
+		So(s.keyAttribute, ShouldEqual, defaultKafkaKeyAttribute)
+		So(s.structured, ShouldBeFalse)
+		So(s.tls, ShouldBeFalse)
+
+		s, err = parseKafkaSink("kafka://127.0.0.1:9092?mode=structured&topic_attribute=topic&key_attribute=id&tls=true")
+		So(err, ShouldBeNil)
+		So(s.topic, ShouldEqual, "")
+		So(s.topicAttribute, ShouldEqual, "topic")
+		So(s.keyAttribute, ShouldEqual, "id")
+		So(s.structured, ShouldBeTrue)
+		So(s.tls, ShouldBeTrue)
+
+		_, err = parseKafkaSink("http://127.0.0.1:9092/topic")
+		So(err, ShouldNotBeNil)
+		_, err = parseKafkaSink("kafka:///topic")
+		So(err, ShouldNotBeNil)
+		_, err = parseKafkaSink("kafka://127.0.0.1:9092")
+		So(err, ShouldNotBeNil)
+		_, err = parseKafkaSink("kafka://127.0.0.1:9092/topic?mode=unknown")
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestKafka_toMessage(t *testing.T) {
+	Convey("test kafka to message", t, func() {
+		e := newKafkaTestEvent()
+		Convey("binary mode", func() {
+			c, _ := NewKafkaClient("kafka://127.0.0.1:9092/topic", nil).(*kafka)
+			msg, err := c.toMessage(e)
+			So(err, ShouldBeNil)
+			So(msg.Topic, ShouldEqual, "topic")
+			So(msg.Key, ShouldResemble, sarama.StringEncoder("key"))
+			So(msg.Value, ShouldResemble, sarama.ByteEncoder(e.Data()))
+			So(headerMap(msg.Headers), ShouldResemble, map[string]string{
+				"ce_specversion":  "1.0",
+				"ce_id":           "id",
+				"ce_source":       "source",
+				"ce_type":         "type",
+				"ce_time":         "2023-01-01T00:00:00Z",
+				"ce_partitionkey": "key",
+				"ce_topic":        "events",
+				"content-type":    ce.ApplicationJSON,
+			})
+		})
+		Convey("structured mode", func() {
+			c, _ := NewKafkaClient("kafka://127.0.0.1:9092?mode=structured&topic_attribute=topic&key_attribute=id",
+				nil).(*kafka)
+			msg, err := c.toMessage(e)
+			So(err, ShouldBeNil)
+			So(msg.Topic, ShouldEqual, "events")
+			So(msg.Key, ShouldResemble, sarama.StringEncoder("id"))
+			So(headerMap(msg.Headers), ShouldResemble, map[string]string{"content-type": kafkaStructuredType})
+			payload, _ := msg.Value.Encode()
+			var got ce.Event
+			So(got.UnmarshalJSON(payload), ShouldBeNil)
+			So(got.ID(), ShouldEqual, e.ID())
+			So(got.Extensions(), ShouldResemble, e.Extensions())
+			So(got.Data(), ShouldResemble, e.Data())
+
+			e.SetExtension("topic", nil)
+			_, err = c.toMessage(e)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestKafka_Send(t *testing.T) {
+	ctx := context.Background()
+	Convey("test kafka send", t, func() {
+		broker := sarama.NewMockBroker(t, 1)
+		defer broker.Close()
+		metadata := sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader("topic", 0, broker.BrokerID())
+		produce := sarama.NewMockProduceResponse(t)
+		broker.SetHandlerByMap(map[string]sarama.MockResponse{
+			"MetadataRequest": metadata,
+			"ProduceRequest":  produce,
+		})
+		c := NewKafkaClient("kafka://"+broker.Addr()+"/topic",
+			primitive.NewPlainSinkCredential("user", "password").(*primitive.PlainSinkCredential))
+		c.(*kafka).config.Net.SASL.Enable = false
+		c.(*kafka).config.Producer.Retry.Max = 0
+
+		res := c.Send(ctx, newKafkaTestEvent(), newKafkaTestEvent())
+		So(res.Err, ShouldBeNil)
+
+		produce.SetError("topic", 0, sarama.ErrMessageSizeTooLarge)
+		res = c.Send(ctx, newKafkaTestEvent())
+		So(res.StatusCode, ShouldEqual, errStatusCode)
+		So(res.Err, ShouldNotBeNil)
+
+		So(c.Close(), ShouldBeNil)
+		So(c.Close(), ShouldBeNil)
+		res = c.Send(ctx, newKafkaTestEvent())
+		So(res.Err, ShouldEqual, errKafkaClientClosed)
+	})
+
+	Convey("test kafka send with invalid sink", t, func() {
+		res := NewKafkaClient("kafka://127.0.0.1:9092", nil).Send(ctx, newKafkaTestEvent())
+		So(res.StatusCode, ShouldEqual, errStatusCode)
+	})
+}
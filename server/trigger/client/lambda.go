@@ -23,8 +23,35 @@ import (
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	ce "github.com/cloudevents/sdk-go/v2"
+
+	primitive "github.com/vanus-labs/vanus/pkg"
 )
 
+func init() {
+	Register(primitive.AwsLambdaProtocol, awsLambdaPlugin{})
+}
+
+type awsLambdaPlugin struct{}
+
+func (awsLambdaPlugin) Validate(_ context.Context, cfg Config) error {
+	if _, err := arn.Parse(cfg.Sink); err != nil {
+		return newInvalidErr("protocol is aws lambda, sink is arn, arn parse error", err)
+	}
+	if _, ok := cfg.Credential.(*primitive.AkSkSinkCredential); !ok {
+		return newInvalidErr("protocol is aws lambda, sink credential can not be nil and credential type is aws", nil)
+	}
+	return nil
+}
+
+func (awsLambdaPlugin) NewClient(cfg Config) EventClient {
+	credential, _ := cfg.Credential.(*primitive.AkSkSinkCredential)
+	return NewAwsLambdaClient(credential.AccessKeyID, credential.SecretAccessKey, cfg.Sink)
+}
+
+func (awsLambdaPlugin) Batch() bool {
+	return false
+}
+
 type awsLambda struct {
 	client *lambda.Client
 	arn    *string
@@ -43,6 +70,10 @@ func NewAwsLambdaClient(accessKeyID, secretKeyID, arnStr string) EventClient {
 	}
 }
 
+func (l *awsLambda) Close() error {
+	return nil
+}
+
 func (l *awsLambda) Send(ctx context.Context, events ...*ce.Event) Result {
 	event := events[0]
 	payload, err := event.MarshalJSON()
@@ -82,6 +82,20 @@ func (m *MockEventClient) EXPECT() *MockEventClientMockRecorder {
 	return m.recorder
 }
 
+// Close mocks base method.
+func (m *MockEventClient) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockEventClientMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockEventClient)(nil).Close))
+}
+
 // Send mocks base method.
 func (m *MockEventClient) Send(ctx context.Context, events ...*v2.Event) Result {
 	m.ctrl.T.Helper()
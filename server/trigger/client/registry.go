@@ -0,0 +1,95 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/vanus-labs/vanus/api/errors"
+
+	primitive "github.com/vanus-labs/vanus/pkg"
+)
+
+// Config is the sink of a subscription which an event client delivers events to.
+type Config struct {
+	Sink       string
+	Credential primitive.SinkCredential
+	Setting    *primitive.ProtocolSetting
+	// GatewayAddress and GatewayHeader are set if events are sent to the sink through a gateway,
+	// the sink is carried by the header.
+	GatewayAddress string
+	GatewayHeader  string
+}
+
+// Plugin supports a sink protocol, it is shared by the controller to validate subscriptions
+// and by the trigger to deliver events.
+type Plugin interface {
+	// Validate checks the sink, the credential and the setting of a subscription.
+	Validate(ctx context.Context, cfg Config) error
+	// NewClient creates the event client of a validated subscription.
+	NewClient(cfg Config) EventClient
+	// Batch reports whether the event client accepts more than one event per Send.
+	Batch() bool
+}
+
+var (
+	pluginsMu sync.RWMutex
+	plugins   = make(map[primitive.Protocol]Plugin)
+)
+
+// Register makes a plugin available by the protocol, it panics if the protocol has been registered.
+func Register(protocol primitive.Protocol, plugin Plugin) {
+	pluginsMu.Lock()
+	defer pluginsMu.Unlock()
+	if plugin == nil {
+		panic("client: register plugin is nil")
+	}
+	if _, exist := plugins[protocol]; exist {
+		panic(fmt.Sprintf("client: register plugin twice for protocol %s", protocol))
+	}
+	plugins[protocol] = plugin
+}
+
+// GetPlugin returns the plugin of the protocol.
+func GetPlugin(protocol primitive.Protocol) (Plugin, bool) {
+	pluginsMu.RLock()
+	defer pluginsMu.RUnlock()
+	plugin, exist := plugins[protocol]
+	return plugin, exist
+}
+
+// Protocols returns the sorted protocols which have been registered.
+func Protocols() []primitive.Protocol {
+	pluginsMu.RLock()
+	defer pluginsMu.RUnlock()
+	protocols := make([]primitive.Protocol, 0, len(plugins))
+	for protocol := range plugins {
+		protocols = append(protocols, protocol)
+	}
+	sort.Slice(protocols, func(i, j int) bool {
+		return protocols[i] < protocols[j]
+	})
+	return protocols
+}
+
+func newInvalidErr(message string, err error) error {
+	if err != nil {
+		return errors.ErrInvalidRequest.WithMessage(message).Wrap(err)
+	}
+	return errors.ErrInvalidRequest.WithMessage(message)
+}
@@ -0,0 +1,99 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	primitive "github.com/vanus-labs/vanus/pkg"
+)
+
+func TestRegistry(t *testing.T) {
+	ctx := context.Background()
+	Convey("test builtin plugins", t, func() {
+		So(Protocols(), ShouldResemble, []primitive.Protocol{
+			primitive.AwsLambdaProtocol, primitive.GCloudFunctions, primitive.GRPC, primitive.HTTPProtocol,
+			primitive.KafkaProtocol,
+		})
+		_, exist := GetPlugin("unknown")
+		So(exist, ShouldBeFalse)
+		So(func() { Register(primitive.HTTPProtocol, httpPlugin{}) }, ShouldPanic)
+	})
+
+	Convey("test http plugin", t, func() {
+		plugin, _ := GetPlugin(primitive.HTTPProtocol)
+		So(plugin.Batch(), ShouldBeFalse)
+		So(plugin.Validate(ctx, Config{Sink: "http://example.com"}), ShouldBeNil)
+		So(plugin.Validate(ctx, Config{Sink: "http://example.com", Setting: &primitive.ProtocolSetting{
+			Headers: map[string]string{"": "value"},
+		}}), ShouldNotBeNil)
+		So(plugin.NewClient(Config{Sink: "http://example.com"}), ShouldNotBeNil)
+		So(plugin.Validate(ctx, Config{
+			Sink:       "http://example.com",
+			Credential: primitive.NewHMACSinkCredential("whsec_MfKQ9r8GKYqrTwjUPD8ILPZIo2LaLaSw"),
+		}), ShouldBeNil)
+		So(plugin.Validate(ctx, Config{
+			Sink:       "http://example.com",
+			Credential: primitive.NewHMACSinkCredential("whsec_!!"),
+		}), ShouldNotBeNil)
+		So(plugin.Validate(ctx, Config{
+			Sink:       "http://example.com",
+			Credential: primitive.NewAkSkSinkCredential("ak", "sk"),
+		}), ShouldBeNil)
+		So(plugin.Validate(ctx, Config{
+			Sink:       "http://example.com",
+			Credential: primitive.NewPlainSinkCredential("id", "secret"),
+		}), ShouldBeNil)
+	})
+
+	Convey("test aws lambda plugin", t, func() {
+		plugin, _ := GetPlugin(primitive.AwsLambdaProtocol)
+		sink := "arn:aws:lambda:us-west-2:843378899134:function:xdltest"
+		So(plugin.Validate(ctx, Config{Sink: "arn:aws:lambda"}), ShouldNotBeNil)
+		So(plugin.Validate(ctx, Config{Sink: sink}), ShouldNotBeNil)
+		So(plugin.Validate(ctx, Config{
+			Sink:       sink,
+			Credential: primitive.NewPlainSinkCredential("id", "secret"),
+		}), ShouldNotBeNil)
+		cfg := Config{Sink: sink, Credential: primitive.NewAkSkSinkCredential("ak", "sk")}
+		So(plugin.Validate(ctx, cfg), ShouldBeNil)
+		So(plugin.NewClient(cfg), ShouldNotBeNil)
+	})
+
+	Convey("test grpc plugin", t, func() {
+		plugin, _ := GetPlugin(primitive.GRPC)
+		So(plugin.Batch(), ShouldBeTrue)
+		So(plugin.Validate(ctx, Config{Sink: "127.0.0.1:8080"}), ShouldBeNil)
+	})
+
+	Convey("test kafka plugin", t, func() {
+		plugin, _ := GetPlugin(primitive.KafkaProtocol)
+		So(plugin.Batch(), ShouldBeTrue)
+		So(plugin.Validate(ctx, Config{Sink: "http://127.0.0.1:9092/topic"}), ShouldNotBeNil)
+		So(plugin.Validate(ctx, Config{
+			Sink:       "kafka://127.0.0.1:9092/topic",
+			Credential: primitive.NewAkSkSinkCredential("ak", "sk"),
+		}), ShouldNotBeNil)
+		cfg := Config{
+			Sink:       "kafka://127.0.0.1:9092/topic",
+			Credential: primitive.NewPlainSinkCredential("user", "password"),
+		}
+		So(plugin.Validate(ctx, cfg), ShouldBeNil)
+		So(plugin.NewClient(cfg), ShouldNotBeNil)
+	})
+}
@@ -41,4 +41,9 @@ type Config struct {
 	MaxUACKEventNumber int   `yaml:"max_uack_event_number"`
 	DisableDeadLetter  *bool `yaml:"disable_dead_letter"`
 	OrderEvent         *bool `yaml:"order_event"`
+	// SharedReader makes the subscriptions on the same eventbus share the reading of eventlogs.
+	SharedReader bool `yaml:"shared_reader"`
+	// SharedReaderWindow is the max number of recent events kept for each eventlog read shared,
+	// the subscriptions lagging behind them read the eventlog by themselves.
+	SharedReaderWindow int `yaml:"shared_reader_window"`
 }
@@ -0,0 +1,84 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"strings"
+
+	segpb "github.com/vanus-labs/vanus/api/segment"
+
+	primitive "github.com/vanus-labs/vanus/pkg"
+)
+
+// GetAttributeFilter returns the filter pushed down to segment servers when reading events. It is
+// compiled from the exact, prefix and suffix filters on attributes which are required by all
+// subscription filters, so it may match more events than the subscription filters but never less,
+// and the trigger still filters the events read. It returns nil if no filter can be pushed down.
+func GetAttributeFilter(subscriptionFilters []*primitive.SubscriptionFilter) *segpb.AttributeFilter {
+	f := &segpb.AttributeFilter{}
+	for _, subscriptionFilter := range subscriptionFilters {
+		pushDown(f, subscriptionFilter)
+	}
+	if len(f.Exact) == 0 && len(f.Prefix) == 0 && len(f.Suffix) == 0 {
+		return nil
+	}
+	return f
+}
+
+// pushDown follows the precedence of extractFilter.
+func pushDown(f *segpb.AttributeFilter, subscriptionFilter *primitive.SubscriptionFilter) {
+	switch {
+	case len(subscriptionFilter.Exact) > 0:
+		f.Exact = pushDownCondition(f.Exact, subscriptionFilter.Exact)
+	case len(subscriptionFilter.Prefix) > 0:
+		f.Prefix = pushDownCondition(f.Prefix, subscriptionFilter.Prefix)
+	case len(subscriptionFilter.Suffix) > 0:
+		f.Suffix = pushDownCondition(f.Suffix, subscriptionFilter.Suffix)
+	case subscriptionFilter.Not != nil, subscriptionFilter.CeSQL != "", subscriptionFilter.CEL != "":
+		// they can't be pushed down.
+	case len(subscriptionFilter.All) > 0:
+		for _, sub := range subscriptionFilter.All {
+			pushDown(f, sub)
+		}
+	}
+}
+
+func pushDownCondition(condition, value map[string]string) map[string]string {
+	for attr, v := range value {
+		// newCommonFilter ignores the filter.
+		if attr == "" || v == "" {
+			return condition
+		}
+	}
+	for attr, v := range value {
+		if !isPushDownAttribute(attr) {
+			continue
+		}
+		if condition == nil {
+			condition = make(map[string]string, len(value))
+		}
+		// Only one condition of an attribute can be pushed down, the trigger checks the others.
+		if _, exist := condition[attr]; !exist {
+			condition[attr] = v
+		}
+	}
+	return condition
+}
+
+// isPushDownAttribute reports whether the attribute can be compared by segment servers. The data is
+// not parsed by them, and the time is stored in a different format from the one the trigger compares.
+func isPushDownAttribute(attr string) bool {
+	return attr != "data" && !strings.HasPrefix(attr, "data.") && attr != "time"
+}
@@ -0,0 +1,78 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	segpb "github.com/vanus-labs/vanus/api/segment"
+
+	primitive "github.com/vanus-labs/vanus/pkg"
+	"github.com/vanus-labs/vanus/server/trigger/filter"
+)
+
+func TestGetAttributeFilter(t *testing.T) {
+	Convey("test get attribute filter", t, func() {
+		Convey("no filter", func() {
+			So(filter.GetAttributeFilter(nil), ShouldBeNil)
+		})
+
+		Convey("attribute filters", func() {
+			f := filter.GetAttributeFilter([]*primitive.SubscriptionFilter{
+				{Exact: map[string]string{"type": "order.created", "data.id": "1"}},
+				{Prefix: map[string]string{"source": "vanus.ai/"}},
+				{Suffix: map[string]string{"subject": "/1", "time": "Z"}},
+			})
+			So(f, ShouldResemble, &segpb.AttributeFilter{
+				Exact:  map[string]string{"type": "order.created"},
+				Prefix: map[string]string{"source": "vanus.ai/"},
+				Suffix: map[string]string{"subject": "/1"},
+			})
+		})
+
+		Convey("nested filters", func() {
+			f := filter.GetAttributeFilter([]*primitive.SubscriptionFilter{
+				{All: primitive.SubscriptionFilterList{
+					{Exact: map[string]string{"type": "order.created"}},
+					{All: primitive.SubscriptionFilterList{
+						{Exact: map[string]string{"type": "order.deleted", "region": "us"}},
+					}},
+				}},
+				{Any: primitive.SubscriptionFilterList{
+					{Exact: map[string]string{"source": "a"}},
+					{Exact: map[string]string{"source": "b"}},
+				}},
+				{Not: &primitive.SubscriptionFilter{Exact: map[string]string{"source": "c"}}},
+				{CEL: "$id.(string) == 'x'"},
+			})
+			So(f, ShouldResemble, &segpb.AttributeFilter{
+				Exact: map[string]string{"type": "order.created", "region": "us"},
+			})
+		})
+
+		Convey("ignored filters", func() {
+			f := filter.GetAttributeFilter([]*primitive.SubscriptionFilter{
+				{Exact: map[string]string{"type": "order.created", "source": ""}},
+				{Exact: map[string]string{"data": "x"}},
+				{Exact: map[string]string{"subject": "s"}, Prefix: map[string]string{"source": "vanus.ai/"}},
+			})
+			So(f, ShouldResemble, &segpb.AttributeFilter{
+				Exact: map[string]string{"subject": "s"},
+			})
+		})
+	})
+}
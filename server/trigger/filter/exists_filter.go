@@ -0,0 +1,51 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	ce "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/vanus-labs/vanus/pkg/observability/log"
+)
+
+type existsFilter struct {
+	keys     []string
+	locators []*valueLocator
+}
+
+// NewExistsFilter returns a filter which passes events having all the keys.
+func NewExistsFilter(keys []string) Filter {
+	if len(keys) == 0 {
+		return nil
+	}
+	locators := newValueLocators(keys)
+	if locators == nil {
+		return nil
+	}
+	return &existsFilter{keys: keys, locators: locators}
+}
+
+func (filter *existsFilter) Filter(event ce.Event) Result {
+	data := newEventData(&event)
+	for _, l := range filter.locators {
+		if values, _ := l.lookup(event, data); len(values) == 0 {
+			log.Debug().Str("key", l.key).Msg("exists filter key not found")
+			return FailFilter
+		}
+	}
+	return PassFilter
+}
+
+var _ Filter = (*existsFilter)(nil)
@@ -0,0 +1,60 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter_test
+
+import (
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/vanus-labs/vanus/server/trigger/filter"
+)
+
+func TestExistsFilter(t *testing.T) {
+	event := ce.NewEvent()
+	event.SetID("testID")
+	event.SetSource("testSource")
+	event.SetExtension("priority", 5)
+	event.SetExtension("level", "3")
+	event.SetData(ce.ApplicationJSON, map[string]interface{}{
+		"str":    "strValue",
+		"number": 123,
+		"float":  1.5,
+		"numStr": "123",
+		"flag":   true,
+		"empty":  nil,
+		"tags":   []string{"a", "b"},
+		"items":  []map[string]interface{}{{"price": 10}, {"price": 30}},
+	})
+	Convey("exists filter nil", t, func() {
+		So(filter.NewExistsFilter(nil), ShouldBeNil)
+		So(filter.NewExistsFilter([]string{""}), ShouldBeNil)
+		So(filter.NewExistsFilter([]string{"data.["}), ShouldBeNil)
+	})
+	Convey("exists filter attribute", t, func() {
+		So(filter.NewExistsFilter([]string{"id", "priority"}).Filter(event), ShouldEqual, filter.PassFilter)
+		So(filter.NewExistsFilter([]string{"id", "unknown"}).Filter(event), ShouldEqual, filter.FailFilter)
+		So(filter.NewExistsFilter([]string{"subject"}).Filter(event), ShouldEqual, filter.FailFilter)
+	})
+	Convey("exists filter data", t, func() {
+		So(filter.NewExistsFilter([]string{"data"}).Filter(event), ShouldEqual, filter.PassFilter)
+		So(filter.NewExistsFilter([]string{"data.str", "data.empty"}).Filter(event), ShouldEqual, filter.PassFilter)
+		So(filter.NewExistsFilter([]string{"data.items[1].price"}).Filter(event), ShouldEqual, filter.PassFilter)
+		So(filter.NewExistsFilter([]string{"data.items[2].price"}).Filter(event), ShouldEqual, filter.FailFilter)
+		So(filter.NewExistsFilter([]string{"data.unknown"}).Filter(event), ShouldEqual, filter.FailFilter)
+		So(filter.NewExistsFilter([]string{"data"}).Filter(ce.NewEvent()), ShouldEqual, filter.FailFilter)
+	})
+}
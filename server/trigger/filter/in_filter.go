@@ -0,0 +1,93 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	ce "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/vanus-labs/vanus/pkg/observability/log"
+)
+
+type inCondition struct {
+	locator *valueLocator
+	values  []interface{}
+}
+
+type inFilter struct {
+	conditions []inCondition
+}
+
+// NewInFilter returns a filter which passes events whose values of all the keys are one of the
+// listed values. A JSON array value passes if any of its elements is listed, which allows to check
+// array membership.
+func NewInFilter(in map[string][]interface{}) Filter {
+	if len(in) == 0 {
+		return nil
+	}
+	conditions := make([]inCondition, 0, len(in))
+	for key, values := range in {
+		if len(values) == 0 {
+			log.Info().Str("key", key).Msg("new in filter but has no value")
+			return nil
+		}
+		l, err := newValueLocator(key)
+		if err != nil {
+			log.Info().Err(err).Str("key", key).Msg("new in filter but key is invalid")
+			return nil
+		}
+		conditions = append(conditions, inCondition{locator: l, values: values})
+	}
+	return &inFilter{conditions: conditions}
+}
+
+func (filter *inFilter) Filter(event ce.Event) Result {
+	data := newEventData(&event)
+	for i := range filter.conditions {
+		c := &filter.conditions[i]
+		if !c.match(event, data) {
+			return FailFilter
+		}
+	}
+	return PassFilter
+}
+
+func (c *inCondition) match(event ce.Event, data *eventData) bool {
+	values, loose := c.locator.lookup(event, data)
+	for _, value := range values {
+		if arr, ok := value.([]interface{}); ok {
+			for _, elem := range arr {
+				if c.contains(elem, loose) {
+					return true
+				}
+			}
+			continue
+		}
+		if c.contains(value, loose) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *inCondition) contains(value interface{}, loose bool) bool {
+	for _, expect := range c.values {
+		if valueEqual(value, expect, loose) {
+			return true
+		}
+	}
+	return false
+}
+
+var _ Filter = (*inFilter)(nil)
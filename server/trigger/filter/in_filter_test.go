@@ -0,0 +1,69 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter_test
+
+import (
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/vanus-labs/vanus/server/trigger/filter"
+)
+
+func TestInFilter(t *testing.T) {
+	event := ce.NewEvent()
+	event.SetID("testID")
+	event.SetSource("testSource")
+	event.SetExtension("priority", 5)
+	event.SetExtension("level", "3")
+	event.SetData(ce.ApplicationJSON, map[string]interface{}{
+		"str":    "strValue",
+		"number": 123,
+		"float":  1.5,
+		"numStr": "123",
+		"flag":   true,
+		"empty":  nil,
+		"tags":   []string{"a", "b"},
+		"items":  []map[string]interface{}{{"price": 10}, {"price": 30}},
+	})
+	newFilter := func(key string, values ...interface{}) filter.Filter {
+		return filter.NewInFilter(map[string][]interface{}{key: values})
+	}
+	Convey("in filter nil", t, func() {
+		So(filter.NewInFilter(nil), ShouldBeNil)
+		So(newFilter("data.str"), ShouldBeNil)
+		So(newFilter("", "value"), ShouldBeNil)
+	})
+	Convey("in filter data", t, func() {
+		So(newFilter("data.str", "other", "strValue").Filter(event), ShouldEqual, filter.PassFilter)
+		So(newFilter("data.number", float64(1), float64(123)).Filter(event), ShouldEqual, filter.PassFilter)
+		So(newFilter("data.flag", true).Filter(event), ShouldEqual, filter.PassFilter)
+		So(newFilter("data.empty", nil).Filter(event), ShouldEqual, filter.PassFilter)
+		So(newFilter("data.tags", "b", "c").Filter(event), ShouldEqual, filter.PassFilter)
+		So(newFilter("data.tags", "c").Filter(event), ShouldEqual, filter.FailFilter)
+		Convey("in filter data type mismatch", func() {
+			So(newFilter("data.number", "123").Filter(event), ShouldEqual, filter.FailFilter)
+			So(newFilter("data.numStr", float64(123)).Filter(event), ShouldEqual, filter.FailFilter)
+			So(newFilter("data.flag", "true").Filter(event), ShouldEqual, filter.FailFilter)
+			So(newFilter("data.unknown", nil).Filter(event), ShouldEqual, filter.FailFilter)
+		})
+	})
+	Convey("in filter attribute", t, func() {
+		So(newFilter("source", "testSource").Filter(event), ShouldEqual, filter.PassFilter)
+		So(newFilter("priority", float64(1), float64(5)).Filter(event), ShouldEqual, filter.PassFilter)
+		So(newFilter("level", "1", "2").Filter(event), ShouldEqual, filter.FailFilter)
+	})
+}
@@ -0,0 +1,82 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	ce "github.com/cloudevents/sdk-go/v2"
+
+	primitive "github.com/vanus-labs/vanus/pkg"
+	"github.com/vanus-labs/vanus/pkg/observability/log"
+)
+
+type rangeCondition struct {
+	locator *valueLocator
+	bounds  primitive.RangeCondition
+}
+
+func (c *rangeCondition) contains(v float64) bool {
+	b := &c.bounds
+	return (b.Gt == nil || v > *b.Gt) && (b.Gte == nil || v >= *b.Gte) &&
+		(b.Lt == nil || v < *b.Lt) && (b.Lte == nil || v <= *b.Lte)
+}
+
+type rangeFilter struct {
+	conditions []rangeCondition
+}
+
+// NewRangeFilter returns a filter which passes events whose values of all the keys are numbers
+// within the bounds, a key locating several values passes if any of them does.
+func NewRangeFilter(ranges map[string]*primitive.RangeCondition) Filter {
+	if len(ranges) == 0 {
+		return nil
+	}
+	conditions := make([]rangeCondition, 0, len(ranges))
+	for key, bounds := range ranges {
+		if bounds == nil || (bounds.Gt == nil && bounds.Gte == nil && bounds.Lt == nil && bounds.Lte == nil) {
+			log.Info().Str("key", key).Msg("new range filter but has no bound")
+			return nil
+		}
+		l, err := newValueLocator(key)
+		if err != nil {
+			log.Info().Err(err).Str("key", key).Msg("new range filter but key is invalid")
+			return nil
+		}
+		conditions = append(conditions, rangeCondition{locator: l, bounds: *bounds})
+	}
+	return &rangeFilter{conditions: conditions}
+}
+
+func (filter *rangeFilter) Filter(event ce.Event) Result {
+	data := newEventData(&event)
+	for i := range filter.conditions {
+		c := &filter.conditions[i]
+		if !c.match(event, data) {
+			return FailFilter
+		}
+	}
+	return PassFilter
+}
+
+func (c *rangeCondition) match(event ce.Event, data *eventData) bool {
+	values, loose := c.locator.lookup(event, data)
+	for _, value := range values {
+		if v, ok := toNumber(value, loose); ok && c.contains(v) {
+			return true
+		}
+	}
+	return false
+}
+
+var _ Filter = (*rangeFilter)(nil)
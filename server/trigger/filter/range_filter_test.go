@@ -0,0 +1,85 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter_test
+
+import (
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+
+	primitive "github.com/vanus-labs/vanus/pkg"
+	"github.com/vanus-labs/vanus/server/trigger/filter"
+)
+
+func TestRangeFilter(t *testing.T) {
+	event := ce.NewEvent()
+	event.SetID("testID")
+	event.SetSource("testSource")
+	event.SetExtension("priority", 5)
+	event.SetExtension("level", "3")
+	event.SetData(ce.ApplicationJSON, map[string]interface{}{
+		"str":    "strValue",
+		"number": 123,
+		"float":  1.5,
+		"numStr": "123",
+		"flag":   true,
+		"empty":  nil,
+		"tags":   []string{"a", "b"},
+		"items":  []map[string]interface{}{{"price": 10}, {"price": 30}},
+	})
+	bound := func(v float64) *float64 {
+		return &v
+	}
+	newFilter := func(key string, c primitive.RangeCondition) filter.Filter {
+		return filter.NewRangeFilter(map[string]*primitive.RangeCondition{key: &c})
+	}
+	Convey("range filter nil", t, func() {
+		So(filter.NewRangeFilter(nil), ShouldBeNil)
+		So(filter.NewRangeFilter(map[string]*primitive.RangeCondition{"data.number": nil}), ShouldBeNil)
+		So(newFilter("data.number", primitive.RangeCondition{}), ShouldBeNil)
+		So(newFilter("", primitive.RangeCondition{Gt: bound(0)}), ShouldBeNil)
+	})
+	Convey("range filter data", t, func() {
+		So(newFilter("data.number", primitive.RangeCondition{Gt: bound(100), Lte: bound(123)}).Filter(event),
+			ShouldEqual, filter.PassFilter)
+		So(newFilter("data.number", primitive.RangeCondition{Lt: bound(123)}).Filter(event),
+			ShouldEqual, filter.FailFilter)
+		So(newFilter("data.float", primitive.RangeCondition{Gte: bound(1.5), Lt: bound(2)}).Filter(event),
+			ShouldEqual, filter.PassFilter)
+		So(newFilter("data.items[*].price", primitive.RangeCondition{Gt: bound(20)}).Filter(event),
+			ShouldEqual, filter.PassFilter)
+		So(newFilter("data.items[*].price", primitive.RangeCondition{Gt: bound(30)}).Filter(event),
+			ShouldEqual, filter.FailFilter)
+		Convey("range filter data type mismatch", func() {
+			So(newFilter("data.numStr", primitive.RangeCondition{Gt: bound(0)}).Filter(event),
+				ShouldEqual, filter.FailFilter)
+			So(newFilter("data.unknown", primitive.RangeCondition{Gt: bound(0)}).Filter(event),
+				ShouldEqual, filter.FailFilter)
+		})
+	})
+	Convey("range filter attribute", t, func() {
+		So(newFilter("priority", primitive.RangeCondition{Gte: bound(5)}).Filter(event), ShouldEqual, filter.PassFilter)
+		So(newFilter("level", primitive.RangeCondition{Lt: bound(3)}).Filter(event), ShouldEqual, filter.FailFilter)
+		So(newFilter("source", primitive.RangeCondition{Gt: bound(0)}).Filter(event), ShouldEqual, filter.FailFilter)
+	})
+	Convey("range filter multiple keys", t, func() {
+		f := filter.NewRangeFilter(map[string]*primitive.RangeCondition{
+			"data.number": {Gt: bound(100)},
+			"priority":    {Lt: bound(5)},
+		})
+		So(f.Filter(event), ShouldEqual, filter.FailFilter)
+	})
+}
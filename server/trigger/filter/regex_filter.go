@@ -0,0 +1,82 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"regexp"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/vanus-labs/vanus/pkg/observability/log"
+)
+
+type regexCondition struct {
+	locator *valueLocator
+	regexp  *regexp.Regexp
+}
+
+type regexFilter struct {
+	conditions []regexCondition
+}
+
+// NewRegexFilter returns a filter which passes events whose values of all the keys are strings
+// matching the regular expressions.
+func NewRegexFilter(regex map[string]string) Filter {
+	if len(regex) == 0 {
+		return nil
+	}
+	conditions := make([]regexCondition, 0, len(regex))
+	for key, expr := range regex {
+		if expr == "" {
+			log.Info().Str("key", key).Msg("new regex filter but has empty expression")
+			return nil
+		}
+		l, err := newValueLocator(key)
+		if err != nil {
+			log.Info().Err(err).Str("key", key).Msg("new regex filter but key is invalid")
+			return nil
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			log.Info().Err(err).Str("expression", expr).Msg("compile regex expression error")
+			return nil
+		}
+		conditions = append(conditions, regexCondition{locator: l, regexp: re})
+	}
+	return &regexFilter{conditions: conditions}
+}
+
+func (filter *regexFilter) Filter(event ce.Event) Result {
+	data := newEventData(&event)
+	for i := range filter.conditions {
+		c := &filter.conditions[i]
+		if !c.match(event, data) {
+			return FailFilter
+		}
+	}
+	return PassFilter
+}
+
+func (c *regexCondition) match(event ce.Event, data *eventData) bool {
+	values, _ := c.locator.lookup(event, data)
+	for _, value := range values {
+		if s, ok := value.(string); ok && c.regexp.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+var _ Filter = (*regexFilter)(nil)
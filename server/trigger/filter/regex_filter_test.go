@@ -0,0 +1,61 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter_test
+
+import (
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/vanus-labs/vanus/server/trigger/filter"
+)
+
+func TestRegexFilter(t *testing.T) {
+	event := ce.NewEvent()
+	event.SetID("testID")
+	event.SetSource("testSource")
+	event.SetExtension("priority", 5)
+	event.SetExtension("level", "3")
+	event.SetData(ce.ApplicationJSON, map[string]interface{}{
+		"str":    "strValue",
+		"number": 123,
+		"float":  1.5,
+		"numStr": "123",
+		"flag":   true,
+		"empty":  nil,
+		"tags":   []string{"a", "b"},
+		"items":  []map[string]interface{}{{"price": 10}, {"price": 30}},
+	})
+	newFilter := func(key, expr string) filter.Filter {
+		return filter.NewRegexFilter(map[string]string{key: expr})
+	}
+	Convey("regex filter nil", t, func() {
+		So(filter.NewRegexFilter(nil), ShouldBeNil)
+		So(newFilter("data.str", ""), ShouldBeNil)
+		So(newFilter("", "^str"), ShouldBeNil)
+		So(newFilter("data.str", "[a-"), ShouldBeNil)
+	})
+	Convey("regex filter data", t, func() {
+		So(newFilter("data.str", "^str.*e$").Filter(event), ShouldEqual, filter.PassFilter)
+		So(newFilter("data.str", "^value").Filter(event), ShouldEqual, filter.FailFilter)
+		So(newFilter("data.tags[*]", "^b$").Filter(event), ShouldEqual, filter.PassFilter)
+		So(newFilter("data.number", "123").Filter(event), ShouldEqual, filter.FailFilter)
+	})
+	Convey("regex filter attribute", t, func() {
+		So(newFilter("source", "Source$").Filter(event), ShouldEqual, filter.PassFilter)
+		So(newFilter("id", "^\\d+$").Filter(event), ShouldEqual, filter.FailFilter)
+	})
+}
@@ -47,6 +47,18 @@ func extractFilter(subscriptionFilter *primitive.SubscriptionFilter) Filter {
 	if len(subscriptionFilter.Any) > 0 {
 		return NewAnyFilter(extractFilters(subscriptionFilter.Any)...)
 	}
+	if len(subscriptionFilter.Range) > 0 {
+		return NewRangeFilter(subscriptionFilter.Range)
+	}
+	if len(subscriptionFilter.Exists) > 0 {
+		return NewExistsFilter(subscriptionFilter.Exists)
+	}
+	if len(subscriptionFilter.In) > 0 {
+		return NewInFilter(subscriptionFilter.In)
+	}
+	if len(subscriptionFilter.Regex) > 0 {
+		return NewRegexFilter(subscriptionFilter.Regex)
+	}
 	return nil
 }
 
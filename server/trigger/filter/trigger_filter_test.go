@@ -98,6 +98,22 @@ func TestGetFilter(t *testing.T) {
 			},
 		},
 	})
+	gt := float64(5)
+	filters = append(filters, &primitive.SubscriptionFilter{
+		Range: map[string]*primitive.RangeCondition{
+			"data.num": {Gt: &gt},
+		},
+	}, &primitive.SubscriptionFilter{
+		Exists: []string{"data.key"},
+	}, &primitive.SubscriptionFilter{
+		In: map[string][]interface{}{
+			"data.key": {"value"},
+		},
+	}, &primitive.SubscriptionFilter{
+		Regex: map[string]string{
+			"source": "^test",
+		},
+	})
 	Convey("trigger filter multi filter", t, func() {
 		f := filter.GetFilter(filters)
 		So(f, ShouldNotBeNil)
@@ -0,0 +1,185 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+
+	jp "github.com/vanus-labs/vanus/lib/json/path"
+	putil "github.com/vanus-labs/vanus/pkg"
+	"github.com/vanus-labs/vanus/pkg/observability/log"
+	"github.com/vanus-labs/vanus/server/trigger/util"
+)
+
+var errEmptyKey = errors.New("filter key is empty")
+
+// valueLocator locates the values of a typed filter key, which is an event attribute,
+// `data` for the whole data or `data.<json path>` for the fields of a JSON data.
+type valueLocator struct {
+	key       string
+	attribute string
+	path      jp.Path
+}
+
+func newValueLocator(key string) (*valueLocator, error) {
+	switch {
+	case key == "":
+		return nil, errEmptyKey
+	case key == "data":
+		return &valueLocator{key: key}, nil
+	case strings.HasPrefix(key, "data."):
+		path, err := jp.Parse("$." + key[5:])
+		if err != nil {
+			return nil, err
+		}
+		return &valueLocator{key: key, path: path}, nil
+	default:
+		return &valueLocator{key: key, attribute: key}, nil
+	}
+}
+
+func newValueLocators(keys []string) []*valueLocator {
+	locators := make([]*valueLocator, 0, len(keys))
+	for _, key := range keys {
+		l, err := newValueLocator(key)
+		if err != nil {
+			log.Info().Err(err).Str("key", key).Msg("new filter but key is invalid")
+			return nil
+		}
+		locators = append(locators, l)
+	}
+	return locators
+}
+
+// ValidateKey checks whether the key of a typed filter can locate values of an event.
+func ValidateKey(key string) error {
+	_, err := newValueLocator(key)
+	return err
+}
+
+// lookup returns the values located in the event. Attribute values are always strings, so they are
+// loose and may be compared as numbers or booleans, while JSON values of the data keep their types.
+func (l *valueLocator) lookup(event ce.Event, data *eventData) (values []interface{}, loose bool) {
+	if l.attribute != "" {
+		value, ok := util.LookupAttribute(event, l.attribute)
+		if !ok {
+			return nil, true
+		}
+		str, err := attrValue2String(value)
+		if err != nil {
+			log.Info().Str("attr", l.attribute).Err(err).Msg("filter attr value to string failed")
+			return nil, true
+		}
+		if str == "" { // optional context attributes are looked up as empty strings when absent
+			return nil, true
+		}
+		return []interface{}{str}, true
+	}
+	if len(event.Data()) == 0 {
+		return nil, false
+	}
+	obj, ok := data.get()
+	if l.path == nil {
+		if !ok {
+			return []interface{}{string(event.Data())}, true
+		}
+		return []interface{}{obj}, false
+	}
+	if !ok {
+		return nil, false
+	}
+	return l.path.Get(obj), false
+}
+
+// eventData parses the JSON data of an event at most once for all keys of a filter.
+type eventData struct {
+	event  *ce.Event
+	parsed bool
+	value  interface{}
+	ok     bool
+}
+
+func newEventData(event *ce.Event) *eventData {
+	return &eventData{event: event}
+}
+
+func (d *eventData) get() (interface{}, bool) {
+	if !d.parsed {
+		d.parsed = true
+		value, err := putil.ParseJSON(d.event.Data())
+		if err != nil {
+			log.Debug().Err(err).Msg("filter parse data error")
+		} else {
+			d.value, d.ok = value, true
+		}
+	}
+	return d.value, d.ok
+}
+
+func toNumber(value interface{}, loose bool) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	case string:
+		if !loose {
+			return 0, false
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+// valueEqual compares a located value with an expected JSON scalar.
+func valueEqual(value, expect interface{}, loose bool) bool {
+	switch e := expect.(type) {
+	case nil:
+		return value == nil
+	case string:
+		v, ok := value.(string)
+		return ok && v == e
+	case bool:
+		if s, ok := value.(string); ok && loose {
+			b, err := strconv.ParseBool(s)
+			return err == nil && b == e
+		}
+		v, ok := value.(bool)
+		return ok && v == e
+	default:
+		f, ok := toNumber(expect, false)
+		if !ok {
+			return false
+		}
+		v, ok := toNumber(value, loose)
+		return ok && v == f
+	}
+}
@@ -21,6 +21,8 @@ import (
 )
 
 type EventRecord struct {
+	// Event is nil if the record only reports that the events up to the offset are skipped by the
+	// filter of reading.
 	Event *ce.Event
 	info.OffsetInfo
 }
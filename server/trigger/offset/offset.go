@@ -86,6 +86,29 @@ func (offset *SubscriptionOffset) EventCommit(info info.OffsetInfo) {
 	tracker.commitOffset(info.Offset)
 }
 
+// EventSkip advances the offset of the eventlog past the events up to info.Offset, which are skipped
+// without being received, e.g. filtered by segment servers.
+func (offset *SubscriptionOffset) EventSkip(info info.OffsetInfo) {
+	offset.cond.L.Lock()
+	defer offset.cond.L.Unlock()
+	if offset.closed {
+		return
+	}
+	tracker, exist := offset.elOffsets[info.EventlogID]
+	if !exist {
+		offset.elOffsets[info.EventlogID] = initOffset(info.Offset + 1)
+		return
+	}
+	tracker.skipOffset(info.Offset)
+}
+
+// UnACKNumber returns the number of received events which are not committed yet.
+func (offset *SubscriptionOffset) UnACKNumber() int {
+	offset.cond.L.Lock()
+	defer offset.cond.L.Unlock()
+	return offset.uACKNumber
+}
+
 func (offset *SubscriptionOffset) GetCommit() info.ListOffsetInfo {
 	offset.cond.L.Lock()
 	defer offset.cond.L.Unlock()
@@ -127,6 +150,12 @@ func (o *offsetTracker) putOffset(offset uint64) {
 	}
 }
 
+func (o *offsetTracker) skipOffset(offset uint64) {
+	if int64(offset) > o.maxOffset {
+		o.maxOffset = int64(offset)
+	}
+}
+
 func (o *offsetTracker) commitOffset(offset uint64) {
 	o.list.Remove(offset)
 }
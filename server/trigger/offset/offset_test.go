@@ -94,6 +94,23 @@ func TestSubscriptionOffset(t *testing.T) {
 			commits = subOffset.GetCommit()
 			So(1, ShouldEqual, len(commits))
 		})
+		Convey("skip events", func() {
+			subOffset := NewSubscriptionOffset(snowflake.NewTestID(), 100, info.ListOffsetInfo{})
+			subOffset.EventSkip(info.OffsetInfo{EventlogID: eventlogID, Offset: 9})
+			commits := subOffset.GetCommit()
+			So(commits, ShouldHaveLength, 1)
+			So(commits[0].Offset, ShouldEqual, 10)
+
+			subOffset.EventReceive(info.OffsetInfo{EventlogID: eventlogID, Offset: 10})
+			subOffset.EventSkip(info.OffsetInfo{EventlogID: eventlogID, Offset: 19})
+			commits = subOffset.GetCommit()
+			So(commits[0].Offset, ShouldEqual, 10)
+
+			subOffset.EventCommit(info.OffsetInfo{EventlogID: eventlogID, Offset: 10})
+			commits = subOffset.GetCommit()
+			So(commits[0].Offset, ShouldEqual, 20)
+			So(subOffset.UnACKNumber(), ShouldEqual, 0)
+		})
 	})
 }
 
@@ -12,6 +12,7 @@ package reader
 import (
 	reflect "reflect"
 
+	vsr "github.com/vanus-labs/vanus/api/vsr"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -50,6 +51,44 @@ func (mr *MockReaderMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockReader)(nil).Close))
 }
 
+// Eventlogs mocks base method.
+func (m *MockReader) Eventlogs() []vsr.ID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Eventlogs")
+	ret0, _ := ret[0].([]vsr.ID)
+	return ret0
+}
+
+// Eventlogs indicates an expected call of Eventlogs.
+func (mr *MockReaderMockRecorder) Eventlogs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eventlogs", reflect.TypeOf((*MockReader)(nil).Eventlogs))
+}
+
+// Pause mocks base method.
+func (m *MockReader) Pause(eventlogID vsr.ID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Pause", eventlogID)
+}
+
+// Pause indicates an expected call of Pause.
+func (mr *MockReaderMockRecorder) Pause(eventlogID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockReader)(nil).Pause), eventlogID)
+}
+
+// Resume mocks base method.
+func (m *MockReader) Resume(eventlogID vsr.ID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Resume", eventlogID)
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockReaderMockRecorder) Resume(eventlogID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockReader)(nil).Resume), eventlogID)
+}
+
 // Start mocks base method.
 func (m *MockReader) Start() error {
 	m.ctrl.T.Helper()
@@ -19,6 +19,7 @@ import (
 	"context"
 	"encoding/binary"
 	stderr "errors"
+	"sort"
 	"sync"
 	"time"
 
@@ -27,6 +28,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/vanus-labs/vanus/api/errors"
+	segpb "github.com/vanus-labs/vanus/api/segment"
 	vanus "github.com/vanus-labs/vanus/api/vsr"
 	eb "github.com/vanus-labs/vanus/client"
 	"github.com/vanus-labs/vanus/client/pkg/api"
@@ -56,12 +58,24 @@ type Config struct {
 	EventbusIDStr     string
 	Offset            EventlogOffset
 	BatchSize         int
+	// ShardNum is the number of shards the eventlogs are split into.
+	ShardNum uint32
+	// Shards are the shards to read, all shards if it is empty.
+	Shards []uint32
+	// ReadFilter returns the filter evaluated by segment servers, it is called before every read
+	// since the filter of subscription may be changed.
+	ReadFilter func() *segpb.AttributeFilter
 }
 type EventlogOffset map[vanus.ID]uint64
 
 type Reader interface {
 	Start() error
 	Close()
+	// Pause stops reading events from the eventlog until Resume is called.
+	Pause(eventlogID vanus.ID)
+	Resume(eventlogID vanus.ID)
+	// Eventlogs returns the eventlogs being read.
+	Eventlogs() []vanus.ID
 }
 
 type reader struct {
@@ -69,7 +83,10 @@ type reader struct {
 	events      chan<- info.EventRecord
 	stop        context.CancelFunc
 	wg          sync.WaitGroup
+	mu          sync.RWMutex
 	eventlogMap map[uint64]*eventlogReader
+	// hub is not nil if the reader is created by a Hub.
+	hub *hub
 }
 
 func NewReader(config Config, events chan<- info.EventRecord) Reader {
@@ -94,6 +111,50 @@ func (r *reader) Close() {
 		Msg("reader closed")
 }
 
+func (r *reader) Pause(eventlogID vanus.ID) {
+	if elReader := r.getEventlogReader(eventlogID.Uint64()); elReader != nil {
+		elReader.pause()
+	}
+}
+
+func (r *reader) Resume(eventlogID vanus.ID) {
+	if elReader := r.getEventlogReader(eventlogID.Uint64()); elReader != nil {
+		elReader.resume()
+	}
+}
+
+func (r *reader) Eventlogs() []vanus.ID {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ids := make([]vanus.ID, 0, len(r.eventlogMap))
+	for id := range r.eventlogMap {
+		ids = append(ids, vanus.NewIDFromUint64(id))
+	}
+	return ids
+}
+
+// isShardEventlog reports whether the eventlog at index idx of eventlogs sorted by ID
+// belongs to the shards to read. Eventlogs added by scaling have greater IDs, so the
+// existing eventlogs keep their shards.
+func (r *reader) isShardEventlog(idx int) bool {
+	if r.config.ShardNum <= 1 || len(r.config.Shards) == 0 {
+		return true
+	}
+	shard := util.EventlogShard(idx, r.config.ShardNum)
+	for _, s := range r.config.Shards {
+		if s == shard {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *reader) getEventlogReader(id uint64) *eventlogReader {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.eventlogMap[id]
+}
+
 func (r *reader) findEventlog(ctx context.Context) error {
 	timeoutCtx, cancel := context.WithTimeout(ctx, lookupReadableLogsTimeout)
 	defer cancel()
@@ -105,10 +166,18 @@ func (r *reader) findEventlog(ctx context.Context) error {
 			Msg("eventbus lookup Readable eventlog error")
 		return err
 	}
+	sort.Slice(logs, func(i, j int) bool {
+		return logs[i].ID() < logs[j].ID()
+	})
 	logsMap := make(map[uint64]api.Eventlog, len(logs))
 	for i := range logs {
+		if !r.isShardEventlog(i) {
+			continue
+		}
 		logsMap[logs[i].ID()] = logs[i]
 	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	for id, l := range logsMap {
 		if _, exist := r.eventlogMap[id]; exist {
 			continue
@@ -161,7 +230,7 @@ func (r *reader) Start() error {
 
 func (r *reader) startEventlog(ctx context.Context, l api.Eventlog) {
 	eventlogID := vanus.NewIDFromUint64(l.ID())
-	offset := r.getOffset(eventlogID)
+	offset := r.getOffset(ctx, l)
 	elc := &eventlogReader{
 		config:        r.config,
 		eventlogID:    eventlogID,
@@ -170,11 +239,21 @@ func (r *reader) startEventlog(ctx context.Context, l api.Eventlog) {
 		events:        r.events,
 		offset:        offset,
 	}
+	if r.hub != nil {
+		elc.cursor = r.hub.acquire(r.config, l)
+	}
 	r.eventlogMap[l.ID()] = elc
 	r.wg.Add(1)
 	go func() {
 		defer r.wg.Done()
-		defer delete(r.eventlogMap, l.ID())
+		if elc.cursor != nil {
+			defer r.hub.release(elc.cursor)
+		}
+		defer func() {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			delete(r.eventlogMap, l.ID())
+		}()
 		log.Info().
 			Str(log.KeySubscriptionID, r.config.SubscriptionIDStr).
 			Str(log.KeyEventbusID, r.config.EventbusIDStr).
@@ -191,17 +270,33 @@ func (r *reader) startEventlog(ctx context.Context, l api.Eventlog) {
 	}()
 }
 
-func (r *reader) getOffset(eventlogID vanus.ID) uint64 {
+// getOffset returns the offset to start reading the eventlog. An eventlog without offset is
+// added after the subscription created, e.g. the eventbus has been scaled, so it should be read
+// from the earliest.
+func (r *reader) getOffset(ctx context.Context, l api.Eventlog) uint64 {
+	eventlogID := vanus.NewIDFromUint64(l.ID())
 	v, exist := r.config.Offset[eventlogID]
 	if exist {
 		return v
 	}
-	log.Warn().
+	timeoutCtx, cancel := context.WithTimeout(ctx, lookupReadableLogsTimeout)
+	defer cancel()
+	earliest, err := l.EarliestOffset(timeoutCtx)
+	if err != nil || earliest < 0 {
+		log.Warn().Err(err).
+			Str(log.KeySubscriptionID, r.config.SubscriptionIDStr).
+			Str(log.KeyEventbusID, r.config.EventbusIDStr).
+			Str(log.KeyEventlogID, eventlogID.Key()).
+			Msg("offset no exist and get earliest offset failed, will use 0")
+		return 0
+	}
+	log.Info().
 		Str(log.KeySubscriptionID, r.config.SubscriptionIDStr).
 		Str(log.KeyEventbusID, r.config.EventbusIDStr).
 		Str(log.KeyEventlogID, eventlogID.Key()).
-		Msg("offset no exist, will use 0")
-	return 0
+		Int64("offset", earliest).
+		Msg("offset no exist, will use earliest offset")
+	return uint64(earliest)
 }
 
 type eventlogReader struct {
@@ -212,6 +307,12 @@ type eventlogReader struct {
 	events        chan<- info.EventRecord
 	offset        uint64
 	cancel        context.CancelFunc
+	// cursor is in the shared stream of the eventlog, it is nil if the eventlog is read privately.
+	cursor *cursor
+
+	pauseMu sync.Mutex
+	// resumeCh is not nil while the reader is paused, and it is closed when resumed.
+	resumeCh chan struct{}
 }
 
 func (elReader *eventlogReader) stop() {
@@ -221,6 +322,38 @@ func (elReader *eventlogReader) stop() {
 	elReader.cancel()
 }
 
+func (elReader *eventlogReader) pause() {
+	elReader.pauseMu.Lock()
+	defer elReader.pauseMu.Unlock()
+	if elReader.resumeCh == nil {
+		elReader.resumeCh = make(chan struct{})
+	}
+}
+
+func (elReader *eventlogReader) resume() {
+	elReader.pauseMu.Lock()
+	defer elReader.pauseMu.Unlock()
+	if elReader.resumeCh != nil {
+		close(elReader.resumeCh)
+		elReader.resumeCh = nil
+	}
+}
+
+func (elReader *eventlogReader) waitResume(ctx context.Context) error {
+	elReader.pauseMu.Lock()
+	ch := elReader.resumeCh
+	elReader.pauseMu.Unlock()
+	if ch == nil {
+		return nil
+	}
+	select {
+	case <-ch:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // getOffset get earliest offset.
 func (elReader *eventlogReader) getOffset(ctx context.Context) (int64, error) {
 	logs, err := elReader.config.Client.Eventbus(ctx,
@@ -260,6 +393,9 @@ func (elReader *eventlogReader) run(parentCtx context.Context) {
 			return
 		default:
 		}
+		if err := elReader.waitResume(ctx); err != nil {
+			return
+		}
 		err := elReader.loop(ctx, r)
 		currMin := time.Now().Minute() / logFrequencyMini
 		if currMin != min {
@@ -311,10 +447,11 @@ func (elReader *eventlogReader) run(parentCtx context.Context) {
 }
 
 func (elReader *eventlogReader) loop(ctx context.Context, lr api.BusReader) error {
-	events, err := readEvents(ctx, lr)
+	events, next, err := elReader.readEvents(ctx, lr)
 	if err != nil {
 		return err
 	}
+	last := elReader.policy.Offset() - 1
 	for i := range events {
 		ec, _ := events[i].Context.(*ce.EventContextV1)
 		offsetByte, _ := ec.Extensions[eventlog.XVanusLogOffset].([]byte)
@@ -328,8 +465,21 @@ func (elReader *eventlogReader) loop(ctx context.Context, lr api.BusReader) erro
 			return err
 		}
 		elReader.offset = offset
+		last = int64(offset)
+	}
+	// Report the events skipped by the read filter after the last event, so that their offsets can be
+	// committed even if no more event matches.
+	if next-1 > last {
+		eo := info.EventRecord{OffsetInfo: pInfo.OffsetInfo{
+			EventlogID: elReader.eventlogID,
+			Offset:     uint64(next) - 1,
+		}}
+		if err = elReader.putEvent(ctx, eo); err != nil {
+			return err
+		}
+		elReader.offset = eo.Offset
 	}
-	elReader.policy.Forward(len(events))
+	elReader.policy.Forward(int(next - elReader.policy.Offset()))
 	metrics.TriggerPullEventCounter.WithLabelValues(
 		elReader.config.SubscriptionIDStr, elReader.config.EventbusID.Key(), elReader.eventlogIDStr).
 		Add(float64(len(events)))
@@ -345,9 +495,19 @@ func (elReader *eventlogReader) putEvent(ctx context.Context, event info.EventRe
 	}
 }
 
-func readEvents(ctx context.Context, lr api.BusReader) ([]*ce.Event, error) {
+func (elReader *eventlogReader) readEvents(ctx context.Context, lr api.BusReader) ([]*ce.Event, int64, error) {
 	timeout, cancel := context.WithTimeout(ctx, readEventTimeout)
 	defer cancel()
-	events, _, _, err := api.Read(timeout, lr)
-	return events, err
+	if elReader.cursor != nil {
+		events, next, err := elReader.cursor.read(timeout, elReader.policy.Offset())
+		if !stderr.Is(err, errOutOfWindow) {
+			return events, next, err
+		}
+	}
+	var opts []api.ReadOption
+	if elReader.config.ReadFilter != nil {
+		opts = append(opts, option.WithReadFilter(elReader.config.ReadFilter()))
+	}
+	events, next, _, err := api.Scan(timeout, lr, opts...)
+	return events, next, err
 }
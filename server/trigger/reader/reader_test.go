@@ -27,9 +27,12 @@ import (
 	. "go.uber.org/mock/gomock"
 
 	"github.com/vanus-labs/vanus/api/cloudevents"
+	segpb "github.com/vanus-labs/vanus/api/segment"
+	vanus "github.com/vanus-labs/vanus/api/vsr"
 	"github.com/vanus-labs/vanus/client"
 	"github.com/vanus-labs/vanus/client/pkg/api"
 	"github.com/vanus-labs/vanus/client/pkg/eventlog"
+	"github.com/vanus-labs/vanus/client/pkg/policy"
 
 	"github.com/vanus-labs/vanus/pkg/snowflake"
 	"github.com/vanus-labs/vanus/server/trigger/info"
@@ -55,7 +58,7 @@ func TestReaderStart(t *testing.T) {
 		index := uint64(offset)
 		mockEventlog.EXPECT().LatestOffset(Any()).AnyTimes().Return(offset, nil)
 		mockEventlog.EXPECT().EarliestOffset(Any()).AnyTimes().Return(offset, nil)
-		mockBusReader.EXPECT().Read(Any()).AnyTimes().DoAndReturn(
+		mockBusReader.EXPECT().Scan(Any()).AnyTimes().DoAndReturn(
 			func(ctx context.Context, opts ...api.ReadOption) (*cloudevents.CloudEventBatch, int64, uint64, error) {
 				time.Sleep(time.Millisecond)
 				e := ce.NewEvent()
@@ -67,7 +70,7 @@ func TestReaderStart(t *testing.T) {
 				epb, _ := cloudevents.ToProto(&e)
 				return &cloudevents.CloudEventBatch{
 					Events: []*cloudevents.CloudEvent{epb},
-				}, int64(0), uint64(0), nil
+				}, int64(index), uint64(0), nil
 				// return []*ce.Event{&e}, int64(0), uint64(0), nil
 			})
 		eventCh := make(chan info.EventRecord, 100)
@@ -88,3 +91,152 @@ func TestReaderStart(t *testing.T) {
 		r.Close()
 	})
 }
+
+func TestReaderFindNewEventlog(t *testing.T) {
+	mockCtrl := NewController(t)
+	defer mockCtrl.Finish()
+	mockClient := client.NewMockClient(mockCtrl)
+	mockEventbus := api.NewMockEventbus(mockCtrl)
+	mockBusReader := api.NewMockBusReader(mockCtrl)
+	mockClient.EXPECT().Eventbus(Any(), Any()).AnyTimes().Return(mockEventbus)
+	mockEventbus.EXPECT().Reader(Any(), Any()).AnyTimes().Return(mockBusReader)
+	mockBusReader.EXPECT().Scan(Any()).AnyTimes().DoAndReturn(
+		func(ctx context.Context, opts ...api.ReadOption) (*cloudevents.CloudEventBatch, int64, uint64, error) {
+			<-ctx.Done()
+			return nil, 0, 0, ctx.Err()
+		})
+
+	Convey("test find new eventlog after eventbus scaled", t, func() {
+		log1 := api.NewMockEventlog(mockCtrl)
+		log1.EXPECT().ID().AnyTimes().Return(uint64(1))
+		log2 := api.NewMockEventlog(mockCtrl)
+		log2.EXPECT().ID().AnyTimes().Return(uint64(2))
+		log2.EXPECT().EarliestOffset(Any()).Times(1).Return(int64(10), nil)
+
+		r := NewReader(Config{
+			EventbusID: snowflake.NewTestID(),
+			BatchSize:  1,
+			Offset:     EventlogOffset{vanus.NewIDFromUint64(1): 100},
+		}, make(chan info.EventRecord, 1)).(*reader)
+		r.config.Client = mockClient
+		mockEventbus.EXPECT().ListLog(Any()).Times(1).Return([]api.Eventlog{log1}, nil)
+		So(r.Start(), ShouldBeNil)
+		So(r.getEventlogReader(1).offset, ShouldEqual, 100)
+		So(r.getEventlogReader(2), ShouldBeNil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		mockEventbus.EXPECT().ListLog(Any()).Times(1).Return([]api.Eventlog{log1, log2}, nil)
+		So(r.findEventlog(ctx), ShouldBeNil)
+		So(r.getEventlogReader(1).offset, ShouldEqual, 100)
+		So(r.getEventlogReader(2).offset, ShouldEqual, 10)
+		cancel()
+		r.Close()
+	})
+}
+
+func TestReaderShards(t *testing.T) {
+	mockCtrl := NewController(t)
+	defer mockCtrl.Finish()
+	mockClient := client.NewMockClient(mockCtrl)
+	mockEventbus := api.NewMockEventbus(mockCtrl)
+	mockBusReader := api.NewMockBusReader(mockCtrl)
+	mockClient.EXPECT().Eventbus(Any(), Any()).AnyTimes().Return(mockEventbus)
+	mockEventbus.EXPECT().Reader(Any(), Any()).AnyTimes().Return(mockBusReader)
+	mockBusReader.EXPECT().Scan(Any()).AnyTimes().DoAndReturn(
+		func(ctx context.Context, opts ...api.ReadOption) (*cloudevents.CloudEventBatch, int64, uint64, error) {
+			<-ctx.Done()
+			return nil, 0, 0, ctx.Err()
+		})
+
+	Convey("test read eventlogs of shards", t, func() {
+		var logs []api.Eventlog
+		offset := EventlogOffset{}
+		for i := 4; i > 0; i-- {
+			l := api.NewMockEventlog(mockCtrl)
+			l.EXPECT().ID().AnyTimes().Return(uint64(i))
+			logs = append(logs, l)
+			offset[vanus.NewIDFromUint64(uint64(i))] = 0
+		}
+		mockEventbus.EXPECT().ListLog(Any()).AnyTimes().Return(logs, nil)
+
+		r := NewReader(Config{
+			EventbusID: snowflake.NewTestID(),
+			BatchSize:  1,
+			Offset:     offset,
+			ShardNum:   3,
+			Shards:     []uint32{0, 2},
+		}, make(chan info.EventRecord, 1)).(*reader)
+		r.config.Client = mockClient
+		So(r.Start(), ShouldBeNil)
+		So(r.Eventlogs(), ShouldHaveLength, 3)
+		So(r.getEventlogReader(1), ShouldNotBeNil)
+		So(r.getEventlogReader(2), ShouldBeNil)
+		So(r.getEventlogReader(3), ShouldNotBeNil)
+		So(r.getEventlogReader(4), ShouldNotBeNil)
+		r.Close()
+	})
+}
+
+func TestReaderSkipFilteredEvents(t *testing.T) {
+	mockCtrl := NewController(t)
+	defer mockCtrl.Finish()
+	mockEventlog := api.NewMockEventlog(mockCtrl)
+	mockBusReader := api.NewMockBusReader(mockCtrl)
+
+	Convey("test report the events skipped by read filter", t, func() {
+		filter := &segpb.AttributeFilter{Exact: map[string]string{"type": "order.created"}}
+		events := make(chan info.EventRecord, 10)
+		eventlogID := vanus.NewIDFromUint64(1)
+		elReader := &eventlogReader{
+			config: Config{ReadFilter: func() *segpb.AttributeFilter {
+				return filter
+			}},
+			eventlogID: eventlogID,
+			policy:     policy.NewManuallyReadPolicy(mockEventlog, 0),
+			events:     events,
+		}
+		scan := func(offset uint64, next int64) {
+			mockBusReader.EXPECT().Scan(Any(), Any()).Times(1).DoAndReturn(
+				func(ctx context.Context, opts ...api.ReadOption) (*cloudevents.CloudEventBatch, int64, uint64, error) {
+					readOpts := &api.ReadOptions{}
+					readOpts.Apply(opts...)
+					So(readOpts.Filter, ShouldEqual, filter)
+					batch := &cloudevents.CloudEventBatch{}
+					if offset < uint64(next) {
+						e := ce.NewEvent()
+						buf := make([]byte, 8)
+						binary.BigEndian.PutUint64(buf, offset)
+						e.SetExtension(eventlog.XVanusLogOffset, buf)
+						epb, _ := cloudevents.ToProto(&e)
+						batch.Events = append(batch.Events, epb)
+					}
+					return batch, next, uint64(1), nil
+				})
+		}
+
+		scan(5, 10)
+		So(elReader.loop(context.Background(), mockBusReader), ShouldBeNil)
+		So(events, ShouldHaveLength, 2)
+		record := <-events
+		So(record.Event, ShouldNotBeNil)
+		So(record.Offset, ShouldEqual, 5)
+		record = <-events
+		So(record.Event, ShouldBeNil)
+		So(record.EventlogID, ShouldEqual, eventlogID)
+		So(record.Offset, ShouldEqual, 9)
+		So(elReader.policy.Offset(), ShouldEqual, 10)
+
+		scan(20, 20)
+		So(elReader.loop(context.Background(), mockBusReader), ShouldBeNil)
+		So(events, ShouldHaveLength, 1)
+		record = <-events
+		So(record.Event, ShouldBeNil)
+		So(record.Offset, ShouldEqual, 19)
+		So(elReader.policy.Offset(), ShouldEqual, 20)
+
+		scan(20, 20)
+		So(elReader.loop(context.Background(), mockBusReader), ShouldBeNil)
+		So(events, ShouldBeEmpty)
+		So(elReader.policy.Offset(), ShouldEqual, 20)
+	})
+}
@@ -0,0 +1,258 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reader
+
+import (
+	"context"
+	stderr "errors"
+	"sync"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+
+	cepb "github.com/vanus-labs/vanus/api/cloudevents"
+	"github.com/vanus-labs/vanus/client/pkg/api"
+	"github.com/vanus-labs/vanus/client/pkg/option"
+	"github.com/vanus-labs/vanus/client/pkg/policy"
+	"github.com/vanus-labs/vanus/server/trigger/info"
+)
+
+const defaultWindowSize = 10000
+
+// errOutOfWindow means the offset to read is before the window of the shared stream, the reader has
+// to read the eventlog by itself.
+var errOutOfWindow = stderr.New("offset out of window")
+
+// Hub shares the reading of eventlogs among the readers created by it, so that the events of an
+// eventlog are read once for all subscriptions on the eventbus. Each reader keeps its own offset,
+// it reads the recent events kept in the window of the shared stream, and reads the eventlog by
+// itself when it lags behind the window, or runs ahead of a window used by other readers, until it
+// gets into the window again.
+type Hub interface {
+	NewReader(config Config, events chan<- info.EventRecord) Reader
+}
+
+// NewHub returns a Hub whose streams keep at most windowSize recent events of each eventlog.
+func NewHub(windowSize int) Hub {
+	if windowSize <= 0 {
+		windowSize = defaultWindowSize
+	}
+	return &hub{
+		windowSize: windowSize,
+		streams:    map[uint64]*stream{},
+	}
+}
+
+type hub struct {
+	windowSize int
+	mu         sync.Mutex
+	streams    map[uint64]*stream
+}
+
+func (h *hub) NewReader(config Config, events chan<- info.EventRecord) Reader {
+	r, _ := NewReader(config, events).(*reader)
+	r.hub = h
+	return r
+}
+
+// acquire returns a cursor of the shared stream of the eventlog, the stream is created by the first cursor.
+func (h *hub) acquire(config Config, l api.Eventlog) *cursor {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s, ok := h.streams[l.ID()]
+	if !ok {
+		p := policy.NewManuallyReadPolicy(l, 0)
+		s = &stream{
+			eventlogID: l.ID(),
+			policy:     p,
+			reader: config.Client.Eventbus(context.Background(), api.WithID(config.EventbusID.Uint64())).Reader(
+				option.WithReadPolicy(p), option.WithBatchSize(config.BatchSize)),
+			windowSize: h.windowSize,
+		}
+		h.streams[l.ID()] = s
+	}
+	return s.attach()
+}
+
+func (h *hub) release(c *cursor) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s := c.stream
+	if s.detach(c) == 0 {
+		delete(h.streams, s.eventlogID)
+	}
+}
+
+// cursor is the position of a reader in the shared stream.
+type cursor struct {
+	stream *stream
+	// offset is the offset the reader read last time, it is -1 before the first read.
+	offset int64
+}
+
+func (c *cursor) read(ctx context.Context, offset int64) ([]*ce.Event, int64, error) {
+	return c.stream.read(ctx, c, offset)
+}
+
+type streamBatch struct {
+	offset int64
+	events []*cepb.CloudEvent
+}
+
+// stream reads an eventlog on demand of the readers at its end, and keeps the events read in a window
+// of [start, end). Readers out of the window read the eventlog by themselves, so they can't slow down
+// or move away the window of the others.
+type stream struct {
+	eventlogID uint64
+	reader     api.BusReader
+	policy     api.ReadPolicy
+	windowSize int
+
+	mu      sync.Mutex
+	cursors map[*cursor]struct{}
+	batches []streamBatch
+	start   int64
+	end     int64
+	size    int
+	// reading is closed when the reading in progress is done, it is nil if no one is reading.
+	reading chan struct{}
+}
+
+func (s *stream) attach() *cursor {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cursors == nil {
+		s.cursors = map[*cursor]struct{}{}
+	}
+	c := &cursor{stream: s, offset: -1}
+	s.cursors[c] = struct{}{}
+	return c
+}
+
+// detach removes the cursor, and returns the number of the remaining cursors.
+func (s *stream) detach(c *cursor) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.cursors, c)
+	return len(s.cursors)
+}
+
+// inUse reports whether any cursor except c is in the window, s.mu must be held.
+func (s *stream) inUse(c *cursor) bool {
+	for other := range s.cursors {
+		if other != c && other.offset >= s.start && other.offset <= s.end {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *stream) read(ctx context.Context, c *cursor, offset int64) ([]*ce.Event, int64, error) {
+	for {
+		s.mu.Lock()
+		c.offset = offset
+		if offset >= s.start && offset < s.end {
+			events := s.eventsFrom(offset)
+			s.mu.Unlock()
+			return toEvents(events, offset)
+		}
+		if offset < s.start {
+			s.mu.Unlock()
+			return nil, 0, errOutOfWindow
+		}
+		if ch := s.reading; ch != nil {
+			s.mu.Unlock()
+			select {
+			case <-ch:
+				continue
+			case <-ctx.Done():
+				return nil, 0, ctx.Err()
+			}
+		}
+		if offset > s.end {
+			// The reader is ahead of the window, it reads the eventlog by itself until the window catches
+			// up, unless no other reader uses the window, which is moved forward to the reader then.
+			if s.inUse(c) {
+				s.mu.Unlock()
+				return nil, 0, errOutOfWindow
+			}
+			s.batches, s.size = nil, 0
+			s.start, s.end = offset, offset
+		}
+		ch := make(chan struct{})
+		s.reading = ch
+		s.mu.Unlock()
+
+		n, err := s.fetch(ctx, offset)
+		close(ch)
+		if err != nil {
+			return nil, 0, err
+		}
+		if n == 0 {
+			return nil, offset, nil
+		}
+	}
+}
+
+// fetch reads the eventlog from offset at the end of window, and returns the number of events read.
+func (s *stream) fetch(ctx context.Context, offset int64) (int, error) {
+	s.policy.Forward(int(offset - s.policy.Offset()))
+	batch, next, _, err := s.reader.Scan(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reading = nil
+	if err != nil {
+		return 0, err
+	}
+	n := len(batch.GetEvents())
+	if n == 0 {
+		return 0, nil
+	}
+	if next != offset+int64(n) {
+		// The events are not contiguous, which is unexpected without filter.
+		return 0, errOutOfWindow
+	}
+	s.batches = append(s.batches, streamBatch{offset: offset, events: batch.Events})
+	s.end = next
+	s.size += n
+	for len(s.batches) > 1 && s.size > s.windowSize {
+		s.size -= len(s.batches[0].events)
+		s.batches = s.batches[1:]
+		s.start = s.batches[0].offset
+	}
+	return n, nil
+}
+
+func (s *stream) eventsFrom(offset int64) []*cepb.CloudEvent {
+	for i := len(s.batches) - 1; i >= 0; i-- {
+		if b := s.batches[i]; offset >= b.offset {
+			return b.events[offset-b.offset:]
+		}
+	}
+	return nil
+}
+
+// toEvents converts the shared events to the events owned by the reader.
+func toEvents(events []*cepb.CloudEvent, offset int64) ([]*ce.Event, int64, error) {
+	es := make([]*ce.Event, len(events))
+	for i := range events {
+		e, err := cepb.FromProto(events[i])
+		if err != nil {
+			return nil, 0, err
+		}
+		es[i] = e
+	}
+	return es, offset + int64(len(events)), nil
+}
@@ -0,0 +1,231 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reader
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.uber.org/mock/gomock"
+
+	"github.com/vanus-labs/vanus/api/cloudevents"
+	vanus "github.com/vanus-labs/vanus/api/vsr"
+	"github.com/vanus-labs/vanus/client"
+	"github.com/vanus-labs/vanus/client/pkg/api"
+	"github.com/vanus-labs/vanus/client/pkg/eventlog"
+	"github.com/vanus-labs/vanus/client/pkg/policy"
+
+	"github.com/vanus-labs/vanus/pkg/snowflake"
+	"github.com/vanus-labs/vanus/server/trigger/info"
+)
+
+func makeBatch(offset int64, n int) *cloudevents.CloudEventBatch {
+	batch := &cloudevents.CloudEventBatch{}
+	for i := 0; i < n; i++ {
+		e := ce.NewEvent()
+		buf := make([]byte, 8)
+		binary.BigEndian.PutUint64(buf, uint64(offset)+uint64(i))
+		e.SetExtension(eventlog.XVanusLogOffset, buf)
+		epb, _ := cloudevents.ToProto(&e)
+		batch.Events = append(batch.Events, epb)
+	}
+	return batch
+}
+
+func expectScan(busReader *api.MockBusReader, p api.ReadPolicy, offset int64, n int) {
+	busReader.EXPECT().Scan(Any()).Times(1).DoAndReturn(
+		func(ctx context.Context, opts ...api.ReadOption) (*cloudevents.CloudEventBatch, int64, uint64, error) {
+			So(p.Offset(), ShouldEqual, offset)
+			return makeBatch(offset, n), offset + int64(n), uint64(1), nil
+		})
+}
+
+func TestStream(t *testing.T) {
+	mockCtrl := NewController(t)
+	defer mockCtrl.Finish()
+	mockEventlog := api.NewMockEventlog(mockCtrl)
+	mockBusReader := api.NewMockBusReader(mockCtrl)
+
+	Convey("test shared stream", t, func() {
+		ctx := context.Background()
+		p := policy.NewManuallyReadPolicy(mockEventlog, 0)
+		s := &stream{eventlogID: 1, reader: mockBusReader, policy: p, windowSize: 4}
+		c := s.attach()
+
+		Convey("read in the window", func() {
+			expectScan(mockBusReader, p, 10, 2)
+			events, next, err := c.read(ctx, 10)
+			So(err, ShouldBeNil)
+			So(events, ShouldHaveLength, 2)
+			So(next, ShouldEqual, 12)
+
+			// other readers read the events in the window without reading the eventlog.
+			events, next, err = c.read(ctx, 11)
+			So(err, ShouldBeNil)
+			So(events, ShouldHaveLength, 1)
+			So(next, ShouldEqual, 12)
+			offsetByte, _ := events[0].Extensions()[eventlog.XVanusLogOffset].([]byte)
+			So(binary.BigEndian.Uint64(offsetByte), ShouldEqual, 11)
+
+			// the events are owned by every reader.
+			another, _, _ := c.read(ctx, 11)
+			So(another[0], ShouldNotPointTo, events[0])
+		})
+
+		Convey("lag behind the window", func() {
+			expectScan(mockBusReader, p, 10, 3)
+			expectScan(mockBusReader, p, 13, 3)
+			_, _, err := c.read(ctx, 10)
+			So(err, ShouldBeNil)
+			_, next, err := c.read(ctx, 13)
+			So(err, ShouldBeNil)
+			So(next, ShouldEqual, 16)
+			So(s.start, ShouldEqual, 13)
+
+			_, _, err = c.read(ctx, 12)
+			So(err, ShouldEqual, errOutOfWindow)
+		})
+
+		Convey("move the window forward", func() {
+			expectScan(mockBusReader, p, 10, 2)
+			expectScan(mockBusReader, p, 20, 2)
+			_, _, err := c.read(ctx, 10)
+			So(err, ShouldBeNil)
+			_, next, err := c.read(ctx, 20)
+			So(err, ShouldBeNil)
+			So(next, ShouldEqual, 22)
+			So(s.start, ShouldEqual, 20)
+			_, _, err = c.read(ctx, 11)
+			So(err, ShouldEqual, errOutOfWindow)
+		})
+
+		Convey("keep the window used by other readers", func() {
+			expectScan(mockBusReader, p, 10, 2)
+			_, _, err := c.read(ctx, 10)
+			So(err, ShouldBeNil)
+
+			// the reader ahead reads the eventlog by itself.
+			ahead := s.attach()
+			_, _, err = ahead.read(ctx, 20)
+			So(err, ShouldEqual, errOutOfWindow)
+			So(s.start, ShouldEqual, 10)
+			_, next, err := c.read(ctx, 11)
+			So(err, ShouldBeNil)
+			So(next, ShouldEqual, 12)
+
+			// the window is moved forward if no other reader uses it.
+			So(s.detach(c), ShouldEqual, 1)
+			expectScan(mockBusReader, p, 20, 2)
+			_, next, err = ahead.read(ctx, 20)
+			So(err, ShouldBeNil)
+			So(next, ShouldEqual, 22)
+			So(s.start, ShouldEqual, 20)
+		})
+
+		Convey("read concurrently", func() {
+			mockBusReader.EXPECT().Scan(Any()).Times(1).DoAndReturn(
+				func(ctx context.Context, opts ...api.ReadOption) (*cloudevents.CloudEventBatch, int64, uint64, error) {
+					time.Sleep(50 * time.Millisecond)
+					return makeBatch(30, 2), int64(32), uint64(1), nil
+				})
+			var wg sync.WaitGroup
+			results := make([]int64, 3)
+			for i := range results {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					_, results[i], _ = s.attach().read(ctx, 30)
+				}(i)
+			}
+			wg.Wait()
+			So(results, ShouldResemble, []int64{32, 32, 32})
+		})
+
+		Convey("no event", func() {
+			mockBusReader.EXPECT().Scan(Any()).Times(1).Return(&cloudevents.CloudEventBatch{}, int64(10), uint64(1), nil)
+			events, next, err := c.read(ctx, 10)
+			So(err, ShouldBeNil)
+			So(events, ShouldBeEmpty)
+			So(next, ShouldEqual, 10)
+			So(s.reading, ShouldBeNil)
+		})
+	})
+}
+
+func TestHub(t *testing.T) {
+	mockCtrl := NewController(t)
+	defer mockCtrl.Finish()
+	mockClient := client.NewMockClient(mockCtrl)
+	mockEventbus := api.NewMockEventbus(mockCtrl)
+	mockEventlog := api.NewMockEventlog(mockCtrl)
+	sharedReader := api.NewMockBusReader(mockCtrl)
+	privateReader := api.NewMockBusReader(mockCtrl)
+	mockClient.EXPECT().Eventbus(Any(), Any()).AnyTimes().Return(mockEventbus)
+	mockEventlog.EXPECT().ID().AnyTimes().Return(uint64(1))
+
+	Convey("test reader hub", t, func() {
+		h, _ := NewHub(2).(*hub)
+		config := Config{EventbusID: snowflake.NewTestID(), Client: mockClient, BatchSize: 2}
+
+		mockEventbus.EXPECT().Reader(Any(), Any()).Times(1).Return(sharedReader)
+		c1 := h.acquire(config, mockEventlog)
+		c2 := h.acquire(config, mockEventlog)
+		So(c1.stream, ShouldEqual, c2.stream)
+		So(c1.stream.cursors, ShouldHaveLength, 2)
+		s1 := c1.stream
+
+		events := make(chan info.EventRecord, 20)
+		newReader := func(offset int64, c *cursor) *eventlogReader {
+			return &eventlogReader{
+				config:     config,
+				eventlogID: vanus.NewIDFromUint64(1),
+				policy:     policy.NewManuallyReadPolicy(mockEventlog, offset),
+				events:     events,
+				cursor:     c,
+			}
+		}
+		r1, r2 := newReader(0, c1), newReader(0, c2)
+
+		expectScan(sharedReader, s1.policy, 0, 2)
+		So(r1.loop(context.Background(), privateReader), ShouldBeNil)
+		So(r2.loop(context.Background(), privateReader), ShouldBeNil)
+		So(events, ShouldHaveLength, 4)
+		So(r2.policy.Offset(), ShouldEqual, 2)
+
+		expectScan(sharedReader, s1.policy, 2, 2)
+		So(r1.loop(context.Background(), privateReader), ShouldBeNil)
+		expectScan(sharedReader, s1.policy, 4, 2)
+		So(r1.loop(context.Background(), privateReader), ShouldBeNil)
+		So(r1.policy.Offset(), ShouldEqual, 6)
+
+		// r2 lags behind the window, it reads the eventlog by itself.
+		expectScan(privateReader, r2.policy, 2, 2)
+		So(r2.loop(context.Background(), privateReader), ShouldBeNil)
+		So(r2.policy.Offset(), ShouldEqual, 4)
+		// r2 catches up.
+		So(r2.loop(context.Background(), privateReader), ShouldBeNil)
+		So(r2.policy.Offset(), ShouldEqual, 6)
+
+		h.release(c1)
+		So(h.streams, ShouldContainKey, uint64(1))
+		h.release(c2)
+		So(h.streams, ShouldBeEmpty)
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"go.uber.org/ratelimit"
 
 	primitive "github.com/vanus-labs/vanus/pkg"
+	"github.com/vanus-labs/vanus/server/trigger/reader"
 )
 
 const (
@@ -40,6 +41,9 @@ type Config struct {
 	MaxWriteAttempt   int
 	Ordered           bool
 	DisableDeadLetter bool
+	// OrderedKeyAttribute is the attribute used as ordering key when Ordered is true, events with
+	// different keys are sent concurrently.
+	OrderedKeyAttribute string
 
 	GoroutineSize int
 	SendBatchSize int
@@ -103,6 +107,12 @@ func WithOrdered(ordered bool) Option {
 	}
 }
 
+func WithOrderedKeyAttribute(attr string) Option {
+	return func(t *trigger) {
+		t.config.OrderedKeyAttribute = attr
+	}
+}
+
 func WithRateLimit(rateLimit uint32) Option {
 	return func(t *trigger) {
 		t.config.RateLimit = rateLimit
@@ -167,3 +177,10 @@ func WithProxy(proxy *TargetGateway) Option {
 		t.config.TargetGateway = proxy
 	}
 }
+
+// WithReaderHub makes the trigger read the eventbus through the streams shared by hub.
+func WithReaderHub(hub reader.Hub) Option {
+	return func(t *trigger) {
+		t.readerHub = hub
+	}
+}
@@ -55,6 +55,20 @@ func (mr *MockTriggerMockRecorder) Change(ctx, subscription any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Change", reflect.TypeOf((*MockTrigger)(nil).Change), ctx, subscription)
 }
 
+// GetLoad mocks base method.
+func (m *MockTrigger) GetLoad(ctx context.Context) Load {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoad", ctx)
+	ret0, _ := ret[0].(Load)
+	return ret0
+}
+
+// GetLoad indicates an expected call of GetLoad.
+func (mr *MockTriggerMockRecorder) GetLoad(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoad", reflect.TypeOf((*MockTrigger)(nil).GetLoad), ctx)
+}
+
 // GetOffsets mocks base method.
 func (m *MockTrigger) GetOffsets(ctx context.Context) info.ListOffsetInfo {
 	m.ctrl.T.Helper()
@@ -0,0 +1,319 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	// standard libraries.
+	"context"
+	"sync"
+	"time"
+
+	// third-party libraries.
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/panjf2000/ants/v2"
+
+	// first-party libraries.
+	vanus "github.com/vanus-labs/vanus/api/vsr"
+	"github.com/vanus-labs/vanus/pkg/observability/log"
+	"github.com/vanus-labs/vanus/pkg/observability/metrics"
+
+	// this project.
+	"github.com/vanus-labs/vanus/server/trigger/client"
+	"github.com/vanus-labs/vanus/server/trigger/filter"
+	"github.com/vanus-labs/vanus/server/trigger/info"
+	"github.com/vanus-labs/vanus/server/trigger/util"
+)
+
+// calRetryDelay is the backoff of retrying an ordered event in place, it is a variable for test.
+var calRetryDelay = calDeliveryTime
+
+type orderedKeyFunc func(record info.EventRecord) string
+
+// orderedDispatcher dispatches events into lanes by ordering key. Events in the same lane are handled
+// one by one in arrival order, and different lanes are handled concurrently by the pool. Reading an eventlog
+// is paused while its queued events reach maxPending, so lanes are bounded.
+type orderedDispatcher struct {
+	keyFunc    orderedKeyFunc
+	pool       *ants.Pool
+	handler    func(ctx context.Context, record info.EventRecord)
+	pause      func(eventlogID vanus.ID)
+	resume     func(eventlogID vanus.ID)
+	maxPending int
+
+	mu        sync.Mutex
+	lanes     map[string]*orderedLane
+	eventlogs map[vanus.ID]*orderedEventlog
+}
+
+type orderedLane struct {
+	queue []info.EventRecord
+}
+
+// orderedEventlog is the state of an eventlog whose events are queued or whose reading is held.
+type orderedEventlog struct {
+	pending int
+	// full is true if the eventlog is held because pending reaches maxPending.
+	full  bool
+	holds int
+}
+
+func newOrderedDispatcher(
+	keyFunc orderedKeyFunc, pool *ants.Pool, handler func(ctx context.Context, record info.EventRecord),
+	pause, resume func(eventlogID vanus.ID), maxPending int,
+) *orderedDispatcher {
+	if maxPending < 1 {
+		maxPending = 1
+	}
+	return &orderedDispatcher{
+		keyFunc:    keyFunc,
+		pool:       pool,
+		handler:    handler,
+		pause:      pause,
+		resume:     resume,
+		maxPending: maxPending,
+		lanes:      make(map[string]*orderedLane),
+		eventlogs:  make(map[vanus.ID]*orderedEventlog),
+	}
+}
+
+func (d *orderedDispatcher) dispatch(ctx context.Context, record info.EventRecord) {
+	key := d.keyFunc(record)
+
+	d.mu.Lock()
+	d.enqueueLocked(record.EventlogID)
+	if lane, ok := d.lanes[key]; ok {
+		lane.queue = append(lane.queue, record)
+		d.mu.Unlock()
+		return
+	}
+	lane := &orderedLane{queue: []info.EventRecord{record}}
+	d.lanes[key] = lane
+	d.mu.Unlock()
+
+	err := d.pool.Submit(func() {
+		d.drain(ctx, key, lane)
+	})
+	if err != nil {
+		// the offsets of queued events are not committed, so they are redelivered after restart.
+		log.Warn(ctx).Err(err).
+			Str("ordered_key", key).
+			Msg("submit ordered lane failed, drop queued events")
+		d.mu.Lock()
+		d.dropLocked(key, lane)
+		d.mu.Unlock()
+	}
+}
+
+func (d *orderedDispatcher) drain(ctx context.Context, key string, lane *orderedLane) {
+	for {
+		d.mu.Lock()
+		if len(lane.queue) == 0 || ctx.Err() != nil {
+			d.dropLocked(key, lane)
+			d.mu.Unlock()
+			return
+		}
+		record := lane.queue[0]
+		lane.queue[0] = info.EventRecord{}
+		lane.queue = lane.queue[1:]
+		d.dequeueLocked(record.EventlogID)
+		d.mu.Unlock()
+
+		d.handler(ctx, record)
+	}
+}
+
+// dropLocked removes the lane and its queued events.
+func (d *orderedDispatcher) dropLocked(key string, lane *orderedLane) {
+	delete(d.lanes, key)
+	for _, record := range lane.queue {
+		d.dequeueLocked(record.EventlogID)
+	}
+	lane.queue = nil
+}
+
+func (d *orderedDispatcher) enqueueLocked(id vanus.ID) {
+	el := d.eventlogs[id]
+	if el == nil {
+		el = &orderedEventlog{}
+		d.eventlogs[id] = el
+	}
+	el.pending++
+	if !el.full && el.pending >= d.maxPending {
+		el.full = true
+		d.holdLocked(id, el)
+	}
+}
+
+func (d *orderedDispatcher) dequeueLocked(id vanus.ID) {
+	el := d.eventlogs[id]
+	if el == nil {
+		return
+	}
+	el.pending--
+	// resume at half to avoid pausing and resuming frequently.
+	if el.full && el.pending <= d.maxPending/2 {
+		el.full = false
+		d.releaseLocked(id, el)
+	}
+	d.cleanLocked(id, el)
+}
+
+// hold pauses reading the eventlog until all holds are released.
+func (d *orderedDispatcher) hold(id vanus.ID) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	el := d.eventlogs[id]
+	if el == nil {
+		el = &orderedEventlog{}
+		d.eventlogs[id] = el
+	}
+	d.holdLocked(id, el)
+}
+
+func (d *orderedDispatcher) release(id vanus.ID) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if el := d.eventlogs[id]; el != nil {
+		d.releaseLocked(id, el)
+		d.cleanLocked(id, el)
+	}
+}
+
+func (d *orderedDispatcher) holdLocked(id vanus.ID, el *orderedEventlog) {
+	el.holds++
+	if el.holds == 1 {
+		d.pause(id)
+	}
+}
+
+func (d *orderedDispatcher) releaseLocked(id vanus.ID, el *orderedEventlog) {
+	if el.holds == 0 {
+		return
+	}
+	el.holds--
+	if el.holds == 0 {
+		d.resume(id)
+	}
+}
+
+func (d *orderedDispatcher) cleanLocked(id vanus.ID, el *orderedEventlog) {
+	if el.pending == 0 && el.holds == 0 {
+		delete(d.eventlogs, id)
+	}
+}
+
+// eventlogKey orders events per eventlog, which is the order they are read.
+func eventlogKey(record info.EventRecord) string {
+	return record.EventlogID.Key()
+}
+
+// attributeKey orders events per value of attribute attr, events without the attribute share the same
+// empty key.
+func attributeKey(attr string) orderedKeyFunc {
+	return func(record info.EventRecord) string {
+		value, ok := util.LookupAttribute(*record.Event, attr)
+		if !ok {
+			return ""
+		}
+		key, _ := types.Format(value)
+		return key
+	}
+}
+
+func (t *trigger) processOrderedEvent(ctx context.Context, record info.EventRecord) {
+	startTime := time.Now()
+	res := filter.Run(t.getFilter(), *record.Event)
+	metrics.TriggerFilterCostSecond.WithLabelValues(t.subscriptionIDStr).Observe(time.Since(startTime).Seconds())
+	if res == filter.FailFilter {
+		t.offsetManager.EventCommit(record.OffsetInfo)
+		return
+	}
+	metrics.TriggerFilterMatchEventCounter.WithLabelValues(t.subscriptionIDStr).Inc()
+	event, err := t.transformEvent(record, false)
+	if err != nil {
+		log.Info(ctx).Err(err).
+			Str("event_id", record.Event.ID()).
+			Str(log.KeySubscriptionID, t.subscriptionIDStr).
+			Str(log.KeyEventbusID, t.eventbusIDStr).
+			Stringer(log.KeyEventlogID, record.EventlogID).
+			Uint64("event_offset", record.OffsetInfo.Offset).
+			Msg("event transform error")
+		t.writeFailEvent(ctx, record.Event, ErrTransformCode, err)
+		t.offsetManager.EventCommit(record.OffsetInfo)
+		return
+	}
+	t.sendOrderedEvent(ctx, event)
+}
+
+// sendOrderedEvent retries the event in place with backoff until it is delivered, the sink rejects it with
+// a non-retryable code, the attempts exceed MaxRetryAttempts or the trigger stops, so the following events
+// with the same key are held back. If events are ordered per eventlog, reading the eventlog is paused while
+// the event is stuck.
+func (t *trigger) sendOrderedEvent(ctx context.Context, event *toSendEvent) {
+	var attempts int32
+	defer func() {
+		if attempts > 0 {
+			metrics.TriggerOrderedStuckEventGauge.WithLabelValues(t.subscriptionIDStr).Dec()
+			if t.config.OrderedKeyAttribute == "" {
+				t.dispatcher.release(event.record.EventlogID)
+			}
+		}
+	}()
+	for {
+		r := t.sendEvent(ctx, event.transform)
+		if r == client.Success {
+			metrics.TriggerPushEventCounter.WithLabelValues(
+				t.subscriptionIDStr, t.eventbusIDStr, metrics.LabelFalse, metrics.LabelSuccess).Inc()
+			t.offsetManager.EventCommit(event.record.OffsetInfo)
+			return
+		}
+		metrics.TriggerPushEventCounter.WithLabelValues(
+			t.subscriptionIDStr, t.eventbusIDStr, metrics.LabelFalse, metrics.LabelFailed).Inc()
+		log.Info(ctx).Err(r.Err).
+			Int("code", r.StatusCode).
+			Int32("attempts", attempts).
+			Str("event_id", event.record.Event.ID()).
+			Interface("target", t.subscription.Sink).
+			Str(log.KeySubscriptionID, t.subscriptionIDStr).
+			Str(log.KeyEventbusID, t.eventbusIDStr).
+			Stringer(log.KeyEventlogID, event.record.EventlogID).
+			Uint64("event_offset", event.record.OffsetInfo.Offset).
+			Msg("send ordered event fail")
+		needRetry, reason := isShouldRetry(r.StatusCode)
+		if needRetry && attempts >= t.getConfig().MaxRetryAttempts {
+			needRetry = false
+			reason = maxDeliveryAttemptExceeded
+		}
+		if !needRetry {
+			t.writeEventToDeadLetterIfEnabled(ctx, event.record.Event, r.StatusCode, reason, r.Err)
+			t.offsetManager.EventCommit(event.record.OffsetInfo)
+			return
+		}
+		if attempts == 0 {
+			metrics.TriggerOrderedStuckEventGauge.WithLabelValues(t.subscriptionIDStr).Inc()
+			if t.config.OrderedKeyAttribute == "" {
+				t.dispatcher.hold(event.record.EventlogID)
+			}
+		}
+		attempts++
+		metrics.TriggerOrderedRetryCounter.WithLabelValues(t.subscriptionIDStr).Inc()
+		select {
+		case <-ctx.Done():
+			// leave the offset uncommitted, the event will be redelivered after restart.
+			return
+		case <-time.After(calRetryDelay(attempts)):
+		}
+	}
+}
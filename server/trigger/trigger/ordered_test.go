@@ -0,0 +1,262 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	// standard libraries.
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	// third-party libraries.
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/panjf2000/ants/v2"
+	. "github.com/prashantv/gostub"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+
+	// first-party libraries.
+	vanus "github.com/vanus-labs/vanus/api/vsr"
+	eb "github.com/vanus-labs/vanus/client"
+	"github.com/vanus-labs/vanus/client/pkg/api"
+
+	// this project.
+	primitive "github.com/vanus-labs/vanus/pkg"
+	pInfo "github.com/vanus-labs/vanus/pkg/info"
+	"github.com/vanus-labs/vanus/pkg/snowflake"
+	"github.com/vanus-labs/vanus/server/trigger/client"
+	"github.com/vanus-labs/vanus/server/trigger/info"
+	"github.com/vanus-labs/vanus/server/trigger/reader"
+)
+
+func TestTriggerOrderedByKey(t *testing.T) {
+	retryDelay := 500 * time.Millisecond
+	stub := StubFunc(&calRetryDelay, retryDelay)
+	defer stub.Reset()
+	Convey("test events ordered by key", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		cli := client.NewMockEventClient(ctrl)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		id := snowflake.NewTestID()
+		tg, err := newTrigger(makeSubscription(id), WithControllers([]string{"test"}),
+			WithOrdered(true), WithOrderedKeyAttribute("partitionkey"))
+		So(err, ShouldBeNil)
+		mockClient := eb.NewMockClient(ctrl)
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockClient.EXPECT().Eventbus(gomock.Any(), gomock.Any()).AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().Writer().AnyTimes().Return(api.NewMockBusWriter(ctrl))
+		tg.client = mockClient
+		_ = tg.Init(ctx)
+		So(tg.dispatcher, ShouldNotBeNil)
+		tg.eventCli = cli
+
+		var mu sync.Mutex
+		sent := map[string][]string{}
+		failed := false
+		cli.EXPECT().Send(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(_ context.Context, events ...*ce.Event) client.Result {
+				mu.Lock()
+				defer mu.Unlock()
+				e := events[0]
+				if e.ID() == "a-0" && !failed {
+					failed = true
+					return client.Result{StatusCode: http.StatusServiceUnavailable, Err: errors.New("unavailable")}
+				}
+				key, _ := e.Extensions()["partitionkey"].(string)
+				sent[key] = append(sent[key], e.ID())
+				return client.Success
+			})
+
+		go tg.runEventFilterTransform(ctx)
+		ids := map[string][]string{}
+		for i := 0; i < 10; i++ {
+			for _, key := range []string{"a", "b"} {
+				record := makeOrderedEventRecord(key, i)
+				ids[key] = append(ids[key], record.Event.ID())
+				_ = tg.eventArrived(ctx, record)
+			}
+		}
+
+		// key b is not blocked by the failed event of key a.
+		time.Sleep(100 * time.Millisecond)
+		mu.Lock()
+		So(sent["a"], ShouldBeEmpty)
+		So(sent["b"], ShouldResemble, ids["b"])
+		mu.Unlock()
+
+		time.Sleep(retryDelay)
+		mu.Lock()
+		So(sent["a"], ShouldResemble, ids["a"])
+		mu.Unlock()
+		So(tg.offsetManager.GetCommit(), ShouldResemble, pInfo.ListOffsetInfo{{Offset: 20}})
+	})
+}
+
+func TestOrderedDispatcher(t *testing.T) {
+	Convey("test ordered dispatcher", t, func() {
+		ctx := context.Background()
+		elID := snowflake.NewTestID()
+		var mu sync.Mutex
+		var paused, resumed int
+		pause := func(id vanus.ID) {
+			mu.Lock()
+			defer mu.Unlock()
+			So(id, ShouldEqual, elID)
+			paused++
+		}
+		resume := func(id vanus.ID) {
+			mu.Lock()
+			defer mu.Unlock()
+			So(id, ShouldEqual, elID)
+			resumed++
+		}
+		pool, _ := ants.NewPool(4)
+		block := make(chan struct{})
+		handled := make(chan string, 8)
+		d := newOrderedDispatcher(attributeKey("partitionkey"), pool, func(_ context.Context, record info.EventRecord) {
+			<-block
+			handled <- record.Event.ID()
+		}, pause, resume, 4)
+
+		Convey("test pause reading eventlog while lanes are full", func() {
+			// the first event is taken by the lane, so it isn't queued.
+			for i := 0; i < 5; i++ {
+				record := makeOrderedEventRecord("a", i)
+				record.EventlogID = elID
+				d.dispatch(ctx, record)
+			}
+			mu.Lock()
+			So(paused, ShouldEqual, 1)
+			So(resumed, ShouldEqual, 0)
+			mu.Unlock()
+
+			// stuck events of the eventlog share the same hold.
+			d.hold(elID)
+			close(block)
+			for i := 0; i < 5; i++ {
+				So(<-handled, ShouldEqual, "a-"+strconv.Itoa(i))
+			}
+			mu.Lock()
+			So(resumed, ShouldEqual, 0)
+			mu.Unlock()
+
+			d.release(elID)
+			mu.Lock()
+			So(paused, ShouldEqual, 1)
+			So(resumed, ShouldEqual, 1)
+			mu.Unlock()
+			So(d.eventlogs, ShouldBeEmpty)
+		})
+
+		Convey("test drop queued events if submitting fails", func() {
+			pool.Release()
+			record := makeOrderedEventRecord("a", 0)
+			record.EventlogID = elID
+			d.dispatch(ctx, record)
+			So(d.lanes, ShouldBeEmpty)
+			So(d.eventlogs, ShouldBeEmpty)
+		})
+	})
+}
+
+func makeOrderedEventRecord(key string, seq int) info.EventRecord {
+	record := makeEventRecord("test")
+	record.Event.SetID(key + "-" + strconv.Itoa(seq))
+	record.Event.SetExtension("partitionkey", key)
+	offset := uint64(seq * 2)
+	if key == "b" {
+		offset++
+	}
+	record.OffsetInfo = pInfo.OffsetInfo{Offset: offset}
+	return record
+}
+
+func TestTriggerOrderedRetry(t *testing.T) {
+	stub := StubFunc(&calRetryDelay, 10*time.Millisecond)
+	defer stub.Reset()
+	Convey("test ordered event retry in place", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		cli := client.NewMockEventClient(ctrl)
+		ctx := context.Background()
+		id := snowflake.NewTestID()
+		tg, err := newTrigger(makeSubscription(id), WithControllers([]string{"test"}),
+			WithOrdered(true), WithMaxRetryAttempts(3))
+		So(err, ShouldBeNil)
+		mockClient := eb.NewMockClient(ctrl)
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockBusWriter := api.NewMockBusWriter(ctrl)
+		mockClient.EXPECT().Eventbus(gomock.Any(), gomock.Any()).AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+		tg.client = mockClient
+		_ = tg.Init(ctx)
+		tg.dlEventWriter = mockBusWriter
+		tg.timerEventWriter = mockBusWriter
+		r := reader.NewMockReader(ctrl)
+		tg.reader = r
+		tg.eventCli = cli
+		record := makeEventRecord("test")
+		record.OffsetInfo = pInfo.OffsetInfo{EventlogID: snowflake.NewTestID(), Offset: 10}
+		tg.offsetManager.EventReceive(record.OffsetInfo)
+		event, _ := tg.transformEvent(record, false)
+
+		Convey("test retry until success", func() {
+			var calls int
+			cli.EXPECT().Send(gomock.Any(), gomock.Any()).Times(3).DoAndReturn(
+				func(_ context.Context, _ ...*ce.Event) client.Result {
+					calls++
+					if calls < 3 {
+						return client.Result{StatusCode: http.StatusServiceUnavailable, Err: errors.New("unavailable")}
+					}
+					return client.Success
+				})
+			r.EXPECT().Pause(record.EventlogID).Times(1)
+			r.EXPECT().Resume(record.EventlogID).Times(1)
+			tg.sendOrderedEvent(ctx, event)
+			So(tg.offsetManager.GetCommit(), ShouldResemble, pInfo.ListOffsetInfo{
+				{EventlogID: record.EventlogID, Offset: 11},
+			})
+		})
+
+		Convey("test max retry attempts exceeded", func() {
+			cli.EXPECT().Send(gomock.Any(), gomock.Any()).Times(4).Return(
+				client.Result{StatusCode: http.StatusServiceUnavailable, Err: errors.New("unavailable")})
+			r.EXPECT().Pause(record.EventlogID).Times(1)
+			r.EXPECT().Resume(record.EventlogID).Times(1)
+			mockBusWriter.EXPECT().Append(gomock.Any(), gomock.Any()).Times(1).Return([]string{""}, nil)
+			tg.sendOrderedEvent(ctx, event)
+			So(record.Event.Extensions()[primitive.DeadLetterReason], ShouldEqual, maxDeliveryAttemptExceeded)
+			So(record.Event.Extensions()[primitive.DeadLetterCode], ShouldEqual, http.StatusServiceUnavailable)
+			So(tg.offsetManager.GetCommit(), ShouldResemble, pInfo.ListOffsetInfo{
+				{EventlogID: record.EventlogID, Offset: 11},
+			})
+		})
+
+		Convey("test non-retryable response", func() {
+			cli.EXPECT().Send(gomock.Any(), gomock.Any()).Times(1).Return(
+				client.Result{StatusCode: http.StatusBadRequest, Err: errors.New("bad request")})
+			mockBusWriter.EXPECT().Append(gomock.Any(), gomock.Any()).Times(1).Return([]string{""}, nil)
+			tg.sendOrderedEvent(ctx, event)
+			So(record.Event.Extensions()[primitive.DeadLetterReason], ShouldEqual, "Response400")
+			So(record.Event.Extensions()[primitive.DeadLetterCode], ShouldEqual, http.StatusBadRequest)
+		})
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"reflect"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	// third-party libraries.
@@ -29,6 +30,7 @@ import (
 	"go.uber.org/ratelimit"
 
 	// first-party libraries.
+	segpb "github.com/vanus-labs/vanus/api/segment"
 	vanus "github.com/vanus-labs/vanus/api/vsr"
 	eb "github.com/vanus-labs/vanus/client"
 	"github.com/vanus-labs/vanus/client/pkg/api"
@@ -64,6 +66,17 @@ type Trigger interface {
 	Stop(ctx context.Context) error
 	Change(ctx context.Context, subscription *primitive.Subscription) error
 	GetOffsets(ctx context.Context) pInfo.ListOffsetInfo
+	GetLoad(ctx context.Context) Load
+}
+
+// Load is a snapshot of how busy a trigger is.
+type Load struct {
+	// Throughput is the number of events received per second since the previous snapshot.
+	Throughput float64
+	// Lag is the number of events read from the eventbus but not yet delivered.
+	Lag uint64
+	// Goroutines is the number of running goroutines in the delivery pool.
+	Goroutines int
 }
 
 type trigger struct {
@@ -73,16 +86,25 @@ type trigger struct {
 	subscription  *primitive.Subscription
 	offsetManager *offset.SubscriptionOffset
 	reader        reader.Reader
-	eventCh       chan info.EventRecord
-	sendCh        chan *toSendEvent
-	batchSendCh   chan []*toSendEvent
-	eventCli      client.EventClient
-	client        eb.Client
-	filter        filter.Filter
-	transformer   *transform.Transformer
-	rateLimiter   ratelimit.Limiter
-	config        Config
-	batch         bool
+	// readerHub shares the reading of the eventbus with other triggers if it is not nil.
+	readerHub   reader.Hub
+	eventCh     chan info.EventRecord
+	sendCh      chan *toSendEvent
+	batchSendCh chan []*toSendEvent
+	eventCli    client.EventClient
+	client      eb.Client
+	filter      filter.Filter
+	// readFilter is pushed down to segment servers when reading the eventbus, and retryReadFilter
+	// is for the retry eventbus, which also holds the events of other subscriptions.
+	readFilter      *segpb.AttributeFilter
+	retryReadFilter *segpb.AttributeFilter
+	transformer     *transform.Transformer
+	rateLimiter     ratelimit.Limiter
+	config          Config
+	batch           bool
+
+	// dispatcher is only used when events are ordered.
+	dispatcher *orderedDispatcher
 
 	retryEventCh     chan info.EventRecord
 	retryEventReader reader.Reader
@@ -95,6 +117,11 @@ type trigger struct {
 	wg    primitive.Group
 
 	pool *ants.Pool
+
+	received     atomic.Uint64
+	loadLock     sync.Mutex
+	loadReceived uint64
+	loadTime     time.Time
 }
 
 type toSendEvent struct {
@@ -122,10 +149,10 @@ func newTrigger(subscription *primitive.Subscription, opts ...Option) (*trigger,
 		subscriptionIDStr: subscription.ID.String(),
 		eventbusIDStr:     subscription.EventbusID.String(),
 		transformer:       trans,
+		loadTime:          time.Now(),
 	}
-	if subscription.Protocol == primitive.GRPC {
-		t.batch = true
-	}
+	t.readFilter, t.retryReadFilter = t.getReadFilters(subscription.Filters)
+	t.batch = getPlugin(subscription.Protocol).Batch()
 	t.applyOptions(opts...)
 	if t.rateLimiter == nil {
 		t.rateLimiter = ratelimit.NewUnlimited()
@@ -155,18 +182,23 @@ func (t *trigger) getClient() client.EventClient {
 
 func (t *trigger) changeTarget(
 	sink primitive.URI, protocol primitive.Protocol, credential primitive.SinkCredential,
+	setting *primitive.ProtocolSetting,
 ) error {
 	eventCli := newEventClient(clientConfig{
 		sink:       sink,
 		protocol:   protocol,
 		credential: credential,
+		setting:    setting,
 		gateway:    t.config.TargetGateway})
 	t.lock.Lock()
-	defer t.lock.Unlock()
+	oldCli := t.eventCli
 	t.eventCli = eventCli
 	t.subscription.Sink = sink
 	t.subscription.Protocol = protocol
 	t.subscription.SinkCredential = credential
+	t.subscription.ProtocolSetting = setting
+	t.lock.Unlock()
+	closeEventClient(oldCli)
 	return nil
 }
 
@@ -178,12 +210,37 @@ func (t *trigger) getFilter() filter.Filter {
 
 func (t *trigger) changeFilter(filters []*primitive.SubscriptionFilter) {
 	f := filter.GetFilter(filters)
+	readFilter, retryReadFilter := t.getReadFilters(filters)
 	t.lock.Lock()
 	defer t.lock.Unlock()
 	t.filter = f
+	t.readFilter = readFilter
+	t.retryReadFilter = retryReadFilter
 	t.subscription.Filters = filters
 }
 
+func (t *trigger) getReadFilters(
+	filters []*primitive.SubscriptionFilter,
+) (*segpb.AttributeFilter, *segpb.AttributeFilter) {
+	readFilter := filter.GetAttributeFilter(filters)
+	retryReadFilter := filter.GetAttributeFilter(append([]*primitive.SubscriptionFilter{{
+		Exact: map[string]string{primitive.XVanusSubscriptionID: t.subscriptionIDStr},
+	}}, filters...))
+	return readFilter, retryReadFilter
+}
+
+func (t *trigger) getReadFilter() *segpb.AttributeFilter {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.readFilter
+}
+
+func (t *trigger) getRetryReadFilter() *segpb.AttributeFilter {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.retryReadFilter
+}
+
 func (t *trigger) getTransformer() *transform.Transformer {
 	t.lock.RLock()
 	defer t.lock.RUnlock()
@@ -262,7 +319,12 @@ func (t *trigger) runRetryEventFilterTransform(ctx context.Context) {
 			if !ok {
 				return
 			}
+			if record.Event == nil {
+				t.offsetManager.EventSkip(record.OffsetInfo)
+				continue
+			}
 			t.offsetManager.EventReceive(record.OffsetInfo)
+			t.received.Add(1)
 			_ = t.pool.Submit(func() {
 				ec, _ := record.Event.Context.(*ce.EventContextV1)
 				if len(ec.Extensions) == 0 {
@@ -310,7 +372,16 @@ func (t *trigger) runEventFilterTransform(ctx context.Context) {
 			if !ok {
 				return
 			}
+			if record.Event == nil {
+				t.offsetManager.EventSkip(record.OffsetInfo)
+				continue
+			}
 			t.offsetManager.EventReceive(record.OffsetInfo)
+			t.received.Add(1)
+			if t.dispatcher != nil {
+				t.dispatcher.dispatch(ctx, record)
+				continue
+			}
 			_ = t.pool.Submit(func() {
 				startTime := time.Now()
 				res := filter.Run(t.getFilter(), *record.Event)
@@ -383,13 +454,9 @@ func (t *trigger) runEventSend(ctx context.Context) {
 			if !ok {
 				return
 			}
-			if t.config.Ordered {
+			_ = t.pool.Submit(func() {
 				t.processEvent(ctx, events...)
-			} else {
-				_ = t.pool.Submit(func() {
-					t.processEvent(ctx, events...)
-				})
-			}
+			})
 		}
 	}
 }
@@ -424,13 +491,8 @@ func (t *trigger) processEvent(ctx context.Context, events ...*toSendEvent) {
 			Stringer(log.KeyEventlogID, events[0].record.EventlogID).
 			Uint64("event_offset", events[0].record.OffsetInfo.Offset).
 			Msg("send event fail")
-		code := r.StatusCode
-		if t.config.Ordered {
-			// todo retry util success, now ordered event no need retry direct into dead letter
-			code = OrderEventCode
-		}
 		for _, event := range events {
-			t.writeFailEvent(ctx, event.record.Event, code, r.Err)
+			t.writeFailEvent(ctx, event.record.Event, r.StatusCode, r.Err)
 		}
 	} else {
 		result = metrics.LabelSuccess
@@ -469,17 +531,12 @@ func (t *trigger) writeFailEvent(ctx context.Context, e *ce.Event, code int, err
 			}
 			if attempts >= t.getConfig().MaxRetryAttempts {
 				needRetry = false
-				reason = "MaxDeliveryAttemptExceeded"
+				reason = maxDeliveryAttemptExceeded
 			}
 		}
 	}
 	if !needRetry {
-		// dead letter
-		if t.dlEventWriter == nil {
-			return
-		}
-		t.writeEventToDeadLetter(ctx, e, reason, err.Error())
-		metrics.TriggerDeadLetterEventCounter.WithLabelValues(t.subscriptionIDStr).Inc()
+		t.writeEventToDeadLetterIfEnabled(ctx, e, code, reason, err)
 		return
 	}
 	// retry
@@ -525,13 +582,27 @@ func (t *trigger) writeEventToRetry(ctx context.Context, e *ce.Event, attempts i
 		Msg("write retry event success")
 }
 
-func (t *trigger) writeEventToDeadLetter(ctx context.Context, e *ce.Event, reason, errorMsg string) {
+func (t *trigger) writeEventToDeadLetterIfEnabled(
+	ctx context.Context, e *ce.Event, code int, reason string, err error,
+) {
+	if t.dlEventWriter == nil {
+		return
+	}
+	t.writeEventToDeadLetter(ctx, e, code, reason, err.Error())
+	metrics.TriggerDeadLetterEventCounter.WithLabelValues(t.subscriptionIDStr).Inc()
+}
+
+func (t *trigger) writeEventToDeadLetter(ctx context.Context, e *ce.Event, code int, reason, errorMsg string) {
 	ec, _ := e.Context.(*ce.EventContextV1)
+	if ec.Extensions == nil {
+		ec.Extensions = make(map[string]interface{})
+	}
 	delete(ec.Extensions, primitive.XVanusEventbus)
 	ec.Extensions[primitive.XVanusSubscriptionID] = t.subscriptionIDStr
 	ec.Extensions[primitive.LastDeliveryTime] = ce.Timestamp{Time: time.Now()}
 	ec.Extensions[primitive.LastDeliveryError] = errorMsg
 	ec.Extensions[primitive.DeadLetterReason] = reason
+	ec.Extensions[primitive.DeadLetterCode] = int32(code)
 	var writeAttempt int
 	for {
 		writeAttempt++
@@ -568,6 +639,9 @@ func (t *trigger) getReaderConfig() reader.Config {
 		SubscriptionID: t.subscription.ID,
 		BatchSize:      t.config.PullBatchSize,
 		Offset:         getOffset(t.subscription),
+		ShardNum:       t.subscription.Config.GetShards(),
+		Shards:         t.subscription.Shards,
+		ReadFilter:     t.getReadFilter,
 	}
 }
 
@@ -578,6 +652,9 @@ func (t *trigger) getRetryEventReaderConfig() reader.Config {
 		SubscriptionID: t.subscription.ID,
 		BatchSize:      t.config.PullBatchSize,
 		Offset:         getOffset(t.subscription),
+		ShardNum:       t.subscription.Config.GetShards(),
+		Shards:         t.subscription.Shards,
+		ReadFilter:     t.getRetryReadFilter,
 	}
 }
 
@@ -596,6 +673,7 @@ func (t *trigger) Init(ctx context.Context) error {
 		sink:       t.subscription.Sink,
 		protocol:   t.subscription.Protocol,
 		credential: t.subscription.SinkCredential,
+		setting:    t.subscription.ProtocolSetting,
 		gateway:    t.config.TargetGateway})
 	t.client = eb.Connect(t.config.Controllers)
 
@@ -608,9 +686,21 @@ func (t *trigger) Init(ctx context.Context) error {
 	t.eventCh = make(chan info.EventRecord, t.config.BufferSize)
 	t.sendCh = make(chan *toSendEvent, t.config.BufferSize)
 	t.batchSendCh = make(chan []*toSendEvent, t.config.BufferSize)
-	t.reader = reader.NewReader(t.getReaderConfig(), t.eventCh)
+	if t.readerHub != nil {
+		t.reader = t.readerHub.NewReader(t.getReaderConfig(), t.eventCh)
+	} else {
+		t.reader = reader.NewReader(t.getReaderConfig(), t.eventCh)
+	}
 	t.retryEventCh = make(chan info.EventRecord, t.config.BufferSize)
 	t.retryEventReader = reader.NewReader(t.getRetryEventReaderConfig(), t.retryEventCh)
+	if t.config.Ordered {
+		keyFunc := eventlogKey
+		if t.config.OrderedKeyAttribute != "" {
+			keyFunc = attributeKey(t.config.OrderedKeyAttribute)
+		}
+		t.dispatcher = newOrderedDispatcher(keyFunc, t.pool, t.processOrderedEvent,
+			func(id vanus.ID) { t.reader.Pause(id) }, func(id vanus.ID) { t.reader.Resume(id) }, t.config.BufferSize)
+	}
 	return nil
 }
 
@@ -660,6 +750,7 @@ func (t *trigger) Stop(ctx context.Context) error {
 	t.wg.Wait()
 	t.pool.Release()
 	t.offsetManager.Close()
+	closeEventClient(t.getClient())
 	t.state = TriggerStopped
 	log.Info(ctx).
 		Str(log.KeySubscriptionID, t.subscriptionIDStr).
@@ -667,11 +758,35 @@ func (t *trigger) Stop(ctx context.Context) error {
 	return nil
 }
 
+func (t *trigger) GetLoad(_ context.Context) Load {
+	t.loadLock.Lock()
+	defer t.loadLock.Unlock()
+	now := time.Now()
+	received := t.received.Load()
+	var throughput float64
+	if d := now.Sub(t.loadTime).Seconds(); d > 0 {
+		throughput = float64(received-t.loadReceived) / d
+	}
+	t.loadReceived = received
+	t.loadTime = now
+	lag := uint64(t.offsetManager.UnACKNumber())
+	if t.eventCh != nil {
+		lag += uint64(len(t.eventCh) + len(t.retryEventCh))
+	}
+	return Load{
+		Throughput: throughput,
+		Lag:        lag,
+		Goroutines: t.pool.Running(),
+	}
+}
+
 func (t *trigger) Change(_ context.Context, subscription *primitive.Subscription) error {
 	if t.subscription.Sink != subscription.Sink ||
 		t.subscription.Protocol != subscription.Protocol ||
-		!reflect.DeepEqual(t.subscription.SinkCredential, subscription.SinkCredential) {
-		err := t.changeTarget(subscription.Sink, subscription.Protocol, subscription.SinkCredential)
+		!reflect.DeepEqual(t.subscription.SinkCredential, subscription.SinkCredential) ||
+		!reflect.DeepEqual(t.subscription.ProtocolSetting, subscription.ProtocolSetting) {
+		err := t.changeTarget(subscription.Sink, subscription.Protocol, subscription.SinkCredential,
+			subscription.ProtocolSetting)
 		if err != nil {
 			return err
 		}
@@ -690,5 +805,23 @@ func (t *trigger) Change(_ context.Context, subscription *primitive.Subscription
 
 // GetOffsets contains retry eventlog.
 func (t *trigger) GetOffsets(_ context.Context) pInfo.ListOffsetInfo {
-	return t.offsetManager.GetCommit()
+	offsets := t.offsetManager.GetCommit()
+	if t.subscription.Config.GetShards() <= 1 || t.reader == nil {
+		return offsets
+	}
+	// the offsets of eventlogs in other shards are committed by their own trigger workers.
+	eventlogs := make(map[vanus.ID]struct{})
+	for _, id := range t.reader.Eventlogs() {
+		eventlogs[id] = struct{}{}
+	}
+	for _, id := range t.retryEventReader.Eventlogs() {
+		eventlogs[id] = struct{}{}
+	}
+	shardOffsets := make(pInfo.ListOffsetInfo, 0, len(offsets))
+	for _, offset := range offsets {
+		if _, exist := eventlogs[offset.EventlogID]; exist {
+			shardOffsets = append(shardOffsets, offset)
+		}
+	}
+	return shardOffsets
 }
@@ -30,11 +30,13 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/vanus-labs/vanus/api/cloudevents"
+	segpb "github.com/vanus-labs/vanus/api/segment"
 	vanus "github.com/vanus-labs/vanus/api/vsr"
 	eb "github.com/vanus-labs/vanus/client"
 	"github.com/vanus-labs/vanus/client/pkg/api"
 
 	primitive "github.com/vanus-labs/vanus/pkg"
+	pInfo "github.com/vanus-labs/vanus/pkg/info"
 	"github.com/vanus-labs/vanus/pkg/snowflake"
 	"github.com/vanus-labs/vanus/server/trigger/client"
 	"github.com/vanus-labs/vanus/server/trigger/info"
@@ -103,6 +105,70 @@ func TestTriggerStartStop(t *testing.T) {
 	})
 }
 
+func TestTrigger_GetLoad(t *testing.T) {
+	Convey("test get load", t, func() {
+		ctx := context.Background()
+		id := snowflake.NewTestID()
+		tg, err := newTrigger(makeSubscription(id))
+		So(err, ShouldBeNil)
+		tg.eventCh = make(chan info.EventRecord, 10)
+		tg.retryEventCh = make(chan info.EventRecord, 10)
+		elID := snowflake.NewTestID()
+		for i := 0; i < 3; i++ {
+			tg.offsetManager.EventReceive(pInfo.OffsetInfo{EventlogID: elID, Offset: uint64(i)})
+			tg.received.Add(1)
+		}
+		tg.offsetManager.EventCommit(pInfo.OffsetInfo{EventlogID: elID, Offset: 0})
+		tg.eventCh <- info.EventRecord{}
+		tg.loadTime = time.Now().Add(-time.Second)
+
+		load := tg.GetLoad(ctx)
+		So(load.Lag, ShouldEqual, 3)
+		So(load.Throughput, ShouldBeGreaterThan, 2)
+		So(load.Throughput, ShouldBeLessThanOrEqualTo, 3)
+		So(load.Goroutines, ShouldEqual, 0)
+
+		load = tg.GetLoad(ctx)
+		So(load.Throughput, ShouldEqual, 0)
+	})
+}
+
+func TestTrigger_GetOffsetsOfShards(t *testing.T) {
+	Convey("test get offsets of shards", t, func() {
+		ctx := context.Background()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		id := snowflake.NewTestID()
+		sub := makeSubscription(id)
+		el1, el2, retryEl := snowflake.NewTestID(), snowflake.NewTestID(), snowflake.NewTestID()
+		sub.Offsets = pInfo.ListOffsetInfo{
+			{EventlogID: el1, Offset: 1},
+			{EventlogID: el2, Offset: 2},
+			{EventlogID: retryEl, Offset: 3},
+		}
+		Convey("not sharded", func() {
+			tg, err := newTrigger(sub)
+			So(err, ShouldBeNil)
+			So(tg.GetOffsets(ctx), ShouldHaveLength, 3)
+		})
+		Convey("sharded", func() {
+			sub.Config.Shards = 2
+			sub.Shards = []uint32{1}
+			tg, err := newTrigger(sub)
+			So(err, ShouldBeNil)
+			r := reader.NewMockReader(ctrl)
+			r2 := reader.NewMockReader(ctrl)
+			tg.reader = r
+			tg.retryEventReader = r2
+			So(tg.getReaderConfig().Shards, ShouldResemble, []uint32{1})
+			So(tg.getRetryEventReaderConfig().ShardNum, ShouldEqual, 2)
+			r.EXPECT().Eventlogs().Return([]vanus.ID{el2})
+			r2.EXPECT().Eventlogs().Return(nil)
+			So(tg.GetOffsets(ctx), ShouldResemble, pInfo.ListOffsetInfo{{EventlogID: el2, Offset: 2}})
+		})
+	})
+}
+
 func TestTriggerWriteFailEvent(t *testing.T) {
 	Convey("test write fail event", t, func() {
 		ctrl := gomock.NewController(t)
@@ -194,6 +260,12 @@ func TestTriggerRunEventSend(t *testing.T) {
 		_ = tg.eventArrived(ctx, makeEventRecord("no"))
 		time.Sleep(100 * time.Millisecond)
 		So(len(tg.sendCh), ShouldEqual, size)
+		eventlogID := snowflake.NewTestID()
+		_ = tg.eventArrived(ctx, info.EventRecord{OffsetInfo: pInfo.OffsetInfo{EventlogID: eventlogID, Offset: 99}})
+		time.Sleep(100 * time.Millisecond)
+		So(len(tg.sendCh), ShouldEqual, size)
+		So(tg.offsetManager.UnACKNumber(), ShouldEqual, size)
+		So(tg.offsetManager.GetCommit(), ShouldContain, pInfo.OffsetInfo{EventlogID: eventlogID, Offset: 100})
 		close(tg.eventCh)
 		wg.Wait()
 		wg.Add(1)
@@ -301,6 +373,12 @@ func TestChangeSubscription(t *testing.T) {
 				{Exact: map[string]string{"test": "test"}},
 			}})
 			So(err, ShouldBeNil)
+			So(tg.getReadFilter(), ShouldResemble, &segpb.AttributeFilter{
+				Exact: map[string]string{"test": "test"},
+			})
+			So(tg.getRetryReadFilter(), ShouldResemble, &segpb.AttributeFilter{
+				Exact: map[string]string{"test": "test", primitive.XVanusSubscriptionID: tg.subscriptionIDStr},
+			})
 		})
 		Convey("change transformation", func() {
 			err := tg.Change(ctx, &primitive.Subscription{Transformer: &primitive.Transformer{}})
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	primitive "github.com/vanus-labs/vanus/pkg"
+	"github.com/vanus-labs/vanus/pkg/observability/log"
 	"github.com/vanus-labs/vanus/server/trigger/client"
 )
 
@@ -30,30 +31,46 @@ type clientConfig struct {
 	sink       primitive.URI
 	protocol   primitive.Protocol
 	credential primitive.SinkCredential
+	setting    *primitive.ProtocolSetting
 }
 
 func newEventClient(cfg clientConfig) client.EventClient {
-	sink := string(cfg.sink)
-	switch cfg.protocol {
-	case primitive.AwsLambdaProtocol:
-		_credential, _ := cfg.credential.(*primitive.AkSkSinkCredential)
-		return client.NewAwsLambdaClient(_credential.AccessKeyID, _credential.SecretAccessKey, sink)
-	case primitive.GCloudFunctions:
-		_credential, _ := cfg.credential.(*primitive.GCloudSinkCredential)
-		return client.NewGCloudFunctionClient(sink, _credential.CredentialJSON)
-	case primitive.GRPC:
-		return client.NewGRPCClient(sink)
-	default:
-		if cfg.gateway != nil {
-			return client.NewHTTPClientWithGateway(sink, cfg.gateway.Address, cfg.gateway.TargetHeaderName)
-		}
-		return client.NewHTTPClient(sink)
+	clientCfg := client.Config{
+		Sink:       string(cfg.sink),
+		Credential: cfg.credential,
+		Setting:    cfg.setting,
+	}
+	if cfg.gateway != nil {
+		clientCfg.GatewayAddress = cfg.gateway.Address
+		clientCfg.GatewayHeader = cfg.gateway.TargetHeaderName
 	}
+	return getPlugin(cfg.protocol).NewClient(clientCfg)
+}
+
+// closeEventClient closes the client which is replaced or no longer used.
+func closeEventClient(cli client.EventClient) {
+	if cli == nil {
+		return
+	}
+	if err := cli.Close(); err != nil {
+		log.Warn().Err(err).Msg("close event client failed")
+	}
+}
+
+// getPlugin returns the plugin of the protocol, events are sent by http if the protocol is unknown.
+func getPlugin(protocol primitive.Protocol) client.Plugin {
+	if plugin, exist := client.GetPlugin(protocol); exist {
+		return plugin
+	}
+	plugin, _ := client.GetPlugin(primitive.HTTPProtocol)
+	return plugin
 }
 
 const (
 	OrderEventCode   = -1
 	ErrTransformCode = 1
+
+	maxDeliveryAttemptExceeded = "MaxDeliveryAttemptExceeded"
 )
 
 func isShouldRetry(statusCode int) (bool, string) {
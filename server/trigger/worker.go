@@ -18,6 +18,7 @@ package trigger
 import (
 	"context"
 	"io"
+	"reflect"
 	"sync"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 	"github.com/vanus-labs/vanus/pkg/observability/metrics"
 
 	"github.com/vanus-labs/vanus/pkg/convert"
+	"github.com/vanus-labs/vanus/server/trigger/reader"
 	"github.com/vanus-labs/vanus/server/trigger/trigger"
 )
 
@@ -56,6 +58,8 @@ type newTrigger func(subscription *primitive.Subscription, options ...trigger.Op
 
 type worker struct {
 	triggerMap map[vanus.ID]trigger.Trigger
+	// shardMap records the shards run by the triggers.
+	shardMap   map[vanus.ID][]uint32
 	ctx        context.Context
 	stop       context.CancelFunc
 	config     Config
@@ -65,6 +69,7 @@ type worker struct {
 	tgLock     sync.RWMutex
 	client     ctrlpb.TriggerControllerClient
 	ctrl       cluster.Cluster
+	readerHub  reader.Hub
 }
 
 func NewWorker(config Config) Worker {
@@ -76,8 +81,12 @@ func NewWorker(config Config) Worker {
 		config:     config,
 		ctrl:       cluster.NewClusterController(config.ControllerAddr, insecure.NewCredentials()),
 		triggerMap: make(map[vanus.ID]trigger.Trigger),
+		shardMap:   make(map[vanus.ID][]uint32),
 		newTrigger: trigger.NewTrigger,
 	}
+	if config.SharedReader {
+		m.readerHub = reader.NewHub(config.SharedReaderWindow)
+	}
 	m.client = m.ctrl.TriggerService().RawClient()
 	m.ctx, m.stop = context.WithCancel(context.Background())
 	return m
@@ -100,6 +109,19 @@ func (w *worker) deleteTrigger(id vanus.ID) {
 	w.tgLock.Lock()
 	defer w.tgLock.Unlock()
 	delete(w.triggerMap, id)
+	delete(w.shardMap, id)
+}
+
+func (w *worker) getShards(id vanus.ID) []uint32 {
+	w.tgLock.RLock()
+	defer w.tgLock.RUnlock()
+	return w.shardMap[id]
+}
+
+func (w *worker) setShards(id vanus.ID, shards []uint32) {
+	w.tgLock.Lock()
+	defer w.tgLock.Unlock()
+	w.shardMap[id] = shards
 }
 
 func (w *worker) Init(_ context.Context) error {
@@ -160,8 +182,14 @@ func (w *worker) AddSubscription(ctx context.Context, subscription *primitive.Su
 	defer w.lock.Unlock()
 	t, exist := w.getTrigger(subscription.ID)
 	if exist {
-		err := t.Change(ctx, subscription)
-		return err
+		if reflect.DeepEqual(w.getShards(subscription.ID), subscription.Shards) {
+			err := t.Change(ctx, subscription)
+			return err
+		}
+		// the eventlogs to read are changed, restart it from the latest offsets.
+		_ = t.Stop(ctx)
+		w.deleteTrigger(subscription.ID)
+		metrics.TriggerGauge.WithLabelValues(w.config.IP).Dec()
 	}
 	t, err := w.newTrigger(subscription, w.getTriggerOptions(subscription)...)
 	if err != nil {
@@ -176,6 +204,7 @@ func (w *worker) AddSubscription(ctx context.Context, subscription *primitive.Su
 		return err
 	}
 	w.addTrigger(subscription.ID, t)
+	w.setShards(subscription.ID, subscription.Shards)
 	metrics.TriggerGauge.WithLabelValues(w.config.IP).Inc()
 	return nil
 }
@@ -204,6 +233,7 @@ func (w *worker) startHeartbeat(ctx context.Context) error {
 		return &ctrlpb.TriggerWorkerHeartbeatRequest{
 			Address:          w.config.TriggerAddr,
 			SubscriptionInfo: w.getAllSubscriptionInfo(ctx),
+			SubscriptionLoad: w.getAllSubscriptionLoad(ctx),
 		}
 	}
 	return w.ctrl.TriggerService().RegisterHeartbeat(ctx, w.config.HeartbeatInterval, f)
@@ -250,6 +280,22 @@ func (w *worker) getAllSubscriptionInfo(ctx context.Context) []*metapb.Subscript
 	return subInfos
 }
 
+func (w *worker) getAllSubscriptionLoad(ctx context.Context) []*ctrlpb.SubscriptionLoad {
+	w.tgLock.RLock()
+	defer w.tgLock.RUnlock()
+	loads := make([]*ctrlpb.SubscriptionLoad, 0, len(w.triggerMap))
+	for id, t := range w.triggerMap {
+		load := t.GetLoad(ctx)
+		loads = append(loads, &ctrlpb.SubscriptionLoad{
+			SubscriptionId: uint64(id),
+			Throughput:     load.Throughput,
+			Lag:            load.Lag,
+			Goroutines:     int32(load.Goroutines),
+		})
+	}
+	return loads
+}
+
 func (w *worker) getTriggerOptions(subscription *primitive.Subscription) []trigger.Option {
 	opts := []trigger.Option{trigger.WithControllers(w.config.ControllerAddr)}
 	config := subscription.Config
@@ -267,10 +313,14 @@ func (w *worker) getTriggerOptions(subscription *primitive.Subscription) []trigg
 		trigger.WithMaxRetryAttempts(config.GetMaxRetryAttempts()),
 		trigger.WithDisableDeadLetter(disableDeadLetter),
 		trigger.WithOrdered(orderEvent),
+		trigger.WithOrderedKeyAttribute(config.OrderedKeyAttribute),
 		trigger.WithGoroutineSize(w.config.SendEventGoroutineSize),
 		trigger.WithSendBatchSize(w.config.SendEventBatchSize),
 		trigger.WithPullBatchSize(w.config.PullEventBatchSize),
 		trigger.WithMaxUACKNumber(w.config.MaxUACKEventNumber),
 		trigger.WithProxy(w.config.Gateway))
+	if w.readerHub != nil {
+		opts = append(opts, trigger.WithReaderHub(w.readerHub))
+	}
 	return opts
 }
@@ -67,6 +67,21 @@ func TestAddSubscription(t *testing.T) {
 				})
 				So(err, ShouldBeNil)
 			})
+			Convey("update subscription shards", func() {
+				tg2 := trigger.NewMockTrigger(ctrl)
+				m.newTrigger = testNewTrigger(tg2)
+				tg.EXPECT().Stop(gomock.Any()).Return(nil)
+				tg2.EXPECT().Init(gomock.Any()).Return(nil)
+				tg2.EXPECT().Start(gomock.Any()).Return(nil)
+				err = m.AddSubscription(ctx, &primitive.Subscription{
+					ID:     id,
+					Shards: []uint32{1},
+				})
+				So(err, ShouldBeNil)
+				v, _ = m.getTrigger(id)
+				So(v, ShouldEqual, tg2)
+				So(m.getShards(id), ShouldResemble, []uint32{1})
+			})
 		})
 		Convey("add subscription has error", func() {
 			id := snowflake.NewTestID()
@@ -196,6 +211,29 @@ func TestWorker_Stop(t *testing.T) {
 	})
 }
 
+func TestWorker_GetAllSubscriptionLoad(t *testing.T) {
+	Convey("test get all subscription load", t, func() {
+		ctx := context.Background()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		tg := trigger.NewMockTrigger(ctrl)
+		m := NewWorker(Config{}).(*worker)
+		id := snowflake.NewTestID()
+		m.addTrigger(id, tg)
+		tg.EXPECT().GetLoad(gomock.Any()).Return(trigger.Load{
+			Throughput: 12.5,
+			Lag:        100,
+			Goroutines: 8,
+		})
+		loads := m.getAllSubscriptionLoad(ctx)
+		So(loads, ShouldHaveLength, 1)
+		So(loads[0].SubscriptionId, ShouldEqual, uint64(id))
+		So(loads[0].Throughput, ShouldEqual, 12.5)
+		So(loads[0].Lag, ShouldEqual, 100)
+		So(loads[0].Goroutines, ShouldEqual, 8)
+	})
+}
+
 func TestWorker_Register(t *testing.T) {
 	Convey("test register", t, func() {
 		ctx := context.Background()
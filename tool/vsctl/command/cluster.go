@@ -17,12 +17,14 @@ package command
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"os/exec"
+	"strconv"
 	"strings"
 	"time"
 
@@ -30,6 +32,10 @@ import (
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	ctrlpb "github.com/vanus-labs/vanus/api/controller"
+	vanus "github.com/vanus-labs/vanus/api/vsr"
 )
 
 const (
@@ -160,6 +166,8 @@ func NewClusterCommand() *cobra.Command {
 	cmd.AddCommand(scaleClusterCommand())
 	cmd.AddCommand(getClusterCommand())
 	cmd.AddCommand(genClusterCommand())
+	cmd.AddCommand(rebalanceClusterCommand())
+	cmd.AddCommand(storeClusterCommand())
 	return cmd
 }
 
@@ -738,6 +746,117 @@ func scaleTriggerReplicas() *cobra.Command {
 	return cmd
 }
 
+func storeClusterCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store sub-command ",
+		Short: "store operations",
+	}
+	cmd.AddCommand(decommissionStoreCommand())
+	return cmd
+}
+
+func decommissionStoreCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decommission <volume-id>",
+		Short: "drain replicas out of a store so that it can be removed safely",
+		Args:  cobra.ExactArgs(1),
+		PreRun: func(cmd *cobra.Command, args []string) {
+			InitGatewayClient(cmd)
+		},
+		PostRun: func(cmd *cobra.Command, args []string) {
+			DestroyGatewayClient()
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			volumeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				cmdFailedWithHelpNotice(cmd, fmt.Sprintf("invalid volume id: %s\n", err.Error()))
+			}
+
+			req := &ctrlpb.DecommissionVolumeRequest{
+				VolumeId: volumeID,
+				Cancel:   cancelDecommission,
+			}
+			for {
+				res, err := client.DecommissionVolume(context.Background(), req)
+				if err != nil {
+					cmdFailedf(cmd, "decommission store failed: %s", Error(err))
+				}
+				if IsFormatJSON(cmd) {
+					data, _ := json.Marshal(res)
+					color.Green(string(data))
+				} else {
+					switch {
+					case !res.Draining:
+						color.Green("the decommission of volume %d has been canceled", volumeID)
+					case res.Completed:
+						color.Green("all replicas have been migrated, the store of volume %d can be removed safely",
+							volumeID)
+					default:
+						color.Yellow("volume %d is draining, %d replicas remaining", volumeID, res.RemainingBlocks)
+					}
+				}
+				if !res.Draining || res.Completed || !waitDecommission {
+					return
+				}
+				time.Sleep(5 * time.Second)
+			}
+		},
+	}
+	cmd.Flags().BoolVar(&cancelDecommission, "cancel", false, "cancel the decommission, the store accepts "+
+		"new replicas again, migrated replicas aren't moved back")
+	cmd.Flags().BoolVar(&waitDecommission, "wait", true, "wait until all replicas have been migrated")
+	return cmd
+}
+
+func rebalanceClusterCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebalance",
+		Short: "transfer segment leaders to even out write load across stores",
+		PreRun: func(cmd *cobra.Command, args []string) {
+			InitGatewayClient(cmd)
+		},
+		PostRun: func(cmd *cobra.Command, args []string) {
+			DestroyGatewayClient()
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := client.RebalanceLeaders(context.Background(), &emptypb.Empty{})
+			if err != nil {
+				cmdFailedf(cmd, "rebalance cluster failed: %s", Error(err))
+			}
+
+			if IsFormatJSON(cmd) {
+				data, _ := json.Marshal(res.Transfers)
+				color.Green(string(data))
+				return
+			}
+			t := table.NewWriter()
+			t.AppendHeader(table.Row{"Segment", "From Block", "To Block", "From Volume", "To Volume"})
+			for _, tr := range res.Transfers {
+				t.AppendRow(table.Row{
+					vanus.NewIDFromUint64(tr.SegmentId).String(),
+					vanus.NewIDFromUint64(tr.FromBlockId).String(),
+					vanus.NewIDFromUint64(tr.ToBlockId).String(),
+					vanus.NewIDFromUint64(tr.FromVolumeId).String(),
+					vanus.NewIDFromUint64(tr.ToVolumeId).String(),
+				})
+			}
+			t.SetColumnConfigs([]table.ColumnConfig{
+				{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 2, AlignHeader: text.AlignCenter},
+				{Number: 3, AlignHeader: text.AlignCenter},
+				{Number: 4, AlignHeader: text.AlignCenter},
+				{Number: 5, AlignHeader: text.AlignCenter},
+			})
+			t.SetOutputMirror(os.Stdout)
+			t.Render()
+			if len(res.Transfers) == 0 {
+				color.Green("leaders are balanced, nothing to do")
+			}
+		},
+	}
+	return cmd
+}
+
 func getClusterCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "status",
@@ -19,11 +19,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	proxypb "github.com/vanus-labs/vanus/api/proxy"
 	vanus "github.com/vanus-labs/vanus/api/vsr"
@@ -42,6 +44,7 @@ func newDeadLetterCommand() *cobra.Command {
 	}
 	cmd.AddCommand(getDeadLetterCommand())
 	cmd.AddCommand(resendDeadLetterCommand())
+	cmd.AddCommand(deadLetterStatsCommand())
 	return cmd
 }
 
@@ -105,22 +108,182 @@ func resendDeadLetterCommand() *cobra.Command {
 			if err != nil {
 				cmdFailedWithHelpNotice(cmd, fmt.Sprintf("invalid subscription id: %s\n", err.Error()))
 			}
-
-			_, err = client.ResendDeadLetterEvent(context.Background(), &proxypb.ResendDeadLetterEventRequest{
+			req := &proxypb.ResendDeadLetterEventRequest{
 				SubscriptionId: id.Uint64(),
 				StartOffset:    startOffset,
 				EndOffset:      endOffset,
-			})
+				Filter:         getDeadLetterFilter(cmd),
+				DryRun:         dryRun,
+				Purge:          purge,
+				PurgeUnmatched: purgeUnmatched,
+			}
+			if targetEventbus != "" && targetSink != "" {
+				cmdFailedWithHelpNotice(cmd, "target-eventbus and target-sink can't be both set\n")
+			}
+			if purge && (targetEventbus != "" || targetSink != "") {
+				cmdFailedWithHelpNotice(cmd, "purge can't be used with target-eventbus or target-sink\n")
+			}
+			if targetEventbus != "" {
+				req.TargetEventbusId = mustGetEventbusID(namespace, targetEventbus).Uint64()
+			}
+			if targetSink != "" {
+				req.TargetSink = targetSink
+				req.TargetProtocol = getProtocol(cmd)
+				req.TargetSinkCredential = getSinkCredential(cmd)
+			}
 
+			res, err := client.ResendDeadLetterEvent(context.Background(), req)
 			if err != nil {
 				cmdFailedf(cmd, "failed to resend: %s\n", Error(err))
 			}
-			color.Green("resend event success")
+			action := "resend"
+			if purge {
+				action = "purge"
+			}
+			if dryRun {
+				color.Green("%d events matched, %d unmatched, %s nothing in dry run",
+					res.GetMatched(), res.GetUnmatched(), action)
+				return
+			}
+			color.Green("%s %d events success, dead letter offset is %d now", action, res.GetMatched(), res.GetOffset())
+			if res.GetUnmatched() > 0 {
+				color.Yellow("WARN: %d unmatched events are kept, the dead letter offset stops at %d, "+
+					"the %s events after it stay in the dead letter, use --purge-unmatched to drop unmatched events",
+					res.GetUnmatched(), res.GetOffset(), action)
+			}
 		},
 	}
 
 	cmd.Flags().StringVar(&idStr, "id", "", "subscription id")
 	cmd.Flags().Uint64Var(&startOffset, "start", 0, "which position you want to start get, default first")
 	cmd.Flags().Uint64Var(&endOffset, "end", 0, "which position you want to end get, default to end")
+	cmd.Flags().StringSliceVar(&deadLetterReasons, "reason", nil,
+		"only events with one of the dead letter reasons, like MaxDeliveryAttemptExceeded or Response400")
+	cmd.Flags().StringVar(&deadLetterError, "error", "",
+		"only events whose last delivery error matches the regular expression")
+	cmd.Flags().StringVar(&deadLetterSince, "since", "",
+		"only events written to the dead letter since the RFC3339 time, like 2022-11-24T11:20:56+08:00")
+	cmd.Flags().StringVar(&deadLetterUntil, "until", "",
+		"only events written to the dead letter before the RFC3339 time, like 2022-11-24T11:20:56+08:00")
+	cmd.Flags().StringVar(&deadLetterCEL, "cel", "", "only events whose data matches the CEL expression")
+	cmd.Flags().StringVar(&targetEventbus, "target-eventbus", "",
+		"resend events to the eventbus instead of the subscription")
+	cmd.Flags().StringVar(&namespace, "namespace", "default", "namespace name of the target eventbus")
+	cmd.Flags().StringVar(&targetSink, "target-sink", "", "resend events to the sink instead of the subscription")
+	cmd.Flags().StringVar(&subProtocol, "protocol", "http",
+		"protocol of the target sink,http or aws-lambda or gcloud-functions or grpc or kafka")
+	cmd.Flags().StringVar(&sinkCredentialType, "credential-type", "",
+		"credential type of the target sink: aws, gcloud, plain, hmac or oauth2")
+	cmd.Flags().StringVar(&sinkCredential, "credential", "",
+		"credential info of the target sink, JSON format or @filePath")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "only count matched events, resend or purge nothing")
+	cmd.Flags().BoolVar(&purge, "purge", false,
+		"drop matched events by moving the dead letter offset, until the first unmatched event")
+	cmd.Flags().BoolVar(&purgeUnmatched, "purge-unmatched", false,
+		"drop unmatched events, so the dead letter offset moves across the whole range")
+	return cmd
+}
+
+func getDeadLetterFilter(cmd *cobra.Command) *proxypb.DeadLetterFilter {
+	if len(deadLetterReasons) == 0 && deadLetterError == "" && deadLetterSince == "" &&
+		deadLetterUntil == "" && deadLetterCEL == "" {
+		return nil
+	}
+	filter := &proxypb.DeadLetterFilter{
+		Reasons:           deadLetterReasons,
+		LastDeliveryError: deadLetterError,
+		CelExpression:     deadLetterCEL,
+	}
+	if deadLetterSince != "" {
+		t, err := time.Parse(time.RFC3339, deadLetterSince)
+		if err != nil {
+			cmdFailedWithHelpNotice(cmd, fmt.Sprintf("invalid since time: %s\n", err.Error()))
+		}
+		filter.StartTime = timestamppb.New(t)
+	}
+	if deadLetterUntil != "" {
+		t, err := time.Parse(time.RFC3339, deadLetterUntil)
+		if err != nil {
+			cmdFailedWithHelpNotice(cmd, fmt.Sprintf("invalid until time: %s\n", err.Error()))
+		}
+		filter.EndTime = timestamppb.New(t)
+	}
+	return filter
+}
+
+func deadLetterStatsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "aggregate the dead letter events of a subscription by reason, code, type and hour",
+		Run: func(cmd *cobra.Command, args []string) {
+			id, err := vanus.NewIDFromString(idStr)
+			if err != nil {
+				cmdFailedWithHelpNotice(cmd, fmt.Sprintf("invalid subscription id: %s\n", err.Error()))
+			}
+
+			res, err := client.GetDeadLetterStats(context.Background(), &proxypb.GetDeadLetterStatsRequest{
+				SubscriptionId: id.Uint64(),
+				StartOffset:    startOffset,
+				EndOffset:      endOffset,
+				Limit:          deadLetterLimit,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "failed to get dead letter stats: %s\n", Error(err))
+			}
+			printDeadLetterStats(cmd, res)
+		},
+	}
+
+	cmd.Flags().StringVar(&idStr, "id", "", "subscription id")
+	cmd.Flags().Uint64Var(&startOffset, "start", 0, "which position you want to start scan, default first")
+	cmd.Flags().Uint64Var(&endOffset, "end", 0, "which position you want to end scan, default to end")
+	cmd.Flags().Uint64Var(&deadLetterLimit, "limit", 0, "the maximum number of events to scan, default is 100000")
 	return cmd
 }
+
+func printDeadLetterStats(cmd *cobra.Command, res *proxypb.GetDeadLetterStatsResponse) {
+	if IsFormatJSON(cmd) {
+		data, _ := json.Marshal(res)
+		color.Green(string(data))
+		return
+	}
+	formatTime := func(t *timestamppb.Timestamp) string {
+		if t == nil {
+			return "-"
+		}
+		return t.AsTime().Local().Format(time.RFC3339)
+	}
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"by", "key", "count", "first", "last"})
+	groups := []struct {
+		name  string
+		stats []*proxypb.DeadLetterStat
+	}{
+		{"reason", res.Reasons},
+		{"code", res.Codes},
+		{"type", res.Types},
+		{"hour", res.Hours},
+	}
+	for _, group := range groups {
+		for _, stat := range group.stats {
+			t.AppendRow(table.Row{
+				group.name, stat.Key, stat.Count, formatTime(stat.FirstTime), formatTime(stat.LastTime),
+			})
+		}
+		t.AppendSeparator()
+	}
+	t.AppendFooter(table.Row{"total", "", res.Total, formatTime(res.FirstTime), formatTime(res.LastTime)})
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, AutoMerge: true, VAlign: text.VAlignMiddle, AlignHeader: text.AlignCenter},
+		{Number: 2, AlignHeader: text.AlignCenter},
+		{Number: 3, AlignHeader: text.AlignCenter},
+		{Number: 4, AlignHeader: text.AlignCenter},
+		{Number: 5, AlignHeader: text.AlignCenter},
+	})
+	t.SetOutputMirror(os.Stdout)
+	t.Render()
+	color.Green("scanned offset [%d, %d)", res.StartOffset, res.EndOffset)
+	if res.Truncated {
+		color.Yellow("WARN: the limit is reached, events after offset %d are not aggregated", res.EndOffset)
+	}
+}
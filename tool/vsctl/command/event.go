@@ -315,6 +315,7 @@ func getEventCommand() *cobra.Command {
 				Offset:     offset,
 				EventId:    eventID,
 				Number:     int32(number),
+				CeId:       ceID,
 			})
 			if err != nil {
 				cmdFailedf(cmd, "failed to get event: %s", Error(err))
@@ -351,6 +352,8 @@ func getEventCommand() *cobra.Command {
 	cmd.Flags().Int16Var(&number, "number", 1, "the number of event you want to get")
 	cmd.Flags().Uint64Var(&eventlogID, "eventlog", 0, "get events from a specified eventlog")
 	cmd.Flags().StringVar(&eventID, "event-id", "", "get event by event ID")
+	cmd.Flags().StringVar(&ceID, "id", "", "get event by the id attribute of CloudEvent, "+
+		"which requires the id index of store")
 	return cmd
 }
 
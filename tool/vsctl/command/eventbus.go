@@ -18,6 +18,8 @@ import (
 	"context"
 	"encoding/json"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/fatih/color"
@@ -44,6 +46,8 @@ func NewEventbusCommand() *cobra.Command {
 		},
 	}
 	cmd.AddCommand(createEventbusCommand())
+	cmd.AddCommand(updateEventbusCommand())
+	cmd.AddCommand(scaleEventbusCommand())
 	cmd.AddCommand(deleteEventbusCommand())
 	cmd.AddCommand(getEventbusInfoCommand())
 	cmd.AddCommand(listEventbusInfoCommand())
@@ -59,10 +63,12 @@ func createEventbusCommand() *cobra.Command {
 				cmdFailedf(cmd, "the --name flag MUST be set")
 			}
 			eb, err := client.CreateEventbus(context.Background(), &ctrlpb.CreateEventbusRequest{
-				Name:        eventbus,
-				LogNumber:   eventlogNum,
-				Description: description,
-				NamespaceId: mustGetNamespaceID(namespace).Uint64(),
+				Name:              eventbus,
+				LogNumber:         eventlogNum,
+				Description:       description,
+				NamespaceId:       mustGetNamespaceID(namespace).Uint64(),
+				Retention:         getRetentionPolicy(cmd),
+				CompressAlgorithm: mustGetCompressAlgorithm(cmd),
 			})
 			if err != nil {
 				cmdFailedf(cmd, "create eventbus failed: %s", Error(err))
@@ -88,9 +94,170 @@ func createEventbusCommand() *cobra.Command {
 	cmd.Flags().StringVar(&eventbus, "name", "", "eventbus name to create")
 	cmd.Flags().Int32Var(&eventlogNum, "eventlog", 1, "number of eventlog")
 	cmd.Flags().StringVar(&description, "description", "", "subscription description")
+	addRetentionFlags(cmd)
+	addCompressFlag(cmd)
 	return cmd
 }
 
+func updateEventbusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "update a eventbus",
+		Run: func(cmd *cobra.Command, args []string) {
+			if eventbus == "" {
+				cmdFailedf(cmd, "the --name flag MUST be set")
+			}
+			req := &ctrlpb.UpdateEventbusRequest{
+				Retention: getRetentionPolicy(cmd),
+			}
+			if cmd.Flags().Changed("description") {
+				req.Description = &description
+			}
+			if cmd.Flags().Changed("eventlog") {
+				req.LogNumber = eventlogNum
+			}
+			if cmd.Flags().Changed("compress") {
+				algo := mustGetCompressAlgorithm(cmd)
+				req.CompressAlgorithm = &algo
+			}
+			if req.Retention == nil && req.Description == nil && req.LogNumber == 0 && req.CompressAlgorithm == nil {
+				cmdFailedf(cmd, "nothing to update")
+			}
+			req.Id = mustGetEventbusID(namespace, eventbus).Uint64()
+			eb, err := client.UpdateEventbus(context.Background(), req)
+			if err != nil {
+				cmdFailedf(cmd, "update eventbus failed: %s", Error(err))
+			}
+			if IsFormatJSON(cmd) {
+				data, _ := json.Marshal(map[string]interface{}{
+					"Result": "Update Success", "Name": eventbus, "Description": eb.Description,
+					"Eventlog Number": eb.LogNumber, "Retention": formatRetention(eb.Retention),
+				})
+				color.Green(string(data))
+			} else {
+				t := table.NewWriter()
+				t.AppendHeader(table.Row{"Result", "Name", "Description", "Eventlog Number", "Retention"})
+				t.AppendRow(table.Row{
+					"Update Success", eventbus, eb.Description, eb.LogNumber, formatRetention(eb.Retention),
+				})
+				t.SetColumnConfigs([]table.ColumnConfig{
+					{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+					{Number: 2, AlignHeader: text.AlignCenter},
+					{Number: 3, AlignHeader: text.AlignCenter},
+					{Number: 4, AlignHeader: text.AlignCenter},
+					{Number: 5, AlignHeader: text.AlignCenter},
+				})
+				t.SetOutputMirror(os.Stdout)
+				t.Render()
+			}
+		},
+	}
+	cmd.Flags().StringVar(&namespace, "namespace", "default", "namespace name, default name is default")
+	cmd.Flags().StringVar(&eventbus, "name", "", "eventbus name to update")
+	cmd.Flags().StringVar(&description, "description", "", "eventbus description")
+	cmd.Flags().Int32Var(&eventlogNum, "eventlog", 0, "number of eventlog, it can only be increased")
+	addRetentionFlags(cmd)
+	addCompressFlag(cmd)
+	return cmd
+}
+
+func scaleEventbusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scale",
+		Short: "scale out the eventlogs of a eventbus",
+		Run: func(cmd *cobra.Command, args []string) {
+			if eventbus == "" {
+				cmdFailedf(cmd, "the --name flag MUST be set")
+			}
+			if eventlogNum <= 0 {
+				cmdFailedf(cmd, "the --logs flag MUST be greater than 0")
+			}
+			eb, err := client.UpdateEventbus(context.Background(), &ctrlpb.UpdateEventbusRequest{
+				Id:        mustGetEventbusID(namespace, eventbus).Uint64(),
+				LogNumber: eventlogNum,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "scale eventbus failed: %s", Error(err))
+			}
+			if IsFormatJSON(cmd) {
+				data, _ := json.Marshal(map[string]interface{}{
+					"Result": "Scale Success", "Name": eventbus, "Eventlog Number": eb.LogNumber,
+				})
+				color.Green(string(data))
+			} else {
+				t := table.NewWriter()
+				t.AppendHeader(table.Row{"Result", "Name", "Eventlog Number"})
+				t.AppendRow(table.Row{"Scale Success", eventbus, eb.LogNumber})
+				t.SetColumnConfigs([]table.ColumnConfig{
+					{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+					{Number: 2, AlignHeader: text.AlignCenter},
+					{Number: 3, AlignHeader: text.AlignCenter},
+				})
+				t.SetOutputMirror(os.Stdout)
+				t.Render()
+			}
+		},
+	}
+	cmd.Flags().StringVar(&namespace, "namespace", "default", "namespace name, default name is default")
+	cmd.Flags().StringVar(&eventbus, "name", "", "eventbus name to scale")
+	cmd.Flags().Int32Var(&eventlogNum, "logs", 0, "the expected number of eventlog, it can't be less than current")
+	return cmd
+}
+
+func addRetentionFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&retentionMaxAge, "retention-max-age", "",
+		"max age of events in each eventlog, such as 12h, 0 means no limitation, cluster default is 72h")
+	cmd.Flags().Int64Var(&retentionMaxBytes, "retention-max-bytes", 0,
+		"max bytes of events in each eventlog, 0 means no limitation")
+}
+
+// getRetentionPolicy returns nil if none of retention flags is set, the policy will
+// keep events forever if both of max age and max bytes are 0.
+func getRetentionPolicy(cmd *cobra.Command) *metapb.RetentionPolicy {
+	if !cmd.Flags().Changed("retention-max-age") && !cmd.Flags().Changed("retention-max-bytes") {
+		return nil
+	}
+	retention := &metapb.RetentionPolicy{MaxBytes: retentionMaxBytes}
+	if retentionMaxAge != "" && retentionMaxAge != "0" {
+		d, err := time.ParseDuration(retentionMaxAge)
+		if err != nil {
+			cmdFailedf(cmd, "invalid retention max age: %s", err)
+		}
+		retention.MaxAgeSeconds = int64(d / time.Second)
+	}
+	return retention
+}
+
+func addCompressFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&compressAlgorithm, "compress", "none",
+		"algorithm to compress event data of new segments, none, lz4 or zstd")
+}
+
+func mustGetCompressAlgorithm(cmd *cobra.Command) metapb.CompressAlgorithm {
+	algo, ok := metapb.CompressAlgorithm_value[strings.ToUpper(compressAlgorithm)]
+	if !ok {
+		cmdFailedf(cmd, "unknown compress algorithm: %s", compressAlgorithm)
+	}
+	return metapb.CompressAlgorithm(algo)
+}
+
+func formatRetention(retention *metapb.RetentionPolicy) string {
+	if retention == nil {
+		return "default"
+	}
+	if retention.MaxAgeSeconds <= 0 && retention.MaxBytes <= 0 {
+		return "infinite"
+	}
+	var policies []string
+	if retention.MaxAgeSeconds > 0 {
+		policies = append(policies, "max_age="+(time.Duration(retention.MaxAgeSeconds)*time.Second).String())
+	}
+	if retention.MaxBytes > 0 {
+		policies = append(policies, "max_bytes="+strconv.FormatInt(retention.MaxBytes, 10))
+	}
+	return strings.Join(policies, ",")
+}
+
 func deleteEventbusCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delete",
@@ -164,7 +331,7 @@ func getEventbusInfoCommand() *cobra.Command {
 			if !showSegment && !showBlock {
 				t.AppendHeader(table.Row{
 					"id", "Name", "Namespace", "Description", "Created_At", "Updated_At",
-					"Eventlog", "Segment Number",
+					"Eventlog", "Segment Number", "Retention",
 				})
 				for idx := 0; idx < len(res.Logs); idx++ {
 					if idx == 0 {
@@ -177,6 +344,7 @@ func getEventbusInfoCommand() *cobra.Command {
 							time.UnixMilli(res.UpdatedAt).Format(time.RFC3339),
 							formatID(res.Logs[idx].EventlogId),
 							res.Logs[idx].CurrentSegmentNumbers,
+							formatRetention(res.Retention),
 						})
 					}
 				}
@@ -336,7 +504,7 @@ func listEventbusInfoCommand() *cobra.Command {
 				t := table.NewWriter()
 				t.AppendHeader(table.Row{
 					"id", "Name", "Namespace", "Description", "Created_At",
-					"Updated_At", "Eventlog Number",
+					"Updated_At", "Eventlog Number", "Retention",
 				})
 				for idx := range res.Eventbus {
 					eb := res.Eventbus[idx]
@@ -349,6 +517,7 @@ func listEventbusInfoCommand() *cobra.Command {
 						time.UnixMilli(eb.CreatedAt).Format(time.RFC3339),
 						time.UnixMilli(eb.UpdatedAt).Format(time.RFC3339),
 						eb.LogNumber,
+						formatRetention(eb.Retention),
 					})
 				}
 				cfgs := eventbusColConfigs()
@@ -29,6 +29,7 @@ var (
 	number            int16
 	detail            bool
 	eventID           string
+	ceID              string
 	eventCreateTime   string
 
 	// for both of eventbus and subscription.
@@ -47,6 +48,8 @@ var (
 
 	orderedPushEvent     bool
 	orderedPushEventStr  string
+	orderedKeyAttribute  string
+	shards               int32
 	disableDeadLetter    bool
 	disableDeadLetterStr string
 
@@ -60,6 +63,10 @@ var (
 	showSegment bool
 	showBlock   bool
 
+	retentionMaxAge   string
+	retentionMaxBytes int64
+	compressAlgorithm string
+
 	// for cluster
 	clusterConfigFile   string
 	clusterVersion      string
@@ -67,6 +74,8 @@ var (
 	showUpgradeableList bool
 	storeReplicas       int32
 	triggerReplicas     int32
+	cancelDecommission  bool
+	waitDecommission    bool
 
 	// for connector
 	connectorConfigFile string
@@ -80,6 +89,19 @@ var (
 	startOffset uint64
 	endOffset   uint64
 
+	// for dead letter
+	deadLetterReasons []string
+	deadLetterError   string
+	deadLetterSince   string
+	deadLetterUntil   string
+	deadLetterCEL     string
+	targetEventbus    string
+	targetSink        string
+	dryRun            bool
+	purge             bool
+	purgeUnmatched    bool
+	deadLetterLimit   uint64
+
 	userIdentifier string
 	idStr          string
 	role           string
@@ -89,4 +111,7 @@ var (
 const (
 	AWSCredentialType    = "aws"
 	GCloudCredentialType = "gcloud"
+	PlainCredentialType  = "plain"
+	HMACCredentialType   = "hmac"
+	OAuth2CredentialType = "oauth2"
 )
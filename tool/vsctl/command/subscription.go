@@ -23,7 +23,6 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/fatih/color"
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
@@ -118,8 +117,8 @@ func createSubscriptionCommand() *cobra.Command {
 	cmd.Flags().Int32Var(&rateLimit, "rate-limit", 0, "max event number pushing to sink per second, default is 0, means unlimited")
 	cmd.Flags().StringVar(&from, "from", "", "consume events from, latest,earliest or RFC3339 format time")
 	cmd.Flags().StringVar(&subProtocol, "protocol", "http",
-		"protocol,http or aws-lambda or gcloud-functions or grpc")
-	cmd.Flags().StringVar(&sinkCredentialType, "credential-type", "", "sink credential type: aws or gcloud")
+		"protocol,http or aws-lambda or gcloud-functions or grpc or kafka")
+	cmd.Flags().StringVar(&sinkCredentialType, "credential-type", "", "sink credential type: aws, gcloud, plain, hmac or oauth2")
 	cmd.Flags().StringVar(&sinkCredential, "credential", "",
 		"sink credential info, JSON format or @file")
 	cmd.Flags().Int32Var(&deliveryTimeout, "delivery-timeout", 0,
@@ -132,31 +131,21 @@ func createSubscriptionCommand() *cobra.Command {
 		"subscription (just create if disable=true)")
 	cmd.Flags().BoolVar(&orderedPushEvent, "ordered-event", false, "whether push the "+
 		"event with ordered")
+	cmd.Flags().StringVar(&orderedKeyAttribute, "ordered-key-attribute", "", "the attribute of event used as "+
+		"ordering key, events are only ordered among the same key, requires ordered-event")
 	cmd.Flags().BoolVar(&disableDeadLetter, "disable-dead-letter", false, "whether disable the dead letter")
+	cmd.Flags().Int32Var(&shards, "shards", 0, "the number of shards the eventlogs are split into, each "+
+		"shard may be run by a different trigger worker, default is 0, means 1 shard")
 	return cmd
 }
 
 func getProtocol(cmd *cobra.Command) meta.Protocol {
-	var p meta.Protocol
-	switch subProtocol {
-	case "http", "":
-		p = meta.Protocol_HTTP
-	case "aws-lambda":
-		p = meta.Protocol_AWS_LAMBDA
-		if _, err := arn.Parse(sink); err != nil {
-			cmdFailedf(cmd, "protocol is aws-lambda sink is aws arn, arn parse error: %s\n", err.Error())
-		}
-		if sinkCredentialType != AWSCredentialType {
-			cmdFailedf(cmd, "protocol is aws-lambda, credential-type must be %s\n", AWSCredentialType)
-		}
-	case "gcloud-functions":
-		p = meta.Protocol_GCLOUD_FUNCTIONS
-		if sinkCredentialType != GCloudCredentialType {
-			cmdFailedf(cmd, "protocol is aws-lambda, credential-type must be %s\n", GCloudCredentialType)
-		}
-	case "grpc":
-		p = meta.Protocol_GRPC
-	default:
+	if subProtocol == "" {
+		return meta.Protocol_HTTP
+	}
+	// the sink and the credential are validated by the server with the plugin of the protocol.
+	p := convert.ToPbProtocol(primitive.Protocol(subProtocol))
+	if convert.FromPbProtocol(p) != primitive.Protocol(subProtocol) {
 		cmdFailedf(cmd, "protocol is invalid\n")
 	}
 	return p
@@ -209,6 +198,51 @@ func getSinkCredential(cmd *cobra.Command) *meta.SinkCredential {
 				},
 			},
 		}
+	case PlainCredentialType:
+		var plain *meta.PlainCredential
+		err := json.Unmarshal([]byte(sinkCredential), &plain)
+		if err != nil {
+			cmdFailedf(cmd, "the sink credential unmarshal json error: %s", err.Error())
+		}
+		if plain.Identifier == "" || plain.Secret == "" {
+			cmdFailedf(cmd, "credential-type is plain, identifier and secret must not be empty\n")
+		}
+		return &meta.SinkCredential{
+			CredentialType: meta.SinkCredential_PLAIN,
+			Credential: &meta.SinkCredential_Plain{
+				Plain: plain,
+			},
+		}
+	case HMACCredentialType:
+		var hmac *meta.HMACCredential
+		err := json.Unmarshal([]byte(sinkCredential), &hmac)
+		if err != nil {
+			cmdFailedf(cmd, "the sink credential unmarshal json error: %s", err.Error())
+		}
+		if hmac.Secret == "" {
+			cmdFailedf(cmd, "credential-type is hmac, secret must not be empty\n")
+		}
+		return &meta.SinkCredential{
+			CredentialType: meta.SinkCredential_HMAC,
+			Credential: &meta.SinkCredential_Hmac{
+				Hmac: hmac,
+			},
+		}
+	case OAuth2CredentialType:
+		var oauth2 *meta.OAuth2Credential
+		err := json.Unmarshal([]byte(sinkCredential), &oauth2)
+		if err != nil {
+			cmdFailedf(cmd, "the sink credential unmarshal json error: %s", err.Error())
+		}
+		if oauth2.TokenUrl == "" || oauth2.ClientId == "" || oauth2.ClientSecret == "" {
+			cmdFailedf(cmd, "credential-type is oauth2, token_url, client_id and client_secret must not be empty\n")
+		}
+		return &meta.SinkCredential{
+			CredentialType: meta.SinkCredential_OAUTH2,
+			Credential: &meta.SinkCredential_Oauth2{
+				Oauth2: oauth2,
+			},
+		}
 	default:
 		cmdFailedf(cmd, "credential-type is invalid\n")
 	}
@@ -273,6 +307,12 @@ func getSubscriptionConfig(cmd *cobra.Command, config *meta.SubscriptionConfig)
 		}
 		config.OrderedEvent = v
 	}
+	if orderedKeyAttribute != "" {
+		config.OrderedKeyAttribute = orderedKeyAttribute
+	}
+	if shards >= 0 {
+		config.Shards = uint32(shards)
+	}
 	if disableDeadLetterStr != "" {
 		v, err := strconv.ParseBool(disableDeadLetterStr)
 		if err != nil {
@@ -379,8 +419,8 @@ func updateSubscriptionCommand() *cobra.Command {
 	cmd.Flags().StringVar(&transformer, "transformer", "", "transformer, JSON format required")
 	cmd.Flags().Int32Var(&rateLimit, "rate-limit", -1, "max event number pushing to sink per second, 0 means unlimited")
 	cmd.Flags().StringVar(&subProtocol, "protocol", "",
-		"protocol,http or aws-lambda or gcloud-functions or grpc")
-	cmd.Flags().StringVar(&sinkCredentialType, "credential-type", "", "sink credential type: aws or gcloud")
+		"protocol,http or aws-lambda or gcloud-functions or grpc or kafka")
+	cmd.Flags().StringVar(&sinkCredentialType, "credential-type", "", "sink credential type: aws, gcloud, plain, hmac or oauth2")
 	cmd.Flags().StringVar(&sinkCredential, "credential", "",
 		"sink credential info, JSON format or @file")
 	cmd.Flags().Int32Var(&deliveryTimeout, "delivery-timeout", -1,
@@ -391,8 +431,12 @@ func updateSubscriptionCommand() *cobra.Command {
 	cmd.Flags().StringVar(&description, "description", "", "subscription description")
 	cmd.Flags().StringVar(&orderedPushEventStr, "ordered-event", "",
 		"whether push the event with ordered, true of false")
+	cmd.Flags().StringVar(&orderedKeyAttribute, "ordered-key-attribute", "", "the attribute of event used as "+
+		"ordering key, events are only ordered among the same key, requires ordered-event")
 	cmd.Flags().StringVar(&disableDeadLetterStr, "disable-dead-letter", "",
 		"whether disable the dead letter, true of false")
+	cmd.Flags().Int32Var(&shards, "shards", -1, "the number of shards the eventlogs are split into, each "+
+		"shard may be run by a different trigger worker")
 	return cmd
 }
 
@@ -570,12 +614,47 @@ func getSubscriptionCommand() *cobra.Command {
 				cmdFailedf(cmd, "get subscription info failed: %s", Error(err))
 			}
 			printSubscription(cmd, false, true, true, res)
+
+			lag, err := client.GetSubscriptionLag(context.Background(), &ctrlpb.GetSubscriptionLagRequest{
+				SubscriptionId: id.Uint64(),
+			})
+			if err != nil {
+				color.Yellow("WARN: get subscription lag failed: %s\n", Error(err))
+				return
+			}
+			printSubscriptionLag(cmd, lag)
 		},
 	}
 	cmd.Flags().StringVar(&idStr, "id", "", "subscription id")
 	return cmd
 }
 
+func printSubscriptionLag(cmd *cobra.Command, lag *ctrlpb.GetSubscriptionLagResponse) {
+	if IsFormatJSON(cmd) {
+		data, _ := json.Marshal(lag)
+		color.Green(string(data))
+		return
+	}
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"eventlog", "committed_offset", "latest_offset", "lag", "lag_time"})
+	for _, elLag := range lag.Eventlogs {
+		t.AppendRow(table.Row{
+			formatID(elLag.EventlogId), elLag.CommittedOffset, elLag.LatestOffset, elLag.Lag,
+			time.Duration(elLag.LagTime) * time.Millisecond,
+		})
+	}
+	t.AppendFooter(table.Row{"total", "", "", lag.Lag, time.Duration(lag.LagTime) * time.Millisecond})
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, AlignHeader: text.AlignCenter},
+		{Number: 2, AlignHeader: text.AlignCenter},
+		{Number: 3, AlignHeader: text.AlignCenter},
+		{Number: 4, AlignHeader: text.AlignCenter},
+		{Number: 5, AlignHeader: text.AlignCenter},
+	})
+	t.SetOutputMirror(os.Stdout)
+	t.Render()
+}
+
 func listSubscriptionCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -667,17 +746,7 @@ func getSubscriptionRow(sub *meta.Subscription) []interface{} {
 	result = append(result, sub.Sink)
 	result = append(result, sub.Description)
 
-	var protocol string
-	switch sub.Protocol {
-	case meta.Protocol_HTTP:
-		protocol = "http"
-	case meta.Protocol_AWS_LAMBDA:
-		protocol = "aws-lambda"
-	case meta.Protocol_GCLOUD_FUNCTIONS:
-		protocol = "gcloud-functions"
-	case meta.Protocol_GRPC:
-		protocol = "grpc"
-	}
+	protocol := string(convert.FromPbProtocol(sub.Protocol))
 	result = append(result, protocol)
 
 	sinkCredential, _ := json.MarshalIndent(sub.SinkCredential, "", "  ")